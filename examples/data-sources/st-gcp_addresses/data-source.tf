@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_addresses" "def" {
+  region       = "asia-southeast1"
+  status       = "RESERVED"
+  address_type = "EXTERNAL"
+  network_tier = "PREMIUM"
+
+  labels = {
+    pool = "lb-frontend"
+  }
+}
+
+output "unused_address" {
+  value = try(data.st-gcp_addresses.def.items[0].address, null)
+}
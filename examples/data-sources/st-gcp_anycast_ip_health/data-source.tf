@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_anycast_ip_health" "def" {
+  forwarding_rule = "web-prod-https"
+  host            = "www.example.com"
+  path            = "/healthz"
+  expected_status = 200
+}
+
+output "anycast_ip_latency_ms" {
+  value = data.st-gcp_anycast_ip_health.def.latency_ms
+}
+
+output "anycast_ip_reachable" {
+  value = data.st-gcp_anycast_ip_health.def.reachable
+}
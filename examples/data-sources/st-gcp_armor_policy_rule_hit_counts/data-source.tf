@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+# A counter log-based metric on the load balancer request logs, labeled with
+# policy_name (jsonPayload.enforcedSecurityPolicy.name) and priority
+# (jsonPayload.enforcedSecurityPolicy.priority).
+data "st-gcp_armor_policy_rule_hit_counts" "def" {
+  policy      = "shared-edge-policy"
+  metric_type = "logging.googleapis.com/user/armor_rule_hits"
+  window_days = 30
+}
+
+output "unused_rule_priorities" {
+  value = data.st-gcp_armor_policy_rule_hit_counts.def.unused_rule_priorities
+}
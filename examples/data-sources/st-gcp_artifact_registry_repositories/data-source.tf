@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_artifact_registry_repositories" "def" {
+  location = "asia-east1"
+  format   = "DOCKER"
+  labels = {
+    team = "payments"
+  }
+}
+
+output "push_targets" {
+  value = [for repository in data.st-gcp_artifact_registry_repositories.def.items : repository.repository_url]
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_audit_log_config" "all_services" {
+  service = "allServices"
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.items) > 0 && self.items[0].data_read_enabled && self.items[0].data_write_enabled
+      error_message = "Data Access audit logs must be enabled for all services."
+    }
+  }
+}
+
+data "st-gcp_audit_log_config" "project" {}
+
+output "audited_services" {
+  value = data.st-gcp_audit_log_config.project.items[*].service
+}
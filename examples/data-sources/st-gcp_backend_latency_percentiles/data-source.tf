@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_backend_latency_percentiles" "def" {
+  backend_service = "web-backend"
+  window_minutes  = 30
+
+  lifecycle {
+    postcondition {
+      condition     = self.p99_ms == null || self.p99_ms < 500
+      error_message = "p99 backend latency regressed above 500ms."
+    }
+  }
+}
+
+output "backend_latency_ms" {
+  value = {
+    p50 = data.st-gcp_backend_latency_percentiles.def.p50_ms
+    p95 = data.st-gcp_backend_latency_percentiles.def.p95_ms
+    p99 = data.st-gcp_backend_latency_percentiles.def.p99_ms
+  }
+}
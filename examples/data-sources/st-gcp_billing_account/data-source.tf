@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_billing_account" "def" {}
+
+output "billing_account_id" {
+  value = data.st-gcp_billing_account.def.billing_account_id
+
+  precondition {
+    condition     = data.st-gcp_billing_account.def.open != false
+    error_message = "The billing account of the project is closed."
+  }
+}
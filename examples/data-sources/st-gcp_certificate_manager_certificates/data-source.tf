@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_certificate_manager_certificates" "def" {
+  labels = {
+    env = "prod"
+  }
+}
+
+output "certificates_not_active" {
+  value = [
+    for item in data.st-gcp_certificate_manager_certificates.def.items :
+    item.name if item.type == "MANAGED" && item.state != "ACTIVE"
+  ]
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_certificate_map_entries" "def" {
+  certificate_map = "web-prod"
+  hostname        = "www.example.com"
+}
+
+output "serving_certificate" {
+  value = data.st-gcp_certificate_map_entries.def.items[0].primary_certificate
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_change_window" "def" {
+  timezone         = "Asia/Kuala_Lumpur"
+  holiday_calendar = "my-change-calendar/holidays.json"
+
+  windows = [
+    {
+      schedule         = "0 22 * * MON-THU"
+      duration_minutes = 240
+    },
+  ]
+}
+
+output "next_change_window" {
+  value = data.st-gcp_change_window.def.next_window_start
+
+  precondition {
+    condition     = data.st-gcp_change_window.def.in_window
+    error_message = "Production changes are only allowed inside the change windows."
+  }
+}
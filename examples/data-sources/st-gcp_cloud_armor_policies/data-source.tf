@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_cloud_armor_policies" "def" {
+  region = "global"
+
+  labels = {
+    shared = "true"
+  }
+}
+
+output "shared_security_policies" {
+  value = {
+    for item in data.st-gcp_cloud_armor_policies.def.items :
+    item.name => item.self_link
+  }
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_cloud_functions" "def" {
+  location     = "asia-east1"
+  trigger_type = "HTTP"
+  labels = {
+    team = "payments"
+  }
+}
+
+output "function_urls" {
+  value = { for function in data.st-gcp_cloud_functions.def.items : function.name => function.url }
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_cloud_nat" "def" {
+  network = "shared-vpc"
+  region  = "asia-east1"
+}
+
+output "egress_ips" {
+  value = distinct(flatten([
+    for item in data.st-gcp_cloud_nat.def.items : item.allocated_nat_ips
+  ]))
+}
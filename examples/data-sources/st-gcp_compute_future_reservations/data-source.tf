@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_compute_future_reservations" "def" {
+  zone = "asia-southeast1-a"
+}
+
+output "pending_future_reservations" {
+  value = [
+    for item in data.st-gcp_compute_future_reservations.def.items :
+    item.name if item.procurement_status != "FULFILLED"
+  ]
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_compute_image" "def" {
+  family       = "golden-debian-12"
+  projects     = ["my-shared-images", "debian-cloud"]
+  architecture = "X86_64"
+  labels = {
+    approved = "true"
+  }
+}
+
+output "source_image" {
+  value = data.st-gcp_compute_image.def.self_link
+}
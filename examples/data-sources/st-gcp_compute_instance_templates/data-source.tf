@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_compute_instance_templates" "def" {
+  name_prefix = "web-"
+  region      = "global"
+
+  labels = {
+    approved = "true"
+  }
+}
+
+output "latest_template" {
+  value = data.st-gcp_compute_instance_templates.def.latest.self_link
+}
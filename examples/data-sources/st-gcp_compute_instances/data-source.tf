@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_compute_instances" "def" {
+  region       = "asia-southeast1"
+  status       = "RUNNING"
+  network_tags = ["web", "allow-health-check"]
+
+  labels = {
+    env = "prod"
+  }
+}
+
+output "web_instances" {
+  value = {
+    for item in data.st-gcp_compute_instances.def.items :
+    item.name => item.internal_ips
+  }
+}
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_compute_operation" "migration" {
+  name            = "operation-1697011200000-abcdef0123456-01234567-89abcdef"
+  zone            = "asia-east1-a"
+  wait_for_done   = true
+  timeout_seconds = 1200
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.errors) == 0
+      error_message = "The operation failed: ${join(", ", self.errors)}"
+    }
+  }
+}
+
+output "migrated_target" {
+  value = data.st-gcp_compute_operation.migration.target_link
+}
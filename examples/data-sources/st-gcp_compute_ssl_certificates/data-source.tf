@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_compute_ssl_certificates" "def" {
+  type                 = "SELF_MANAGED"
+  expiring_within_days = 30
+}
+
+output "certificates_to_renew" {
+  value = {
+    for item in data.st-gcp_compute_ssl_certificates.def.items :
+    item.name => item.expire_time
+  }
+}
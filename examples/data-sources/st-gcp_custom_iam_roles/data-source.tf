@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_custom_iam_roles" "def" {
+  title_pattern = "^Deployer"
+  stage         = "GA"
+}
+
+output "deployer_role_names" {
+  value = [for role in data.st-gcp_custom_iam_roles.def.items : role.name]
+}
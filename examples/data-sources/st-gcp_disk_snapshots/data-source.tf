@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_disk_snapshots" "latest" {
+  source_disk     = "zones/asia-east1-a/disks/my-disk"
+  newest_per_disk = true
+}
+
+data "st-gcp_disk_snapshots" "expired" {
+  older_than_days = 30
+  labels = {
+    team = "payments"
+  }
+}
+
+output "restore_from" {
+  value = one(data.st-gcp_disk_snapshots.latest.items[*].self_link)
+}
+
+output "expired_snapshots" {
+  value = data.st-gcp_disk_snapshots.expired.items[*].name
+}
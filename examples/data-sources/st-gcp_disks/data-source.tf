@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_disks" "orphaned" {
+  region   = "asia-east1"
+  attached = false
+}
+
+data "st-gcp_disks" "ssd" {
+  type = "pd-ssd"
+  labels = {
+    team = "payments"
+  }
+}
+
+output "orphaned_disks" {
+  value = data.st-gcp_disks.orphaned.items[*].self_link
+}
+
+output "unencrypted_ssd_disks" {
+  value = [
+    for disk in data.st-gcp_disks.ssd.items : disk.name
+    if disk.encryption_type != "CUSTOMER_MANAGED"
+  ]
+}
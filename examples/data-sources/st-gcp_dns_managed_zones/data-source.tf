@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_dns_managed_zones" "def" {
+  dns_name_suffix = "example.com"
+  visibility      = "public"
+}
+
+output "name_servers" {
+  value = {
+    for item in data.st-gcp_dns_managed_zones.def.items :
+    item.dns_name => item.name_servers
+  }
+}
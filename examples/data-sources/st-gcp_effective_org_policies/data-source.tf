@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_effective_org_policies" "project" {
+  constraints = [
+    "compute.vmExternalIpAccess",
+    "gcp.resourceLocations",
+    "iam.disableServiceAccountKeyCreation",
+  ]
+}
+
+locals {
+  org_policies = {
+    for item in data.st-gcp_effective_org_policies.project.items : item.constraint => item
+  }
+}
+
+output "service_account_key_creation_disabled" {
+  value = local.org_policies["constraints/iam.disableServiceAccountKeyCreation"].enforced
+}
+
+output "allowed_locations" {
+  value = local.org_policies["constraints/gcp.resourceLocations"].allowed_values
+}
+
+output "external_ip_denied" {
+  value = local.org_policies["constraints/compute.vmExternalIpAccess"].deny_all
+}
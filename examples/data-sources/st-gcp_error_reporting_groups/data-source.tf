@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_error_reporting_groups" "def" {
+  service           = "web-frontend"
+  version           = "v2"
+  period            = "PERIOD_1_HOUR"
+  resolution_status = "OPEN"
+}
+
+output "error_count" {
+  value = data.st-gcp_error_reporting_groups.def.total_count
+}
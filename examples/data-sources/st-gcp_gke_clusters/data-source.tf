@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_gke_clusters" "def" {
+  location = "asia-east1"
+
+  labels = {
+    env = "prod"
+  }
+}
+
+output "gke_cluster_endpoints" {
+  value = { for cluster in data.st-gcp_gke_clusters.def.items : cluster.name => cluster.endpoint }
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_gke_node_pools" "def" {
+  cluster  = "prod-cluster"
+  location = "asia-east1"
+}
+
+output "node_pools_pending_upgrade" {
+  value = [for pool in data.st-gcp_gke_node_pools.def.items : pool.name if pool.upgrade_available && !pool.upgrading]
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_health_checks" "def" {
+  region = "global"
+  type   = "HTTP"
+
+  tags = {
+    app = "crond"
+  }
+}
+
+output "health_check_self_link" {
+  value = data.st-gcp_health_checks.def.items[0].self_link
+}
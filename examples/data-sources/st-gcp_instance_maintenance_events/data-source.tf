@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_instance_maintenance_events" "workers" {
+  labels = {
+    role = "worker"
+  }
+  lookback_hours = 72
+}
+
+output "instances_to_drain" {
+  value = [
+    for item in data.st-gcp_instance_maintenance_events.workers.items :
+    item.name if item.upcoming_maintenance != null
+  ]
+}
+
+output "preempted_instances" {
+  value = [
+    for item in data.st-gcp_instance_maintenance_events.workers.items :
+    item.name if contains(item.events[*].type, "compute.instances.preempted")
+  ]
+}
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_instance_serial_output" "bootstrap" {
+  instance   = "my-instance"
+  zone       = "asia-east1-a"
+  tail_lines = 100
+  pattern    = "Cloud-init v\\. .* finished"
+
+  lifecycle {
+    postcondition {
+      condition     = self.matched
+      error_message = "cloud-init has not finished on my-instance."
+    }
+  }
+}
+
+output "bootstrap_log" {
+  value = data.st-gcp_instance_serial_output.bootstrap.contents
+}
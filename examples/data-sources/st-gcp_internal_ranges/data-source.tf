@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_internal_ranges" "def" {
+  network = "projects/my-project/global/networks/shared-vpc"
+  usage   = "FOR_VPC"
+
+  labels = {
+    ipam = "managed"
+  }
+}
+
+output "reserved_ranges" {
+  value = {
+    for item in data.st-gcp_internal_ranges.def.items :
+    item.name => item.ip_cidr_range
+  }
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_ip_lookup" "suspicious" {
+  ip_address = "34.80.1.2"
+}
+
+output "owners" {
+  value = [
+    for item in data.st-gcp_ip_lookup.suspicious.items :
+    "${item.resource_type} ${item.name} (${item.location})"
+  ]
+}
+
+output "external" {
+  value = data.st-gcp_ip_lookup.suspicious.external
+}
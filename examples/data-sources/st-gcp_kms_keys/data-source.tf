@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_kms_keys" "def" {
+  location = "asia-east1"
+  purpose  = "ENCRYPT_DECRYPT"
+  labels = {
+    owner = "security"
+  }
+
+  client_config {
+    project = "security-kms"
+  }
+}
+
+output "cmek_key_ids" {
+  value = [for key in data.st-gcp_kms_keys.def.items : key.id if key.primary_version_state == "ENABLED"]
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_load_balancer_backend_service" "def" {
+  tags = {
+    env     = "prod"
+    service = "web"
+  }
+}
+
+output "backend_service_id" {
+  value = data.st-gcp_load_balancer_backend_service.def.item.id
+}
@@ -17,3 +17,7 @@ data "st-gcp_load_balancer_backend_services" "def" {
     app = "crond"
   }
 }
+
+output "backend_service_id" {
+  value = data.st-gcp_load_balancer_backend_services.def.items_map["backend-service-name"].id
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_load_balancer_chain" "def" {
+  ip_address = "34.120.0.10"
+}
+
+output "certificates" {
+  value = data.st-gcp_load_balancer_chain.def.target_proxy.ssl_certificates
+}
+
+output "backend_groups" {
+  value = flatten([
+    for service in data.st-gcp_load_balancer_chain.def.backend_services : service.backends[*].group
+  ])
+}
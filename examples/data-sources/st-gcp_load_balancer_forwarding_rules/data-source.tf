@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_load_balancer_forwarding_rules" "def" {
+  ip_address = "34.120.0.10"
+
+  tags = {
+    env = "test"
+  }
+}
+
+output "target_proxy" {
+  value = data.st-gcp_load_balancer_forwarding_rules.def.items[0].target_proxy
+}
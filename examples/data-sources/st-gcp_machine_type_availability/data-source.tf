@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_machine_type_availability" "def" {
+  region        = "asia-east1"
+  machine_types = ["n1-standard-8"]
+  accelerators = [
+    {
+      type  = "nvidia-tesla-t4"
+      count = 2
+    },
+  ]
+}
+
+output "gpu_zones" {
+  value = data.st-gcp_machine_type_availability.def.available_zones
+}
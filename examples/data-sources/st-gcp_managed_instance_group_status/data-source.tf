@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_managed_instance_group_status" "def" {
+  name   = "web-mig"
+  region = "asia-southeast1"
+}
+
+output "mig_ready_for_traffic" {
+  value = (
+    data.st-gcp_managed_instance_group_status.def.is_stable &&
+    data.st-gcp_managed_instance_group_status.def.version_target_reached &&
+    data.st-gcp_managed_instance_group_status.def.healthy_count == data.st-gcp_managed_instance_group_status.def.target_size
+  )
+}
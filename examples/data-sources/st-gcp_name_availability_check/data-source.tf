@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_name_availability_check" "def" {
+  name          = "backend-service-name"
+  resource_type = "backend_service"
+}
+
+output "name_available" {
+  value = data.st-gcp_name_availability_check.def.available
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_ncc_spokes" "def" {
+  hub = "prod-hub"
+}
+
+output "inactive_spokes" {
+  value = {
+    for item in data.st-gcp_ncc_spokes.def.items :
+    item.name => item.reasons if item.state != "ACTIVE"
+  }
+}
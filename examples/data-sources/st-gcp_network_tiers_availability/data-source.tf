@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_network_tiers_availability" "def" {
+  region = "asia-east1"
+}
+
+output "lb_network_tier" {
+  value = one(data.st-gcp_network_tiers_availability.def.items[*].preferred_tier)
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_networks" "def" {
+  tags = {
+    team = "platform"
+    env  = "prod"
+  }
+}
+
+output "network_subnetworks" {
+  value = {
+    for item in data.st-gcp_networks.def.items :
+    item.name => item.subnetworks
+  }
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_project_iam_policy_query" "def" {
+  member = "group:platform-admins@example.com"
+  role   = "roles/compute.admin"
+}
+
+output "platform_admins_have_compute_admin" {
+  value = data.st-gcp_project_iam_policy_query.def.found
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_pubsub_topics" "def" {
+  name_pattern          = "^orders-"
+  include_subscriptions = true
+  labels = {
+    domain = "orders"
+  }
+}
+
+output "order_topics" {
+  value = { for topic in data.st-gcp_pubsub_topics.def.items : topic.name => topic.subscriptions }
+}
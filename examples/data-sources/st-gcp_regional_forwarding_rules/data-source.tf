@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_regional_forwarding_rules" "def" {
+  region                = "asia-southeast1"
+  load_balancing_scheme = "INTERNAL"
+
+  tags = {
+    app = "crond"
+  }
+}
+
+output "internal_endpoints" {
+  value = {
+    for item in data.st-gcp_regional_forwarding_rules.def.items :
+    item.name => item.ip_address
+  }
+}
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_reservations_and_commitments" "asia_east1" {
+  region       = "asia-east1"
+  machine_type = "n2-standard-8"
+}
+
+locals {
+  # The reservation with the most instances not in use.
+  target_reservation = try(reverse(sort([
+    for r in data.st-gcp_reservations_and_commitments.asia_east1.reservations :
+    format("%010d/%s/%s", r.available_count, r.zone, r.name) if r.available_count > 0
+  ]))[0], null)
+}
+
+output "target_reservation" {
+  value = local.target_reservation
+}
+
+output "active_commitments" {
+  value = [
+    for c in data.st-gcp_reservations_and_commitments.asia_east1.commitments :
+    c.name if c.status == "ACTIVE"
+  ]
+}
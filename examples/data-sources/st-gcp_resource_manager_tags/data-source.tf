@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_resource_manager_tags" "env" {
+  short_name = "env"
+}
+
+output "env_prod_tag_value" {
+  value = data.st-gcp_resource_manager_tags.env.values["123456789/env/prod"]
+}
+
+output "env_tag_values" {
+  value = flatten(data.st-gcp_resource_manager_tags.env.items[*].values[*].short_name)
+}
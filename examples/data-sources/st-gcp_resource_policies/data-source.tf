@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_resource_policies" "def" {
+  region = "asia-east1"
+  type   = "SNAPSHOT_SCHEDULE"
+  tags = {
+    tier = "gold"
+  }
+}
+
+output "snapshot_policy" {
+  value = one(data.st-gcp_resource_policies.def.items[*].self_link)
+}
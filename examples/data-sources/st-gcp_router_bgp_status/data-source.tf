@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_router_bgp_status" "def" {
+  router = "onprem-router"
+  region = "asia-east1"
+}
+
+output "bgp_sessions" {
+  value = {
+    for item in data.st-gcp_router_bgp_status.def.items :
+    item.name => "${item.status} (${item.num_learned_routes} learned, ${item.num_advertised_routes} advertised)"
+  }
+}
+
+output "all_peers_up" {
+  value = data.st-gcp_router_bgp_status.def.all_peers_up
+}
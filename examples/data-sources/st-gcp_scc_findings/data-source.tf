@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_scc_findings" "def" {
+  severities     = ["CRITICAL"]
+  resource_names = ["//storage.googleapis.com/my-bucket"]
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.items) == 0
+      error_message = "Open critical findings exist against the bucket."
+    }
+  }
+}
@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_secret_manager_secrets" "def" {
+  labels = {
+    team = "payments"
+  }
+}
+
+output "secrets_without_rotation" {
+  value = [for secret in data.st-gcp_secret_manager_secrets.def.items : secret.name if secret.rotation_period == ""]
+}
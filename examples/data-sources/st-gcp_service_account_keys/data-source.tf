@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_service_account_keys" "def" {
+  service_account = "deployer@my-project.iam.gserviceaccount.com"
+}
+
+output "oldest_key_age_days" {
+  value = data.st-gcp_service_account_keys.def.max_age_days
+
+  precondition {
+    condition     = data.st-gcp_service_account_keys.def.max_age_days <= 90
+    error_message = "Service account keys older than 90 days must be rotated."
+  }
+}
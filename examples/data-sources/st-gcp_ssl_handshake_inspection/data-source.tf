@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_ssl_handshake_inspection" "def" {
+  host        = "34.120.0.10"
+  server_name = "www.example.com"
+}
+
+output "serving_certificate" {
+  value = {
+    verified    = data.st-gcp_ssl_handshake_inspection.def.verified
+    issuer      = data.st-gcp_ssl_handshake_inspection.def.certificates[0].issuer
+    dns_names   = data.st-gcp_ssl_handshake_inspection.def.certificates[0].dns_names
+    not_after   = data.st-gcp_ssl_handshake_inspection.def.certificates[0].not_after
+    fingerprint = data.st-gcp_ssl_handshake_inspection.def.certificates[0].sha256_fingerprint
+  }
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_ssl_policies" "def" {
+  region = "global"
+}
+
+output "non_compliant_ssl_policies" {
+  value = [
+    for item in data.st-gcp_ssl_policies.def.items :
+    item.name if !contains(["TLS_1_2", "TLS_1_3"], item.min_tls_version) ||
+    !contains(["MODERN", "RESTRICTED"], item.profile)
+  ]
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_subnetworks" "def" {
+  region  = "asia-southeast1"
+  network = "shared-vpc"
+  purpose = "PRIVATE"
+}
+
+output "free_ips" {
+  value = {
+    for item in data.st-gcp_subnetworks.def.items :
+    item.name => item.free_ips
+  }
+}
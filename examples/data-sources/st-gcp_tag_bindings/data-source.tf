@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_tag_bindings" "project" {
+  resource          = "//cloudresourcemanager.googleapis.com/projects/123456789"
+  include_inherited = true
+
+  lifecycle {
+    postcondition {
+      condition     = contains(keys(self.tags), "123456789/cost-center")
+      error_message = "The project must be tagged with cost-center."
+    }
+  }
+}
+
+data "st-gcp_tag_bindings" "instance" {
+  resource = "//compute.googleapis.com/projects/my-project/zones/asia-east1-a/instances/1234567890"
+  location = "asia-east1-a"
+}
+
+output "instance_tags" {
+  value = data.st-gcp_tag_bindings.instance.tags
+}
@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_terraform_state_resources_in_gcs" "def" {
+  bucket      = "terraform-states"
+  prefix      = "env/prod"
+  resource_id = "global/backendServices/backend-service-name"
+}
+
+output "owners" {
+  value = [for item in data.st-gcp_terraform_state_resources_in_gcs.def.items : "${item.state_object}: ${item.address}"]
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+data "st-gcp_zones_regions" "def" {
+  region_prefix = "asia-"
+  status        = "UP"
+}
+
+output "placements" {
+  value = { for region in data.st-gcp_zones_regions.def.regions : region.name => region.zones }
+}
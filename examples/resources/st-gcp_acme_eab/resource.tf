@@ -10,8 +10,14 @@ terraform {
 provider "st-gcp" {}
 
 resource "st-gcp_acme_eab" "eab" {
+  expiry_days = 7
+
+  deactivate_acme_account_on_destroy = true
+  acme_directory_url                 = "https://dv.acme-v02.api.pki.goog/directory"
+  acme_account_key_pem               = file("${path.module}/acme-account-key.pem")
 }
 
 output "eab" {
-  value = st-gcp_acme_eab.eab
+  value     = st-gcp_acme_eab.eab
+  sensitive = true
 }
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_alert_policy_for_lb" "web" {
+  display_name    = "web backend service"
+  backend_service = "https://www.googleapis.com/compute/v1/projects/my-project/global/backendServices/web"
+
+  notification_channels = [
+    "projects/my-project/notificationChannels/1234567890",
+  ]
+
+  latency_threshold_ms  = 800
+  latency_percentile    = 99
+  error_ratio_threshold = 0.02
+  duration_seconds      = 300
+
+  documentation = "Runbook: https://wiki.example.com/runbooks/web-lb"
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_apply_lock" "url_map" {
+  bucket          = "terraform-apply-locks"
+  name            = "shared-url-map"
+  holder          = "web-workspace"
+  timeout_seconds = 900
+  ttl_seconds     = 3600
+
+  triggers = {
+    url_map_hash = sha1(jsonencode(var.host_rules))
+  }
+}
+
+variable "host_rules" {
+  type    = map(string)
+  default = {}
+}
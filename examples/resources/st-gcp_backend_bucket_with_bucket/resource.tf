@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_backend_bucket_with_bucket" "static" {
+  name             = "static-example-com"
+  location         = "ASIA"
+  main_page_suffix = "index.html"
+  not_found_page   = "404.html"
+
+  cache_mode  = "CACHE_ALL_STATIC"
+  default_ttl = 3600
+  max_ttl     = 86400
+
+  url_map = "web-prod"
+  host    = "static.example.com"
+
+  dns_managed_zone = "example-com"
+  dns_record_ip    = "34.120.0.10"
+}
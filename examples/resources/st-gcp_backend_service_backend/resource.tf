@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_backend_service_backend" "def" {
+  backend_service = "shared-web-backend"
+  group           = "projects/my-project/zones/asia-east1-a/instanceGroups/team-a-web"
+  description     = "Web servers of team A"
+  balancing_mode  = "UTILIZATION"
+  max_utilization = 0.8
+  capacity_scaler = 1.0
+}
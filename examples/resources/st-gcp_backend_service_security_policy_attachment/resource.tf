@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_backend_service_security_policy_attachment" "def" {
+  backend_service      = "team-a-web-backend"
+  security_policy      = "projects/my-project/global/securityPolicies/shared-waf-policy"
+  edge_security_policy = "projects/my-project/global/securityPolicies/shared-edge-policy"
+}
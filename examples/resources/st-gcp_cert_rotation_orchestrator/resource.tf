@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_cert_rotation_orchestrator" "www" {
+  name_prefix = "www-example-com"
+  certificate = file("certs/www.example.com.fullchain.pem")
+  private_key = file("certs/www.example.com.key")
+
+  target_https_proxies = ["www-https-proxy"]
+
+  verify_host            = "34.120.0.10"
+  verify_server_name     = "www.example.com"
+  verify_timeout_seconds = 900
+  grace_period_seconds   = 600
+}
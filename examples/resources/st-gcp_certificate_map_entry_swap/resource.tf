@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_certificate_map_entry_swap" "def" {
+  certificate_map       = "my-certificate-map"
+  certificate_map_entry = "www"
+  certificate           = "www-2025"
+  verify                = true
+}
@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_cloud_armor_rule" "deny_scanners" {
+  security_policy = "shared-edge-policy"
+  priority        = 1000
+  action          = "deny(403)"
+  description     = "Block the known scanners of team A"
+  src_ip_ranges   = ["198.51.100.0/24", "203.0.113.0/24"]
+}
+
+resource "st-gcp_cloud_armor_rule" "throttle_login" {
+  security_policy = "shared-edge-policy"
+  priority        = 2000
+  action          = "throttle"
+  description     = "Throttle the login requests per client IP"
+  expression      = "request.path.startsWith('/login')"
+
+  rate_limit_options = {
+    exceed_action          = "deny(429)"
+    threshold_count        = 100
+    threshold_interval_sec = 60
+    enforce_on_key         = "IP"
+  }
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_compute_project_info" "def" {
+  default_network_tier = "PREMIUM"
+  xpn_host             = true
+  usage_export_bucket  = "my-project-usage-export"
+  usage_export_prefix  = "usage"
+  enable_oslogin       = true
+}
+
+output "default_service_account" {
+  value = st-gcp_compute_project_info.def.default_service_account
+}
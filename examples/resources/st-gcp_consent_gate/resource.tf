@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+variable "change_id" {
+  type = string
+}
+
+resource "st-gcp_consent_gate" "def" {
+  gcs_object      = "my-approvals/lb-prod/${var.change_id}"
+  expected_token  = "approved"
+  timeout_seconds = 7200
+
+  triggers = {
+    change_id = var.change_id
+  }
+}
+
+output "approved_at" {
+  value = st-gcp_consent_gate.def.approved_at
+}
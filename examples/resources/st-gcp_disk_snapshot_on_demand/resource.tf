@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_disk_snapshot_on_demand" "def" {
+  disk           = "my-db-data"
+  zone           = "asia-east1-a"
+  name_prefix    = "my-db-data-premigration"
+  retention_days = 14
+
+  labels = {
+    reason = "schema-migration"
+  }
+
+  triggers = {
+    migration = "v42"
+  }
+}
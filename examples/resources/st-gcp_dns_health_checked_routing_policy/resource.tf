@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_dns_health_checked_routing_policy" "def" {
+  managed_zone = "internal-example-com"
+  name         = "api.internal.example.com"
+  ttl          = 30
+
+  primary_forwarding_rules = [
+    "projects/my-project/regions/asia-southeast1/forwardingRules/api-ilb",
+  ]
+
+  backup_geo = [
+    {
+      location = "asia-southeast1"
+      forwarding_rules = [
+        "projects/my-project/regions/asia-east1/forwardingRules/api-ilb",
+      ]
+    },
+  ]
+}
@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_dns_record_sets_batch" "def" {
+  managed_zone = "example-com"
+
+  record_sets = [
+    {
+      name    = "www.example.com."
+      type    = "A"
+      rrdatas = ["203.0.113.10"]
+    },
+    {
+      name    = "api.example.com."
+      type    = "CNAME"
+      ttl     = 60
+      rrdatas = ["www.example.com."]
+    },
+    {
+      name    = "example.com."
+      type    = "TXT"
+      rrdatas = ["\"v=spf1 include:_spf.google.com ~all\""]
+    },
+  ]
+}
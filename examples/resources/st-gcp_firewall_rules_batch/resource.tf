@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_firewall_rules_batch" "def" {
+  network     = "projects/my-project/global/networks/shared-vpc"
+  name_prefix = "team-a"
+  concurrency = 20
+
+  rules = {
+    allow-https = {
+      description   = "HTTPS from the load balancers"
+      protocols     = [{ protocol = "tcp", ports = ["443"] }]
+      source_ranges = ["130.211.0.0/22", "35.191.0.0/16"]
+      target_tags   = ["team-a-web"]
+    }
+    deny-telnet = {
+      action        = "deny"
+      priority      = 900
+      protocols     = [{ protocol = "tcp", ports = ["23"] }]
+      source_ranges = ["0.0.0.0/0"]
+    }
+  }
+}
@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_gcs_state_bucket_bootstrap" "state" {
+  name                              = "my-project-terraform-states"
+  location                          = "ASIA"
+  kms_key_name                      = "projects/my-project/locations/asia/keyRings/terraform/cryptoKeys/state"
+  noncurrent_version_retention_days = 90
+
+  ci_service_accounts = [
+    "terraform-ci@my-project.iam.gserviceaccount.com",
+  ]
+
+  labels = {
+    team = "platform"
+  }
+}
+
+output "state_bucket_url" {
+  value = st-gcp_gcs_state_bucket_bootstrap.state.url
+}
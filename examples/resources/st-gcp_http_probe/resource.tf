@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+# Verify the new load balancer serves the site before DNS is pointed to it.
+resource "st-gcp_http_probe" "new_lb" {
+  url                    = "https://34.120.0.10/healthz"
+  host                   = "www.example.com"
+  expected_status        = 200
+  expected_body_contains = "ok"
+  retries                = 10
+  retry_interval_seconds = 30
+
+  triggers = {
+    certificate = "web-prod-2024"
+  }
+}
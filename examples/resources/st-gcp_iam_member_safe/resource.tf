@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_iam_member_safe" "app_object_viewer" {
+  role   = "roles/storage.objectViewer"
+  member = "serviceAccount:app@my-project.iam.gserviceaccount.com"
+}
+
+resource "st-gcp_iam_member_safe" "contractor_until_2030" {
+  role   = "roles/viewer"
+  member = "user:contractor@example.com"
+
+  condition = {
+    title      = "expires-2030"
+    expression = "request.time < timestamp(\"2030-01-01T00:00:00Z\")"
+  }
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_idle_resource_cleanup" "def" {
+  resource_types = ["address", "disk", "image"]
+  locations      = ["global", "asia-southeast1", "asia-southeast1-a", "asia-southeast1-b"]
+  dry_run        = false
+
+  max_per_type = {
+    disk = 5
+  }
+}
+
+output "cleaned_up_resources" {
+  value = [for action in st-gcp_idle_resource_cleanup.def.actions : action.resource]
+}
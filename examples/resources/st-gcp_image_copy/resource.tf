@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_image_copy" "def" {
+  name         = "web-20240101"
+  source_image = "projects/my-build-project/global/images/web-20240101"
+  kms_key_name = "projects/my-project/locations/global/keyRings/images/cryptoKeys/images"
+
+  labels = {
+    promoted = "true"
+  }
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_instance_labels" "def" {
+  instances = [
+    "projects/my-project/zones/asia-east1-a/instances/my-mig-abcd",
+    "projects/my-project/zones/asia-east1-b/instances/my-mig-efgh",
+  ]
+  labels = {
+    cost-center = "cc-1234"
+    team        = "platform"
+  }
+}
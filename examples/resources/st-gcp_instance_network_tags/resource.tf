@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_instance_network_tags" "def" {
+  instance = "my-instance"
+  zone     = "asia-east1-a"
+  tags     = ["allow-health-check", "allow-ssh"]
+}
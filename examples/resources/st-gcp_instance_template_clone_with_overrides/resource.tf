@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_instance_template_clone_with_overrides" "def" {
+  name            = "web-20240601"
+  source_template = "web-20240501"
+  image           = "projects/my-project/global/images/web-20240601"
+  machine_type    = "e2-standard-4"
+
+  labels = {
+    release = "20240601"
+  }
+}
+
+output "template_self_link" {
+  value = st-gcp_instance_template_clone_with_overrides.def.self_link
+}
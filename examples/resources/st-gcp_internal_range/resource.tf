@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_internal_range" "def" {
+  name               = "gke-pods-prod"
+  description        = "Pod range of the production GKE clusters"
+  network            = "projects/my-project/global/networks/shared-vpc"
+  prefix_length      = 18
+  target_cidr_ranges = ["10.64.0.0/10"]
+
+  labels = {
+    ipam = "managed"
+  }
+}
@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_log_based_metric" "lb_5xx" {
+  name        = "lb_5xx_count"
+  description = "Number of 5xx responses of the load balancers."
+  filter      = "resource.type=\"http_load_balancer\" AND httpRequest.status>=500"
+
+  label_extractors = {
+    backend_service = "EXTRACT(resource.labels.backend_service_name)"
+    status          = "EXTRACT(httpRequest.status)"
+  }
+}
+
+resource "st-gcp_log_based_metric" "lb_latency" {
+  name            = "lb_latency"
+  filter          = "resource.type=\"http_load_balancer\""
+  value_type      = "DISTRIBUTION"
+  unit            = "s"
+  value_extractor = "REGEXP_EXTRACT(httpRequest.latency, \"([0-9.]+)s\")"
+
+  exponential_buckets = {
+    num_finite_buckets = 64
+    growth_factor      = 1.4
+    scale              = 0.001
+  }
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_mig_rolling_action" "def" {
+  instance_group_manager = "my-mig"
+  region                 = "asia-east1"
+  action                 = "RESTART"
+  max_surge              = 0
+  max_unavailable        = 3
+
+  triggers = {
+    config = sha256(file("${path.module}/app.conf"))
+  }
+}
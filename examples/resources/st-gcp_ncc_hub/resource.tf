@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_ncc_hub" "def" {
+  name        = "prod-hub"
+  description = "Hub of the production hub-and-spoke topology"
+
+  labels = {
+    env = "prod"
+  }
+}
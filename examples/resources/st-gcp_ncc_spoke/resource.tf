@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_ncc_spoke" "vpc" {
+  name     = "shared-vpc"
+  location = "global"
+  hub      = "prod-hub"
+
+  linked_vpc_network = {
+    uri                   = "projects/my-project/global/networks/shared-vpc"
+    exclude_export_ranges = ["10.250.0.0/16"]
+  }
+}
+
+resource "st-gcp_ncc_spoke" "vpn" {
+  name        = "onprem-vpn"
+  location    = "asia-east1"
+  hub         = "prod-hub"
+  description = "HA VPN tunnels to the on-premises data center"
+
+  linked_vpn_tunnels = {
+    uris = [
+      "https://www.googleapis.com/compute/v1/projects/my-project/regions/asia-east1/vpnTunnels/onprem-0",
+      "https://www.googleapis.com/compute/v1/projects/my-project/regions/asia-east1/vpnTunnels/onprem-1",
+    ]
+    site_to_site_data_transfer = true
+  }
+}
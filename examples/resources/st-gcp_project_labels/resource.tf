@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_project_labels" "def" {
+  labels = {
+    cost-center = "cc-1234"
+    team        = "platform"
+  }
+}
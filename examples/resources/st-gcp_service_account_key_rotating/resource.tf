@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_service_account_key_rotating" "def" {
+  service_account = "ci-deployer@my-project.iam.gserviceaccount.com"
+  rotation_days   = 30
+  overlap_hours   = 24
+}
+
+output "ci_deployer_key_rotate_at" {
+  value = st-gcp_service_account_key_rotating.def.rotate_at
+}
@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_synthetic_monitor" "def" {
+  name           = "web-frontend-checkout"
+  region         = "asia-southeast1"
+  source_archive = "${path.module}/checker.zip"
+  source_bucket  = "my-project-function-sources"
+  entry_point    = "checkoutFlow"
+  target_url     = "https://www.example.com/checkout"
+  period_seconds = 300
+}
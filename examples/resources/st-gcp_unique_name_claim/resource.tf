@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_unique_name_claim" "backend_service" {
+  bucket = "terraform-name-claims"
+  prefix = "web-prod-"
+  owner  = "web-pipeline"
+}
+
+output "backend_service_name" {
+  value = st-gcp_unique_name_claim.backend_service.name
+}
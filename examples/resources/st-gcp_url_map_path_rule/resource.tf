@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    st-gcp = {
+      source  = "myklst/st-gcp"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "st-gcp" {}
+
+resource "st-gcp_url_map_path_rule" "def" {
+  url_map         = "platform-lb"
+  path_matcher    = "orders"
+  hosts           = ["orders.example.com"]
+  default_service = "projects/my-project/global/backendServices/orders-web"
+
+  path_rules = [
+    {
+      paths   = ["/api/*"]
+      service = "projects/my-project/global/backendServices/orders-api"
+    },
+  ]
+}
@@ -0,0 +1,249 @@
+package gcp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiLatencyBuckets are the upper bounds in seconds of the API latency
+// histogram buckets.
+var apiLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+var (
+	// apiMetricsByFile are the metrics of the provider process by the
+	// metrics file configured, written by WriteMetrics on shutdown.
+	apiMetricsByFile   = map[string]*apiMetrics{}
+	apiMetricsByFileMu sync.Mutex
+)
+
+// apiMetrics records the Google Cloud API calls made by the provider process,
+// which are written to the metrics file in the Prometheus text format when
+// the process exits. The file can be collected by the textfile collector of
+// node_exporter or pushed to Pushgateway by the wrapper of Terraform.
+type apiMetrics struct {
+	mu   sync.Mutex
+	file string
+
+	requests   map[apiRequestKey]int64
+	latencies  map[string]*apiLatencyHistogram
+	retries    map[string]int64
+	rateLimits map[string]int64
+	// failedRequests are the requests whose last attempt failed, the next
+	// attempt of the same request is counted as a retry.
+	failedRequests map[string]bool
+}
+
+type apiRequestKey struct {
+	service string
+	method  string
+	code    string
+}
+
+type apiLatencyHistogram struct {
+	buckets []int64
+	count   int64
+	sum     float64
+}
+
+// getAPIMetrics returns the metrics of the metrics file, the metrics are
+// shared by the provider configurations of the same file, so the file is
+// written once with all their API calls.
+func getAPIMetrics(file string) *apiMetrics {
+	apiMetricsByFileMu.Lock()
+	defer apiMetricsByFileMu.Unlock()
+
+	if m, ok := apiMetricsByFile[file]; ok {
+		return m
+	}
+	m := &apiMetrics{
+		file:           file,
+		requests:       map[apiRequestKey]int64{},
+		latencies:      map[string]*apiLatencyHistogram{},
+		retries:        map[string]int64{},
+		rateLimits:     map[string]int64{},
+		failedRequests: map[string]bool{},
+	}
+	apiMetricsByFile[file] = m
+	return m
+}
+
+// WriteMetrics writes the metrics of the Google Cloud API calls to the
+// metrics files configured. It must be called before the provider process
+// exits, the metrics are not written otherwise.
+func WriteMetrics() error {
+	apiMetricsByFileMu.Lock()
+	defer apiMetricsByFileMu.Unlock()
+
+	var errs []string
+	for _, m := range apiMetricsByFile {
+		if err := m.write(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to write metrics: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// apiMetricsTransport records the metrics of the requests sent through the
+// base transport.
+type apiMetricsTransport struct {
+	base    http.RoundTripper
+	metrics *apiMetrics
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *apiMetricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	latency := time.Since(start)
+
+	code := "error"
+	rateLimited := false
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+		rateLimited = resp.StatusCode == http.StatusTooManyRequests
+		// Some APIs, e.g. Compute Engine API, return 403 with the reason
+		// rateLimitExceeded instead of 429.
+		if resp.StatusCode == http.StatusForbidden {
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			rateLimited = readErr == nil && bytes.Contains(bytes.ToLower(body), []byte("ratelimitexceeded"))
+		}
+	}
+	failed := err != nil || rateLimited || resp.StatusCode >= http.StatusInternalServerError
+
+	t.metrics.record(apiServiceName(req), req.Method, code,
+		req.Method+" "+req.URL.String(), latency, failed, rateLimited)
+	return resp, err
+}
+
+// apiServiceName returns the service name of the request, e.g. compute for
+// compute.googleapis.com.
+func apiServiceName(req *http.Request) string {
+	host := req.URL.Hostname()
+	host = strings.TrimSuffix(host, ".googleapis.com")
+	return strings.TrimSuffix(host, ".mtls")
+}
+
+func (m *apiMetrics) record(service, method, code, request string,
+	latency time.Duration, failed, rateLimited bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests[apiRequestKey{service: service, method: method, code: code}]++
+
+	histogram, ok := m.latencies[service]
+	if !ok {
+		histogram = &apiLatencyHistogram{buckets: make([]int64, len(apiLatencyBuckets))}
+		m.latencies[service] = histogram
+	}
+	for i, bound := range apiLatencyBuckets {
+		if latency.Seconds() <= bound {
+			histogram.buckets[i]++
+		}
+	}
+	histogram.count++
+	histogram.sum += latency.Seconds()
+
+	if m.failedRequests[request] {
+		m.retries[service]++
+	}
+	if failed {
+		m.failedRequests[request] = true
+	} else {
+		delete(m.failedRequests, request)
+	}
+	if rateLimited {
+		m.rateLimits[service]++
+	}
+}
+
+// write writes the metrics to a temporary file and renames it to the metrics
+// file, so that the collectors never read a partially written file.
+func (m *apiMetrics) write() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var buf bytes.Buffer
+
+	buf.WriteString("# HELP st_gcp_api_requests_total Number of Google Cloud API requests.\n")
+	buf.WriteString("# TYPE st_gcp_api_requests_total counter\n")
+	requestKeys := make([]apiRequestKey, 0, len(m.requests))
+	for key := range m.requests {
+		requestKeys = append(requestKeys, key)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		a, b := requestKeys[i], requestKeys[j]
+		if a.service != b.service {
+			return a.service < b.service
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		return a.code < b.code
+	})
+	for _, key := range requestKeys {
+		fmt.Fprintf(&buf, "st_gcp_api_requests_total{service=%q,method=%q,code=%q} %d\n",
+			key.service, key.method, key.code, m.requests[key])
+	}
+
+	buf.WriteString("# HELP st_gcp_api_request_duration_seconds Latency of Google Cloud API requests.\n")
+	buf.WriteString("# TYPE st_gcp_api_request_duration_seconds histogram\n")
+	for _, service := range sortedKeys(m.latencies) {
+		histogram := m.latencies[service]
+		for i, bound := range apiLatencyBuckets {
+			fmt.Fprintf(&buf, "st_gcp_api_request_duration_seconds_bucket{service=%q,le=%q} %d\n",
+				service, strconv.FormatFloat(bound, 'g', -1, 64), histogram.buckets[i])
+		}
+		fmt.Fprintf(&buf, "st_gcp_api_request_duration_seconds_bucket{service=%q,le=\"+Inf\"} %d\n",
+			service, histogram.count)
+		fmt.Fprintf(&buf, "st_gcp_api_request_duration_seconds_sum{service=%q} %g\n", service, histogram.sum)
+		fmt.Fprintf(&buf, "st_gcp_api_request_duration_seconds_count{service=%q} %d\n", service, histogram.count)
+	}
+
+	buf.WriteString("# HELP st_gcp_api_retries_total Number of Google Cloud API requests retried " +
+		"after a failed attempt.\n")
+	buf.WriteString("# TYPE st_gcp_api_retries_total counter\n")
+	for _, service := range sortedKeys(m.retries) {
+		fmt.Fprintf(&buf, "st_gcp_api_retries_total{service=%q} %d\n", service, m.retries[service])
+	}
+
+	buf.WriteString("# HELP st_gcp_api_rate_limited_total Number of Google Cloud API requests " +
+		"rejected by rate limits.\n")
+	buf.WriteString("# TYPE st_gcp_api_rate_limited_total counter\n")
+	for _, service := range sortedKeys(m.rateLimits) {
+		fmt.Fprintf(&buf, "st_gcp_api_rate_limited_total{service=%q} %d\n", service, m.rateLimits[service])
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(m.file), filepath.Base(m.file)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	// The temporary file is only readable by the owner, the metrics file
+	// must be readable by the collectors.
+	if err := tmpFile.Chmod(0o644); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if _, err := tmpFile.Write(buf.Bytes()); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), m.file)
+}
@@ -0,0 +1,38 @@
+package gcp
+
+import (
+	"strings"
+)
+
+const (
+	changeReferenceKey          = "change-reference"
+	maxChangeReferenceLabelSize = 63
+)
+
+// changeReferenceLabel returns the change reference converted to a valid
+// label value, which only contains lowercase letters, numbers, underscores
+// and dashes, with at most 63 characters.
+func (c *gcpClients) changeReferenceLabel() string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(c.changeReference) {
+		if sb.Len() >= maxChangeReferenceLabelSize {
+			break
+		}
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+	return sb.String()
+}
+
+// withChangeReferenceMetadata returns the metadata with the change reference
+// added if it is configured.
+func (c *gcpClients) withChangeReferenceMetadata(metadata map[string]string) map[string]string {
+	if c.changeReference != "" {
+		metadata[changeReferenceKey] = c.changeReference
+	}
+	return metadata
+}
@@ -0,0 +1,221 @@
+package gcp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+)
+
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// clientConfig is the client_config block used to override the default
+// client created in Provider.
+type clientConfig struct {
+	Project                   types.String `tfsdk:"project"`
+	Credentials               types.String `tfsdk:"credentials"`
+	ImpersonateServiceAccount types.String `tfsdk:"impersonate_service_account"`
+	AccessToken               types.String `tfsdk:"access_token"`
+}
+
+const (
+	clientConfigProjectDescription = "Project Name for Google Cloud API. Default " +
+		"to use project configured in the provider."
+	clientConfigCredentialsDescription = "The credentials of service account in JSON format. " +
+		"Default to use credentials configured in the provider."
+	clientConfigImpersonateServiceAccountDescription = "Email of the service account to " +
+		"impersonate. The credentials or access token of this block, or the credentials " +
+		"configured in the provider are used to impersonate the service account."
+	clientConfigAccessTokenDescription = "OAuth2 access token for Google Cloud API. Takes " +
+		"precedence over credentials when both are set."
+)
+
+// clientConfigBlock returns the schema of client_config block for data
+// sources.
+func clientConfigBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Config to override default client created in Provider. " +
+			"Attributes not set are inherited from the provider. " +
+			"This block will not be recorded in state file.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: clientConfigProjectDescription,
+				Optional:    true,
+			},
+			"credentials": schema.StringAttribute{
+				Description: clientConfigCredentialsDescription,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"impersonate_service_account": schema.StringAttribute{
+				Description: clientConfigImpersonateServiceAccountDescription,
+				Optional:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: clientConfigAccessTokenDescription,
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// clientConfigResourceBlock returns the schema of client_config block for
+// resources. Unlike data sources, the block is recorded in state so that the
+// resource is refreshed and destroyed with the same client.
+func clientConfigResourceBlock() resourceschema.SingleNestedBlock {
+	return resourceschema.SingleNestedBlock{
+		Description: "Config to override default client created in Provider. " +
+			"Attributes not set are inherited from the provider.",
+		Attributes: map[string]resourceschema.Attribute{
+			"project": resourceschema.StringAttribute{
+				Description: clientConfigProjectDescription,
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"credentials": resourceschema.StringAttribute{
+				Description: clientConfigCredentialsDescription,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"impersonate_service_account": resourceschema.StringAttribute{
+				Description: clientConfigImpersonateServiceAccountDescription,
+				Optional:    true,
+			},
+			"access_token": resourceschema.StringAttribute{
+				Description: clientConfigAccessTokenDescription,
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// newClientOptions builds the Google Cloud API client options. The access
+// token takes precedence over the credentials JSON, and the resulting
+// credentials are used to impersonate the service account if it is set.
+func newClientOptions(ctx context.Context, credentialsJSON []byte,
+	accessToken, impersonateServiceAccount string) ([]option.ClientOption, error) {
+	var clientOptions []option.ClientOption
+	if accessToken != "" {
+		clientOptions = append(clientOptions, option.WithTokenSource(
+			oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})))
+	} else {
+		clientOptions = append(clientOptions, option.WithCredentialsJSON(credentialsJSON))
+	}
+
+	if impersonateServiceAccount != "" {
+		tokenSource, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+			TargetPrincipal: impersonateServiceAccount,
+			Scopes:          []string{cloudPlatformScope},
+		}, clientOptions...)
+		if err != nil {
+			return nil, err
+		}
+		clientOptions = []option.ClientOption{option.WithTokenSource(tokenSource)}
+	}
+	return clientOptions, nil
+}
+
+// initClients initializes the Google Cloud API clients with the credentials
+// JSON of the clients, or the access token and the service account to
+// impersonate if they are set. The API calls are recorded in the metrics if
+// the metrics file is configured, and traced if tracing is enabled.
+func (c *gcpClients) initClients(ctx context.Context, accessToken, impersonateServiceAccount string) error {
+	clientOptions, err := newClientOptions(ctx, c.credentialsJSON, accessToken, impersonateServiceAccount)
+	if err != nil {
+		return err
+	}
+	if c.changeReference != "" {
+		clientOptions = append(clientOptions, option.WithRequestReason(c.changeReference))
+	}
+	if c.metrics != nil || tracingEnabled {
+		var base http.RoundTripper = http.DefaultTransport
+		if c.metrics != nil {
+			base = &apiMetricsTransport{base: base, metrics: c.metrics}
+		}
+		if tracingEnabled {
+			base = &apiTracingTransport{base: base}
+		}
+		transport, err := htransport.NewTransport(ctx, base,
+			append(clientOptions, option.WithScopes(cloudPlatformScope))...)
+		if err != nil {
+			return err
+		}
+		clientOptions = []option.ClientOption{option.WithHTTPClient(&http.Client{Transport: transport})}
+	}
+
+	computeService, err := googleComputeClient.NewService(ctx, clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	c.clientOptions = clientOptions
+	c.computeClient = computeService
+	return nil
+}
+
+// withClientConfig returns the clients overridden by the client_config
+// block. The clients configured in Provider are returned as is if nothing
+// is overridden.
+func (c *gcpClients) withClientConfig(ctx context.Context,
+	config *clientConfig) (*gcpClients, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if config == nil {
+		return c, diags
+	}
+
+	clients := *c
+	if config.Project.ValueString() != "" {
+		clients.project = config.Project.ValueString()
+	}
+
+	credentials := config.Credentials.ValueString()
+	accessToken := config.AccessToken.ValueString()
+	impersonateServiceAccount := config.ImpersonateServiceAccount.ValueString()
+	if credentials == "" && accessToken == "" && impersonateServiceAccount == "" {
+		return &clients, diags
+	}
+
+	if credentials != "" {
+		clients.credentialsJSON = []byte(credentials)
+	}
+	if err := clients.initClients(ctx, accessToken, impersonateServiceAccount); err != nil {
+		diags.AddError(
+			"[API ERROR] Failed to Reinitialize Google Cloud client",
+			"Please make sure the credentials is valid.\n"+
+				"Additional error message: "+err.Error(),
+		)
+		return nil, diags
+	}
+	return &clients, diags
+}
+
+// applyClientConfig replaces the clients of resource by the clients
+// overridden by the client_config block, adds an error to the diagnostics and
+// returns true if the clients failed to be initialized. The resources are
+// created per request by the framework, so the override only applies to the
+// current request.
+func applyClientConfig(ctx context.Context, clients **gcpClients,
+	config *clientConfig, diags *diag.Diagnostics) bool {
+	overridden, d := (*clients).withClientConfig(ctx, config)
+	diags.Append(d...)
+	if d.HasError() {
+		return true
+	}
+	*clients = overridden
+	return false
+}
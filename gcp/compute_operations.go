@@ -0,0 +1,41 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// waitComputeOperation waits until the compute operation is done, and
+// returns the errors of the operation if it failed. The operation is waited
+// in its own scope, which is the zone, the region or global.
+func waitComputeOperation(ctx context.Context, client *googleComputeClient.Service,
+	project string, op *googleComputeClient.Operation) error {
+	var err error
+	for op.Status != "DONE" {
+		switch {
+		case op.Zone != "":
+			op, err = client.ZoneOperations.Wait(project, resourceNameFromSelfLink(op.Zone), op.Name).
+				Context(ctx).Do()
+		case op.Region != "":
+			op, err = client.RegionOperations.Wait(project, resourceNameFromSelfLink(op.Region), op.Name).
+				Context(ctx).Do()
+		default:
+			op, err = client.GlobalOperations.Wait(project, op.Name).Context(ctx).Do()
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		messages := make([]string, 0, len(op.Error.Errors))
+		for _, e := range op.Error.Errors {
+			messages = append(messages, e.Code+": "+e.Message)
+		}
+		return fmt.Errorf("operation %s failed: %s", op.Name, strings.Join(messages, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package gcp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	cronMonthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	cronWeekdayNames = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+)
+
+// cronSchedule is a parsed standard 5 fields cron expression, i.e. minute,
+// hour, day of month, month and day of week. Each field supports *, lists,
+// ranges and steps, the months and the days of week also support the 3
+// letters names.
+type cronSchedule struct {
+	minutes     map[int]bool
+	hours       map[int]bool
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+	// Day of month and day of week are matched if either of them matches
+	// when both of them are restricted, same as the standard cron. The field
+	// covering every day, e.g. * or 0-6, is not restricted.
+	dayOfMonthStar bool
+	dayOfWeekStar  bool
+}
+
+// parseCronSchedule parses the standard 5 fields cron expression.
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields", expr)
+	}
+
+	schedule := &cronSchedule{}
+	var err error
+	if schedule.minutes, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid minute of cron expression %q: %v", expr, err)
+	}
+	if schedule.hours, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid hour of cron expression %q: %v", expr, err)
+	}
+	if schedule.daysOfMonth, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid day of month of cron expression %q: %v", expr, err)
+	}
+	if schedule.months, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("invalid month of cron expression %q: %v", expr, err)
+	}
+	// 7 is also Sunday.
+	if schedule.daysOfWeek, err = parseCronField(fields[4], 0, 7, cronWeekdayNames); err != nil {
+		return nil, fmt.Errorf("invalid day of week of cron expression %q: %v", expr, err)
+	}
+	if schedule.daysOfWeek[7] {
+		schedule.daysOfWeek[0] = true
+	}
+	schedule.dayOfMonthStar = cronFieldCovers(schedule.daysOfMonth, 1, 31)
+	schedule.dayOfWeekStar = cronFieldCovers(schedule.daysOfWeek, 0, 6)
+	return schedule, nil
+}
+
+// parseCronField parses a cron field into the set of the values matched.
+func parseCronField(field string, min, max int, names map[string]int) (map[int]bool, error) {
+	values := map[int]bool{}
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step %q", stepPart)
+			}
+		}
+
+		start, end := min, max
+		if rangePart != "*" {
+			startPart, endPart, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = parseCronValue(startPart, min, max, names); err != nil {
+				return nil, err
+			}
+			end = start
+			if isRange {
+				if end, err = parseCronValue(endPart, min, max, names); err != nil {
+					return nil, err
+				}
+			} else if hasStep {
+				end = max
+			}
+			if start > end {
+				return nil, fmt.Errorf("invalid range %q", rangePart)
+			}
+		}
+		for value := start; value <= end; value += step {
+			values[value] = true
+		}
+	}
+	return values, nil
+}
+
+// cronFieldCovers returns true if the values matched include every value from
+// min to max.
+func cronFieldCovers(values map[int]bool, min, max int) bool {
+	for value := min; value <= max; value++ {
+		if !values[value] {
+			return false
+		}
+	}
+	return true
+}
+
+func parseCronValue(value string, min, max int, names map[string]int) (int, error) {
+	if number, ok := names[strings.ToUpper(value)]; ok {
+		return number, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil || number < min || number > max {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	return number, nil
+}
+
+// match returns true if the minute of the time matches the schedule.
+func (s *cronSchedule) match(t time.Time) bool {
+	if !s.minutes[t.Minute()] || !s.hours[t.Hour()] || !s.months[int(t.Month())] {
+		return false
+	}
+	dayOfMonth := s.daysOfMonth[t.Day()]
+	dayOfWeek := s.daysOfWeek[int(t.Weekday())]
+	if s.dayOfMonthStar || s.dayOfWeekStar {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// lastMatchSince returns the latest time matching the schedule within the
+// duration until the time, the time is truncated to minute.
+func (s *cronSchedule) lastMatchSince(t time.Time, d time.Duration) (time.Time, bool) {
+	t = t.Truncate(time.Minute)
+	for candidate := t; !candidate.Before(t.Add(-d)); candidate = candidate.Add(-time.Minute) {
+		if s.match(candidate) {
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// nextMatch returns the earliest time matching the schedule after the time
+// within the duration, the time is truncated to minute.
+func (s *cronSchedule) nextMatch(t time.Time, d time.Duration) (time.Time, bool) {
+	t = t.Truncate(time.Minute)
+	for candidate := t.Add(time.Minute); !candidate.After(t.Add(d)); candidate = candidate.Add(time.Minute) {
+		if s.match(candidate) {
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,288 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &AddressesDataSource{}
+	_ datasource.DataSourceWithConfigure = &AddressesDataSource{}
+)
+
+// NewAddressesDataSource
+func NewAddressesDataSource() datasource.DataSource {
+	return &AddressesDataSource{}
+}
+
+// AddressesDataSource
+type AddressesDataSource struct {
+	client *gcpClients
+}
+
+// AddressesDataSourceModel
+type AddressesDataSourceModel struct {
+	ClientConfig *clientConfig         `tfsdk:"client_config"`
+	Name         types.String          `tfsdk:"name"`
+	Labels       types.Map             `tfsdk:"labels"`
+	Status       types.String          `tfsdk:"status"`
+	AddressType  types.String          `tfsdk:"address_type"`
+	NetworkTier  types.String          `tfsdk:"network_tier"`
+	Region       types.String          `tfsdk:"region"`
+	Items        []*addressesItemModel `tfsdk:"items"`
+}
+
+type addressesItemModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Description types.String `tfsdk:"description"`
+	Address     types.String `tfsdk:"address"`
+	AddressType types.String `tfsdk:"address_type"`
+	IPVersion   types.String `tfsdk:"ip_version"`
+	Status      types.String `tfsdk:"status"`
+	NetworkTier types.String `tfsdk:"network_tier"`
+	Purpose     types.String `tfsdk:"purpose"`
+	Region      types.String `tfsdk:"region"`
+	Users       types.List   `tfsdk:"users"`
+	SelfLink    types.String `tfsdk:"self_link"`
+}
+
+// Metadata returns the data source addresses type name.
+func (d *AddressesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_addresses"
+}
+
+// Schema defines the schema for the addresses data source.
+func (d *AddressesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the reserved static IP addresses on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of address to be filtered.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of address to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of address to be filtered, valid values are " +
+					"RESERVED, RESERVING and IN_USE.",
+				Optional: true,
+			},
+			"address_type": schema.StringAttribute{
+				Description: "Type of address to be filtered, valid values are " +
+					"EXTERNAL and INTERNAL.",
+				Optional: true,
+			},
+			"network_tier": schema.StringAttribute{
+				Description: "Network tier of address to be filtered, valid values are " +
+					"PREMIUM and STANDARD.",
+				Optional: true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of addresses to be filtered, or global for the " +
+					"global addresses. Default to list the addresses in all scopes.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried addresses.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of address.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of address.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of address.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of address.",
+							Computed:    true,
+						},
+						"address": schema.StringAttribute{
+							Description: "IP address.",
+							Computed:    true,
+						},
+						"address_type": schema.StringAttribute{
+							Description: "Type of address, EXTERNAL or INTERNAL.",
+							Computed:    true,
+						},
+						"ip_version": schema.StringAttribute{
+							Description: "IP version of address.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of address.",
+							Computed:    true,
+						},
+						"network_tier": schema.StringAttribute{
+							Description: "Network tier of address.",
+							Computed:    true,
+						},
+						"purpose": schema.StringAttribute{
+							Description: "Purpose of address, e.g. GCE_ENDPOINT or VPC_PEERING.",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of address, empty for the global addresses.",
+							Computed:    true,
+						},
+						"users": schema.ListAttribute{
+							Description: "Self links of the resources using address.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of address.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *AddressesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read addresses data source information
+func (d *AddressesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *AddressesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &AddressesDataSourceModel{
+		Name:        plan.Name,
+		Labels:      plan.Labels,
+		Status:      plan.Status,
+		AddressType: plan.AddressType,
+		NetworkTier: plan.NetworkTier,
+		Region:      plan.Region,
+		Items:       []*addressesItemModel{},
+	}
+
+	appendItems := func(addresses []*googleComputeClient.Address) {
+		for _, address := range addresses {
+			if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != address.Name {
+				continue
+			}
+			if !(plan.Status.IsUnknown() || plan.Status.IsNull()) && plan.Status.ValueString() != address.Status {
+				continue
+			}
+			if !(plan.AddressType.IsUnknown() || plan.AddressType.IsNull()) &&
+				plan.AddressType.ValueString() != address.AddressType {
+				continue
+			}
+			if !(plan.NetworkTier.IsUnknown() || plan.NetworkTier.IsNull()) &&
+				plan.NetworkTier.ValueString() != address.NetworkTier {
+				continue
+			}
+			labels, labelsTfType := labelsValue(address.Labels)
+			if !matchTags(plan.Labels, labels) {
+				continue
+			}
+			state.Items = append(state.Items, newAddressesItem(address, labelsTfType))
+		}
+	}
+
+	var err error
+	switch region := plan.Region.ValueString(); region {
+	case "":
+		err = clients.computeClient.Addresses.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.AddressAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendItems(page.Items[scope].Addresses)
+				}
+				return nil
+			})
+	case globalScope:
+		err = clients.computeClient.GlobalAddresses.List(clients.project).Pages(ctx,
+			func(page *googleComputeClient.AddressList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	default:
+		err = clients.computeClient.Addresses.List(clients.project, region).Pages(ctx,
+			func(page *googleComputeClient.AddressList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list addresses.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newAddressesItem converts the address into queried item.
+func newAddressesItem(address *googleComputeClient.Address,
+	labels types.Map) *addressesItemModel {
+	users := []attr.Value{}
+	for _, user := range address.Users {
+		users = append(users, types.StringValue(user))
+	}
+
+	return &addressesItemModel{
+		ID:          types.Int64Value(int64(address.Id)),
+		Name:        types.StringValue(address.Name),
+		Labels:      labels,
+		Description: types.StringValue(address.Description),
+		Address:     types.StringValue(address.Address),
+		AddressType: types.StringValue(address.AddressType),
+		IPVersion:   types.StringValue(address.IpVersion),
+		Status:      types.StringValue(address.Status),
+		NetworkTier: types.StringValue(address.NetworkTier),
+		Purpose:     types.StringValue(address.Purpose),
+		Region:      types.StringValue(resourceNameFromSelfLink(address.Region)),
+		Users:       types.ListValueMust(types.StringType, users),
+		SelfLink:    types.StringValue(address.SelfLink),
+	}
+}
@@ -0,0 +1,232 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &AnycastIPHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &AnycastIPHealthDataSource{}
+)
+
+// NewAnycastIPHealthDataSource
+func NewAnycastIPHealthDataSource() datasource.DataSource {
+	return &AnycastIPHealthDataSource{}
+}
+
+// AnycastIPHealthDataSource
+type AnycastIPHealthDataSource struct {
+	client *gcpClients
+}
+
+// AnycastIPHealthDataSourceModel
+type AnycastIPHealthDataSourceModel struct {
+	ClientConfig       *clientConfig `tfsdk:"client_config"`
+	ForwardingRule     types.String  `tfsdk:"forwarding_rule"`
+	Scheme             types.String  `tfsdk:"scheme"`
+	Port               types.Int64   `tfsdk:"port"`
+	Path               types.String  `tfsdk:"path"`
+	Host               types.String  `tfsdk:"host"`
+	ExpectedStatus     types.Int64   `tfsdk:"expected_status"`
+	TimeoutSeconds     types.Int64   `tfsdk:"timeout_seconds"`
+	InsecureSkipVerify types.Bool    `tfsdk:"insecure_skip_verify"`
+	AllowMissing       types.Bool    `tfsdk:"allow_missing"`
+	IPAddress          types.String  `tfsdk:"ip_address"`
+	URL                types.String  `tfsdk:"url"`
+	Reachable          types.Bool    `tfsdk:"reachable"`
+	StatusCode         types.Int64   `tfsdk:"status_code"`
+	LatencyMs          types.Int64   `tfsdk:"latency_ms"`
+	Error              types.String  `tfsdk:"error"`
+}
+
+// Metadata returns the data source anycast IP health type name.
+func (d *AnycastIPHealthDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_anycast_ip_health"
+}
+
+// Schema defines the schema for the anycast IP health data source.
+func (d *AnycastIPHealthDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source probes the anycast IP of a global forwarding rule " +
+			"from the provider host and reports the reachability and latency.",
+		Attributes: map[string]schema.Attribute{
+			"forwarding_rule": schema.StringAttribute{
+				Description: "Name of the global forwarding rule to be probed.",
+				Required:    true,
+			},
+			"scheme": schema.StringAttribute{
+				Description: "Scheme of the probe, http or https. Default to https if " +
+					"the port is 443, otherwise http.",
+				Optional: true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port of the probe. Default to the first port of the port " +
+					"range of the forwarding rule.",
+				Optional: true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the probe. Default to " + defaultProbePath + ".",
+				Optional:    true,
+			},
+			"host": schema.StringAttribute{
+				Description: "Host header and TLS server name of the probe, e.g. www.example.com.",
+				Optional:    true,
+			},
+			"expected_status": schema.Int64Attribute{
+				Description: "Expected HTTP status code. Default to " +
+					strconv.Itoa(defaultProbeExpectedStatus) + ".",
+				Optional: true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Timeout in seconds of the probe. Default to " +
+					strconv.Itoa(defaultProbeTimeoutSecs) + ".",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip the verification of the server certificate. Default to false.",
+				Optional:    true,
+			},
+			"allow_missing": allowMissingAttribute("forwarding rule"),
+			"ip_address": schema.StringAttribute{
+				Description: "Anycast IP address of the forwarding rule.",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL probed.",
+				Computed:    true,
+			},
+			"reachable": schema.BoolAttribute{
+				Description: "Whether the expected status code is returned.",
+				Computed:    true,
+			},
+			"status_code": schema.Int64Attribute{
+				Description: "HTTP status code returned, 0 if the request failed.",
+				Computed:    true,
+			},
+			"latency_ms": schema.Int64Attribute{
+				Description: "Latency in milliseconds until the response headers are received.",
+				Computed:    true,
+			},
+			"error": schema.StringAttribute{
+				Description: "Error of the request, empty if a response is received.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *AnycastIPHealthDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read anycast IP health data source information
+func (d *AnycastIPHealthDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *AnycastIPHealthDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := clients.computeClient.GlobalForwardingRules.Get(clients.project,
+		plan.ForwardingRule.ValueString()).Context(ctx).Do()
+	if isMissingAllowed(plan.AllowMissing, err) {
+		state := plan
+		state.IPAddress = types.StringValue("")
+		state.URL = types.StringValue("")
+		state.Reachable = types.BoolValue(false)
+		state.StatusCode = types.Int64Value(0)
+		state.LatencyMs = types.Int64Value(0)
+		state.Error = types.StringValue("")
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get global forwarding rule.", err.Error())
+		return
+	}
+
+	port := plan.Port.ValueInt64()
+	if plan.Port.IsNull() {
+		port, err = strconv.ParseInt(strings.Split(rule.PortRange, "-")[0], 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to get port of forwarding rule.",
+				fmt.Sprintf("Invalid port range %q, please set port explicitly.", rule.PortRange),
+			)
+			return
+		}
+	}
+	scheme := plan.Scheme.ValueString()
+	if scheme == "" {
+		scheme = "http"
+		if port == 443 {
+			scheme = "https"
+		}
+	}
+	path := defaultProbePath
+	if plan.Path.ValueString() != "" {
+		path = "/" + strings.TrimPrefix(plan.Path.ValueString(), "/")
+	}
+	expectedStatus := int64(defaultProbeExpectedStatus)
+	if !plan.ExpectedStatus.IsNull() {
+		expectedStatus = plan.ExpectedStatus.ValueInt64()
+	}
+	timeout := time.Duration(defaultProbeTimeoutSecs) * time.Second
+	if !plan.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(plan.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+
+	url := fmt.Sprintf("%s://%s%s", scheme,
+		net.JoinHostPort(rule.IPAddress, strconv.FormatInt(port, 10)), path)
+	result := probeHTTP(ctx, &httpProbeRequest{
+		url:                url,
+		host:               plan.Host.ValueString(),
+		timeout:            timeout,
+		insecureSkipVerify: plan.InsecureSkipVerify.ValueBool(),
+	})
+
+	state := plan
+	state.IPAddress = types.StringValue(rule.IPAddress)
+	state.URL = types.StringValue(url)
+	state.Reachable = types.BoolValue(result.err == nil && int64(result.statusCode) == expectedStatus)
+	state.StatusCode = types.Int64Value(int64(result.statusCode))
+	state.LatencyMs = types.Int64Value(result.latency.Milliseconds())
+	state.Error = types.StringValue("")
+	if result.err != nil {
+		state.Error = types.StringValue(result.err.Error())
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,285 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+	googleMonitoringClient "google.golang.org/api/monitoring/v3"
+)
+
+const (
+	defaultRuleHitCountsWindowDays    = 30
+	defaultRuleHitCountsPriorityLabel = "priority"
+	defaultRuleHitCountsPolicyLabel   = "policy_name"
+	securityPolicyDefaultRulePriority = 2147483647
+)
+
+var (
+	_ datasource.DataSource              = &ArmorPolicyRuleHitCountsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ArmorPolicyRuleHitCountsDataSource{}
+)
+
+// NewArmorPolicyRuleHitCountsDataSource
+func NewArmorPolicyRuleHitCountsDataSource() datasource.DataSource {
+	return &ArmorPolicyRuleHitCountsDataSource{}
+}
+
+// ArmorPolicyRuleHitCountsDataSource
+type ArmorPolicyRuleHitCountsDataSource struct {
+	client *gcpClients
+}
+
+// ArmorPolicyRuleHitCountsDataSourceModel
+type ArmorPolicyRuleHitCountsDataSourceModel struct {
+	ClientConfig         *clientConfig                        `tfsdk:"client_config"`
+	Policy               types.String                         `tfsdk:"policy"`
+	Region               types.String                         `tfsdk:"region"`
+	AllowMissing         types.Bool                           `tfsdk:"allow_missing"`
+	MetricType           types.String                         `tfsdk:"metric_type"`
+	PolicyLabel          types.String                         `tfsdk:"policy_label"`
+	PriorityLabel        types.String                         `tfsdk:"priority_label"`
+	WindowDays           types.Int64                          `tfsdk:"window_days"`
+	WindowStart          types.String                         `tfsdk:"window_start"`
+	WindowEnd            types.String                         `tfsdk:"window_end"`
+	Rules                []*armorPolicyRuleHitCountsItemModel `tfsdk:"rules"`
+	UnusedRulePriorities types.List                           `tfsdk:"unused_rule_priorities"`
+}
+
+type armorPolicyRuleHitCountsItemModel struct {
+	Priority    types.Int64  `tfsdk:"priority"`
+	Action      types.String `tfsdk:"action"`
+	Description types.String `tfsdk:"description"`
+	Preview     types.Bool   `tfsdk:"preview"`
+	HitCount    types.Int64  `tfsdk:"hit_count"`
+}
+
+// Metadata returns the data source Cloud Armor policy rule hit counts type name.
+func (d *ArmorPolicyRuleHitCountsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_armor_policy_rule_hit_counts"
+}
+
+// Schema defines the schema for the Cloud Armor policy rule hit counts data source.
+func (d *ArmorPolicyRuleHitCountsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the hit counts of every rule of a Cloud " +
+			"Armor security policy over a window from Cloud Monitoring. The built-in " +
+			"Cloud Armor metrics are not broken down by rule, so the counts are read " +
+			"from a counter metric labeled with the policy name and the rule priority, " +
+			"e.g. a log-based metric on the load balancer request logs extracting " +
+			"jsonPayload.enforcedSecurityPolicy.name and jsonPayload.enforcedSecurityPolicy.priority.",
+		Attributes: map[string]schema.Attribute{
+			"policy": schema.StringAttribute{
+				Description: "Name of security policy.",
+				Required:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of security policy. Default to the global security policy.",
+				Optional:    true,
+			},
+			"allow_missing": allowMissingAttribute("security policy"),
+			"metric_type": schema.StringAttribute{
+				Description: "Type of the counter metric of the rule hits, e.g. " +
+					"logging.googleapis.com/user/armor_rule_hits.",
+				Required: true,
+			},
+			"policy_label": schema.StringAttribute{
+				Description: "Metric label of the security policy name. Default to " +
+					defaultRuleHitCountsPolicyLabel + ".",
+				Optional: true,
+			},
+			"priority_label": schema.StringAttribute{
+				Description: "Metric label of the rule priority. Default to " +
+					defaultRuleHitCountsPriorityLabel + ".",
+				Optional: true,
+			},
+			"window_days": schema.Int64Attribute{
+				Description: "Number of days until now to count the hits. Default to " +
+					strconv.Itoa(defaultRuleHitCountsWindowDays) + ".",
+				Optional: true,
+			},
+			"window_start": schema.StringAttribute{
+				Description: "Start time of the window in RFC3339 format.",
+				Computed:    true,
+			},
+			"window_end": schema.StringAttribute{
+				Description: "End time of the window in RFC3339 format.",
+				Computed:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Rules of security policy with their hit counts, ordered by priority.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.Int64Attribute{
+							Description: "Priority of rule.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action of rule.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of rule.",
+							Computed:    true,
+						},
+						"preview": schema.BoolAttribute{
+							Description: "Whether the rule is in preview mode.",
+							Computed:    true,
+						},
+						"hit_count": schema.Int64Attribute{
+							Description: "Number of requests matched by rule in the window.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"unused_rule_priorities": schema.ListAttribute{
+				Description: "Priorities of the rules without hits in the window, " +
+					"excluding the default rule.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ArmorPolicyRuleHitCountsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Cloud Armor policy rule hit counts data source information
+func (d *ArmorPolicyRuleHitCountsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ArmorPolicyRuleHitCountsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policy *googleComputeClient.SecurityPolicy
+	var err error
+	if region := plan.Region.ValueString(); region != "" {
+		policy, err = clients.computeClient.RegionSecurityPolicies.Get(clients.project, region,
+			plan.Policy.ValueString()).Context(ctx).Do()
+	} else {
+		policy, err = clients.computeClient.SecurityPolicies.Get(clients.project,
+			plan.Policy.ValueString()).Context(ctx).Do()
+	}
+	if isMissingAllowed(plan.AllowMissing, err) {
+		policy, err = &googleComputeClient.SecurityPolicy{}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get security policy.", err.Error())
+		return
+	}
+
+	monitoringClient, err := googleMonitoringClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Monitoring client", err.Error())
+		return
+	}
+
+	policyLabel := defaultRuleHitCountsPolicyLabel
+	if plan.PolicyLabel.ValueString() != "" {
+		policyLabel = plan.PolicyLabel.ValueString()
+	}
+	priorityLabel := defaultRuleHitCountsPriorityLabel
+	if plan.PriorityLabel.ValueString() != "" {
+		priorityLabel = plan.PriorityLabel.ValueString()
+	}
+	windowDays := int64(defaultRuleHitCountsWindowDays)
+	if !plan.WindowDays.IsNull() {
+		windowDays = plan.WindowDays.ValueInt64()
+	}
+	windowEnd := time.Now().UTC().Truncate(time.Minute)
+	windowStart := windowEnd.AddDate(0, 0, -int(windowDays))
+
+	// The hits are summed per priority in a single alignment period covering
+	// the window, points of the same priority are added up in case the window
+	// is split.
+	hitCounts := map[int64]int64{}
+	err = monitoringClient.Projects.TimeSeries.List("projects/"+clients.project).
+		Filter(fmt.Sprintf(`metric.type = %q AND metric.labels.%s = %q`,
+			plan.MetricType.ValueString(), policyLabel, policy.Name)).
+		IntervalStartTime(windowStart.Format(time.RFC3339)).
+		IntervalEndTime(windowEnd.Format(time.RFC3339)).
+		AggregationAlignmentPeriod(fmt.Sprintf("%ds", int64(windowEnd.Sub(windowStart).Seconds()))).
+		AggregationPerSeriesAligner("ALIGN_DELTA").
+		AggregationCrossSeriesReducer("REDUCE_SUM").
+		AggregationGroupByFields("metric.labels."+priorityLabel).
+		Pages(ctx, func(page *googleMonitoringClient.ListTimeSeriesResponse) error {
+			for _, series := range page.TimeSeries {
+				if series.Metric == nil {
+					continue
+				}
+				priority, err := strconv.ParseInt(series.Metric.Labels[priorityLabel], 10, 64)
+				if err != nil {
+					continue
+				}
+				for _, point := range series.Points {
+					if point.Value != nil && point.Value.Int64Value != nil {
+						hitCounts[priority] += *point.Value.Int64Value
+					}
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list time series of rule hits.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.WindowStart = types.StringValue(windowStart.Format(time.RFC3339))
+	state.WindowEnd = types.StringValue(windowEnd.Format(time.RFC3339))
+	state.Rules = []*armorPolicyRuleHitCountsItemModel{}
+	unusedPriorities := []attr.Value{}
+	for _, rule := range policy.Rules {
+		state.Rules = append(state.Rules, &armorPolicyRuleHitCountsItemModel{
+			Priority:    types.Int64Value(rule.Priority),
+			Action:      types.StringValue(rule.Action),
+			Description: types.StringValue(rule.Description),
+			Preview:     types.BoolValue(rule.Preview),
+			HitCount:    types.Int64Value(hitCounts[rule.Priority]),
+		})
+		if hitCounts[rule.Priority] == 0 && rule.Priority != securityPolicyDefaultRulePriority {
+			unusedPriorities = append(unusedPriorities, types.Int64Value(rule.Priority))
+		}
+	}
+	state.UnusedRulePriorities = types.ListValueMust(types.Int64Type, unusedPriorities)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,357 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleArtifactRegistryClient "google.golang.org/api/artifactregistry/v1"
+)
+
+var (
+	_ datasource.DataSource              = &ArtifactRegistryRepositoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ArtifactRegistryRepositoriesDataSource{}
+)
+
+// NewArtifactRegistryRepositoriesDataSource
+func NewArtifactRegistryRepositoriesDataSource() datasource.DataSource {
+	return &ArtifactRegistryRepositoriesDataSource{}
+}
+
+// ArtifactRegistryRepositoriesDataSource
+type ArtifactRegistryRepositoriesDataSource struct {
+	client *gcpClients
+}
+
+// ArtifactRegistryRepositoriesDataSourceModel
+type ArtifactRegistryRepositoriesDataSourceModel struct {
+	ClientConfig *clientConfig                            `tfsdk:"client_config"`
+	Location     types.String                             `tfsdk:"location"`
+	Format       types.String                             `tfsdk:"format"`
+	Labels       types.Map                                `tfsdk:"labels"`
+	Items        []*artifactRegistryRepositoriesItemModel `tfsdk:"items"`
+}
+
+type artifactRegistryRepositoriesItemModel struct {
+	ID                  types.String                          `tfsdk:"id"`
+	Name                types.String                          `tfsdk:"name"`
+	Description         types.String                          `tfsdk:"description"`
+	Labels              types.Map                             `tfsdk:"labels"`
+	Format              types.String                          `tfsdk:"format"`
+	Mode                types.String                          `tfsdk:"mode"`
+	RepositoryURL       types.String                          `tfsdk:"repository_url"`
+	KmsKeyName          types.String                          `tfsdk:"kms_key_name"`
+	SizeBytes           types.Int64                           `tfsdk:"size_bytes"`
+	CleanupPolicyDryRun types.Bool                            `tfsdk:"cleanup_policy_dry_run"`
+	CleanupPolicies     []*artifactRegistryCleanupPolicyModel `tfsdk:"cleanup_policies"`
+	CreateTime          types.String                          `tfsdk:"create_time"`
+	UpdateTime          types.String                          `tfsdk:"update_time"`
+}
+
+type artifactRegistryCleanupPolicyModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Action              types.String `tfsdk:"action"`
+	TagState            types.String `tfsdk:"tag_state"`
+	TagPrefixes         types.List   `tfsdk:"tag_prefixes"`
+	VersionNamePrefixes types.List   `tfsdk:"version_name_prefixes"`
+	PackageNamePrefixes types.List   `tfsdk:"package_name_prefixes"`
+	OlderThan           types.String `tfsdk:"older_than"`
+	NewerThan           types.String `tfsdk:"newer_than"`
+	KeepCount           types.Int64  `tfsdk:"keep_count"`
+}
+
+// Metadata returns the data source Artifact Registry repositories type name.
+func (d *ArtifactRegistryRepositoriesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_artifact_registry_repositories"
+}
+
+// Schema defines the schema for the Artifact Registry repositories data source.
+func (d *ArtifactRegistryRepositoriesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Artifact Registry repositories in a " +
+			"location on Google Cloud with their URLs and cleanup policies, so CI " +
+			"modules can resolve the push targets dynamically.",
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				Description: "Location of repositories, e.g. asia-east1 or us.",
+				Required:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Format of repository to be filtered, e.g. DOCKER, MAVEN, NPM or PYTHON.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of repository to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried repositories.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of repository.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of repository.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of repository.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of repository.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"format": schema.StringAttribute{
+							Description: "Format of repository.",
+							Computed:    true,
+						},
+						"mode": schema.StringAttribute{
+							Description: "Mode of repository, e.g. STANDARD_REPOSITORY, " +
+								"VIRTUAL_REPOSITORY or REMOTE_REPOSITORY.",
+							Computed: true,
+						},
+						"repository_url": schema.StringAttribute{
+							Description: "URL of repository without scheme, e.g. " +
+								"asia-east1-docker.pkg.dev/my-project/my-repo.",
+							Computed: true,
+						},
+						"kms_key_name": schema.StringAttribute{
+							Description: "Resource name of the KMS key encrypting repository, " +
+								"empty if repository is encrypted by Google-managed key.",
+							Computed: true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							Description: "Size of the artifacts stored in repository in bytes.",
+							Computed:    true,
+						},
+						"cleanup_policy_dry_run": schema.BoolAttribute{
+							Description: "Whether the cleanup policies only report the " +
+								"artifacts to be deleted without deleting them.",
+							Computed: true,
+						},
+						"cleanup_policies": schema.ListNestedAttribute{
+							Description: "Cleanup policies of repository, ordered by ID.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "ID of cleanup policy.",
+										Computed:    true,
+									},
+									"action": schema.StringAttribute{
+										Description: "Action of cleanup policy, DELETE or KEEP.",
+										Computed:    true,
+									},
+									"tag_state": schema.StringAttribute{
+										Description: "Tag state of the versions matched, e.g. " +
+											"TAGGED, UNTAGGED or ANY.",
+										Computed: true,
+									},
+									"tag_prefixes": schema.ListAttribute{
+										Description: "Tag prefixes of the versions matched.",
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+									"version_name_prefixes": schema.ListAttribute{
+										Description: "Name prefixes of the versions matched.",
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+									"package_name_prefixes": schema.ListAttribute{
+										Description: "Name prefixes of the packages matched.",
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+									"older_than": schema.StringAttribute{
+										Description: "Minimum age of the versions matched, e.g. 2592000s.",
+										Computed:    true,
+									},
+									"newer_than": schema.StringAttribute{
+										Description: "Maximum age of the versions matched, e.g. 2592000s.",
+										Computed:    true,
+									},
+									"keep_count": schema.Int64Attribute{
+										Description: "Number of most recent versions kept, 0 if " +
+											"policy matches versions by condition.",
+										Computed: true,
+									},
+								},
+							},
+						},
+						"create_time": schema.StringAttribute{
+							Description: "Creation time of repository.",
+							Computed:    true,
+						},
+						"update_time": schema.StringAttribute{
+							Description: "Last update time of repository.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ArtifactRegistryRepositoriesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Artifact Registry repositories data source information
+func (d *ArtifactRegistryRepositoriesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ArtifactRegistryRepositoriesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifactRegistryClient, err := googleArtifactRegistryClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Artifact Registry client", err.Error())
+		return
+	}
+
+	state := &ArtifactRegistryRepositoriesDataSourceModel{
+		Location: plan.Location,
+		Format:   plan.Format,
+		Labels:   plan.Labels,
+		Items:    []*artifactRegistryRepositoriesItemModel{},
+	}
+
+	err = artifactRegistryClient.Projects.Locations.Repositories.List(fmt.Sprintf("projects/%s/locations/%s",
+		clients.project, plan.Location.ValueString())).Pages(ctx,
+		func(page *googleArtifactRegistryClient.ListRepositoriesResponse) error {
+			for _, repository := range page.Repositories {
+				if !(plan.Format.IsUnknown() || plan.Format.IsNull()) &&
+					!strings.EqualFold(plan.Format.ValueString(), repository.Format) {
+					continue
+				}
+				labels, labelsTfType := labelsValue(repository.Labels)
+				if !matchTags(plan.Labels, labels) {
+					continue
+				}
+				state.Items = append(state.Items, newArtifactRegistryRepositoriesItem(repository, labelsTfType))
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list repositories.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newArtifactRegistryRepositoriesItem converts the repository into queried
+// item, the repository name is in the format of
+// projects/{project}/locations/{location}/repositories/{name}.
+func newArtifactRegistryRepositoriesItem(repository *googleArtifactRegistryClient.Repository,
+	labels types.Map) *artifactRegistryRepositoriesItemModel {
+	repositoryURL := ""
+	if parts := strings.Split(repository.Name, "/"); len(parts) == 6 {
+		repositoryURL = fmt.Sprintf("%s-%s.pkg.dev/%s/%s",
+			parts[3], strings.ToLower(repository.Format), parts[1], parts[5])
+	}
+
+	item := &artifactRegistryRepositoriesItemModel{
+		ID:                  types.StringValue(repository.Name),
+		Name:                types.StringValue(resourceNameFromSelfLink(repository.Name)),
+		Description:         types.StringValue(repository.Description),
+		Labels:              labels,
+		Format:              types.StringValue(repository.Format),
+		Mode:                types.StringValue(repository.Mode),
+		RepositoryURL:       types.StringValue(repositoryURL),
+		KmsKeyName:          types.StringValue(repository.KmsKeyName),
+		SizeBytes:           types.Int64Value(repository.SizeBytes),
+		CleanupPolicyDryRun: types.BoolValue(repository.CleanupPolicyDryRun),
+		CleanupPolicies:     []*artifactRegistryCleanupPolicyModel{},
+		CreateTime:          types.StringValue(repository.CreateTime),
+		UpdateTime:          types.StringValue(repository.UpdateTime),
+	}
+
+	ids := make([]string, 0, len(repository.CleanupPolicies))
+	for id := range repository.CleanupPolicies {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		item.CleanupPolicies = append(item.CleanupPolicies,
+			newArtifactRegistryCleanupPolicy(id, repository.CleanupPolicies[id]))
+	}
+	return item
+}
+
+// newArtifactRegistryCleanupPolicy converts the cleanup policy of repository.
+func newArtifactRegistryCleanupPolicy(id string,
+	policy googleArtifactRegistryClient.CleanupPolicy) *artifactRegistryCleanupPolicyModel {
+	stringsValue := func(values []string) types.List {
+		elements := []attr.Value{}
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+
+	cleanupPolicy := &artifactRegistryCleanupPolicyModel{
+		ID:                  types.StringValue(id),
+		Action:              types.StringValue(policy.Action),
+		TagState:            types.StringValue(""),
+		TagPrefixes:         stringsValue(nil),
+		VersionNamePrefixes: stringsValue(nil),
+		PackageNamePrefixes: stringsValue(nil),
+		OlderThan:           types.StringValue(""),
+		NewerThan:           types.StringValue(""),
+		KeepCount:           types.Int64Value(0),
+	}
+	if policy.Condition != nil {
+		cleanupPolicy.TagState = types.StringValue(policy.Condition.TagState)
+		cleanupPolicy.TagPrefixes = stringsValue(policy.Condition.TagPrefixes)
+		cleanupPolicy.VersionNamePrefixes = stringsValue(policy.Condition.VersionNamePrefixes)
+		cleanupPolicy.PackageNamePrefixes = stringsValue(policy.Condition.PackageNamePrefixes)
+		cleanupPolicy.OlderThan = types.StringValue(policy.Condition.OlderThan)
+		cleanupPolicy.NewerThan = types.StringValue(policy.Condition.NewerThan)
+	}
+	if policy.MostRecentVersions != nil {
+		cleanupPolicy.PackageNamePrefixes = stringsValue(policy.MostRecentVersions.PackageNamePrefixes)
+		cleanupPolicy.KeepCount = types.Int64Value(policy.MostRecentVersions.KeepCount)
+	}
+	return cleanupPolicy
+}
@@ -0,0 +1,212 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleResourceManagerClient "google.golang.org/api/cloudresourcemanager/v1"
+)
+
+const (
+	auditLogTypeAdminRead = "ADMIN_READ"
+	auditLogTypeDataRead  = "DATA_READ"
+	auditLogTypeDataWrite = "DATA_WRITE"
+)
+
+var (
+	_ datasource.DataSource              = &AuditLogConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &AuditLogConfigDataSource{}
+)
+
+// NewAuditLogConfigDataSource
+func NewAuditLogConfigDataSource() datasource.DataSource {
+	return &AuditLogConfigDataSource{}
+}
+
+// AuditLogConfigDataSource
+type AuditLogConfigDataSource struct {
+	client *gcpClients
+}
+
+// AuditLogConfigDataSourceModel
+type AuditLogConfigDataSourceModel struct {
+	ClientConfig *clientConfig              `tfsdk:"client_config"`
+	Service      types.String               `tfsdk:"service"`
+	Items        []*auditLogConfigItemModel `tfsdk:"items"`
+}
+
+type auditLogConfigItemModel struct {
+	Service                  types.String `tfsdk:"service"`
+	AdminReadEnabled         types.Bool   `tfsdk:"admin_read_enabled"`
+	DataReadEnabled          types.Bool   `tfsdk:"data_read_enabled"`
+	DataWriteEnabled         types.Bool   `tfsdk:"data_write_enabled"`
+	AdminReadExemptedMembers types.List   `tfsdk:"admin_read_exempted_members"`
+	DataReadExemptedMembers  types.List   `tfsdk:"data_read_exempted_members"`
+	DataWriteExemptedMembers types.List   `tfsdk:"data_write_exempted_members"`
+}
+
+// Metadata returns the data source audit log config type name.
+func (d *AuditLogConfigDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log_config"
+}
+
+// Schema defines the schema for the audit log config data source.
+func (d *AuditLogConfigDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the audit log configuration of the " +
+			"project IAM policy on Google Cloud, i.e. the log types enabled for every " +
+			"service and their exempted members, so compliance modules can assert the " +
+			"coverage of the Data Access audit logs.",
+		Attributes: map[string]schema.Attribute{
+			"service": schema.StringAttribute{
+				Description: "Service of audit log config to be filtered, e.g. " +
+					"storage.googleapis.com or allServices.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of the queried audit log configs by service. The log " +
+					"types of allServices are also enabled for every other service.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							Description: "Service of audit log config, e.g. " +
+								"storage.googleapis.com or allServices.",
+							Computed: true,
+						},
+						"admin_read_enabled": schema.BoolAttribute{
+							Description: "Whether " + auditLogTypeAdminRead + " audit logs are enabled.",
+							Computed:    true,
+						},
+						"data_read_enabled": schema.BoolAttribute{
+							Description: "Whether " + auditLogTypeDataRead + " audit logs are enabled.",
+							Computed:    true,
+						},
+						"data_write_enabled": schema.BoolAttribute{
+							Description: "Whether " + auditLogTypeDataWrite + " audit logs are enabled.",
+							Computed:    true,
+						},
+						"admin_read_exempted_members": schema.ListAttribute{
+							Description: "Members exempted from " + auditLogTypeAdminRead + " audit logs.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"data_read_exempted_members": schema.ListAttribute{
+							Description: "Members exempted from " + auditLogTypeDataRead + " audit logs.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"data_write_exempted_members": schema.ListAttribute{
+							Description: "Members exempted from " + auditLogTypeDataWrite + " audit logs.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *AuditLogConfigDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read audit log config data source information
+func (d *AuditLogConfigDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *AuditLogConfigDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceManagerClient, err := googleResourceManagerClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Resource Manager client", err.Error())
+		return
+	}
+
+	policy, err := resourceManagerClient.Projects.GetIamPolicy(clients.project,
+		&googleResourceManagerClient.GetIamPolicyRequest{
+			Options: &googleResourceManagerClient.GetPolicyOptions{
+				RequestedPolicyVersion: iamPolicyVersion,
+			},
+		}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get project IAM policy.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := &AuditLogConfigDataSourceModel{
+		Service: plan.Service,
+		Items:   []*auditLogConfigItemModel{},
+	}
+
+	for _, auditConfig := range policy.AuditConfigs {
+		if !(plan.Service.IsUnknown() || plan.Service.IsNull()) &&
+			plan.Service.ValueString() != auditConfig.Service {
+			continue
+		}
+		state.Items = append(state.Items, newAuditLogConfigItem(auditConfig))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newAuditLogConfigItem converts the audit config of service into queried
+// item.
+func newAuditLogConfigItem(auditConfig *googleResourceManagerClient.AuditConfig) *auditLogConfigItemModel {
+	enabled := map[string]bool{}
+	exemptedMembers := map[string][]attr.Value{
+		auditLogTypeAdminRead: {},
+		auditLogTypeDataRead:  {},
+		auditLogTypeDataWrite: {},
+	}
+	for _, auditLogConfig := range auditConfig.AuditLogConfigs {
+		enabled[auditLogConfig.LogType] = true
+		for _, member := range auditLogConfig.ExemptedMembers {
+			exemptedMembers[auditLogConfig.LogType] = append(exemptedMembers[auditLogConfig.LogType],
+				types.StringValue(member))
+		}
+	}
+
+	return &auditLogConfigItemModel{
+		Service:                  types.StringValue(auditConfig.Service),
+		AdminReadEnabled:         types.BoolValue(enabled[auditLogTypeAdminRead]),
+		DataReadEnabled:          types.BoolValue(enabled[auditLogTypeDataRead]),
+		DataWriteEnabled:         types.BoolValue(enabled[auditLogTypeDataWrite]),
+		AdminReadExemptedMembers: types.ListValueMust(types.StringType, exemptedMembers[auditLogTypeAdminRead]),
+		DataReadExemptedMembers:  types.ListValueMust(types.StringType, exemptedMembers[auditLogTypeDataRead]),
+		DataWriteExemptedMembers: types.ListValueMust(types.StringType, exemptedMembers[auditLogTypeDataWrite]),
+	}
+}
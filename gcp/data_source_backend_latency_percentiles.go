@@ -0,0 +1,196 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleMonitoringClient "google.golang.org/api/monitoring/v3"
+)
+
+const (
+	defaultBackendLatencyMetricType    = "loadbalancing.googleapis.com/https/backend_latencies"
+	defaultBackendLatencyWindowMinutes = 60
+)
+
+var (
+	_ datasource.DataSource              = &BackendLatencyPercentilesDataSource{}
+	_ datasource.DataSourceWithConfigure = &BackendLatencyPercentilesDataSource{}
+)
+
+// NewBackendLatencyPercentilesDataSource
+func NewBackendLatencyPercentilesDataSource() datasource.DataSource {
+	return &BackendLatencyPercentilesDataSource{}
+}
+
+// BackendLatencyPercentilesDataSource
+type BackendLatencyPercentilesDataSource struct {
+	client *gcpClients
+}
+
+// BackendLatencyPercentilesDataSourceModel
+type BackendLatencyPercentilesDataSourceModel struct {
+	ClientConfig   *clientConfig `tfsdk:"client_config"`
+	BackendService types.String  `tfsdk:"backend_service"`
+	MetricType     types.String  `tfsdk:"metric_type"`
+	WindowMinutes  types.Int64   `tfsdk:"window_minutes"`
+	WindowStart    types.String  `tfsdk:"window_start"`
+	WindowEnd      types.String  `tfsdk:"window_end"`
+	P50Ms          types.Float64 `tfsdk:"p50_ms"`
+	P95Ms          types.Float64 `tfsdk:"p95_ms"`
+	P99Ms          types.Float64 `tfsdk:"p99_ms"`
+}
+
+// Metadata returns the data source backend latency percentiles type name.
+func (d *BackendLatencyPercentilesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backend_latency_percentiles"
+}
+
+// Schema defines the schema for the backend latency percentiles data source.
+func (d *BackendLatencyPercentilesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the p50, p95 and p99 backend latencies of a " +
+			"load balancer backend service over a window from Cloud Monitoring. The " +
+			"percentiles are null if there is no request in the window.",
+		Attributes: map[string]schema.Attribute{
+			"backend_service": schema.StringAttribute{
+				Description: "Name of backend service.",
+				Required:    true,
+			},
+			"metric_type": schema.StringAttribute{
+				Description: "Type of the backend latency distribution metric, e.g. " +
+					"loadbalancing.googleapis.com/https/internal/backend_latencies for the " +
+					"internal Application Load Balancers. Default to " +
+					defaultBackendLatencyMetricType + ".",
+				Optional: true,
+			},
+			"window_minutes": schema.Int64Attribute{
+				Description: "Number of minutes until now to compute the percentiles. Default to " +
+					strconv.Itoa(defaultBackendLatencyWindowMinutes) + ".",
+				Optional: true,
+			},
+			"window_start": schema.StringAttribute{
+				Description: "Start time of the window in RFC3339 format.",
+				Computed:    true,
+			},
+			"window_end": schema.StringAttribute{
+				Description: "End time of the window in RFC3339 format.",
+				Computed:    true,
+			},
+			"p50_ms": schema.Float64Attribute{
+				Description: "50th percentile of the backend latency in milliseconds.",
+				Computed:    true,
+			},
+			"p95_ms": schema.Float64Attribute{
+				Description: "95th percentile of the backend latency in milliseconds.",
+				Computed:    true,
+			},
+			"p99_ms": schema.Float64Attribute{
+				Description: "99th percentile of the backend latency in milliseconds.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BackendLatencyPercentilesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read backend latency percentiles data source information
+func (d *BackendLatencyPercentilesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *BackendLatencyPercentilesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitoringClient, err := googleMonitoringClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Monitoring client", err.Error())
+		return
+	}
+
+	metricType := defaultBackendLatencyMetricType
+	if plan.MetricType.ValueString() != "" {
+		metricType = plan.MetricType.ValueString()
+	}
+	windowMinutes := int64(defaultBackendLatencyWindowMinutes)
+	if !plan.WindowMinutes.IsNull() {
+		windowMinutes = plan.WindowMinutes.ValueInt64()
+	}
+	windowEnd := time.Now().UTC().Truncate(time.Minute)
+	windowStart := windowEnd.Add(-time.Duration(windowMinutes) * time.Minute)
+
+	// The distributions of all the series of the backend service are merged
+	// in a single alignment period covering the window before the percentile
+	// is computed, so each percentile needs its own query.
+	percentile := func(reducer string) (types.Float64, error) {
+		value := types.Float64Null()
+		err := monitoringClient.Projects.TimeSeries.List("projects/"+clients.project).
+			Filter(fmt.Sprintf(`metric.type = %q AND resource.labels.backend_target_name = %q`,
+				metricType, plan.BackendService.ValueString())).
+			IntervalStartTime(windowStart.Format(time.RFC3339)).
+			IntervalEndTime(windowEnd.Format(time.RFC3339)).
+			AggregationAlignmentPeriod(fmt.Sprintf("%ds", int64(windowEnd.Sub(windowStart).Seconds()))).
+			AggregationPerSeriesAligner("ALIGN_DELTA").
+			AggregationCrossSeriesReducer(reducer).
+			Pages(ctx, func(page *googleMonitoringClient.ListTimeSeriesResponse) error {
+				for _, series := range page.TimeSeries {
+					for _, point := range series.Points {
+						if point.Value != nil && point.Value.DoubleValue != nil {
+							value = types.Float64Value(*point.Value.DoubleValue)
+						}
+					}
+				}
+				return nil
+			})
+		return value, err
+	}
+
+	state := plan
+	state.WindowStart = types.StringValue(windowStart.Format(time.RFC3339))
+	state.WindowEnd = types.StringValue(windowEnd.Format(time.RFC3339))
+	if state.P50Ms, err = percentile("REDUCE_PERCENTILE_50"); err == nil {
+		if state.P95Ms, err = percentile("REDUCE_PERCENTILE_95"); err == nil {
+			state.P99Ms, err = percentile("REDUCE_PERCENTILE_99")
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list time series of backend latencies.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,169 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleBillingClient "google.golang.org/api/cloudbilling/v1"
+)
+
+var (
+	_ datasource.DataSource              = &BillingAccountDataSource{}
+	_ datasource.DataSourceWithConfigure = &BillingAccountDataSource{}
+)
+
+// NewBillingAccountDataSource
+func NewBillingAccountDataSource() datasource.DataSource {
+	return &BillingAccountDataSource{}
+}
+
+// BillingAccountDataSource
+type BillingAccountDataSource struct {
+	client *gcpClients
+}
+
+// BillingAccountDataSourceModel
+type BillingAccountDataSourceModel struct {
+	ClientConfig         *clientConfig `tfsdk:"client_config"`
+	Project              types.String  `tfsdk:"project"`
+	BillingEnabled       types.Bool    `tfsdk:"billing_enabled"`
+	BillingAccountID     types.String  `tfsdk:"billing_account_id"`
+	BillingAccountName   types.String  `tfsdk:"billing_account_name"`
+	DisplayName          types.String  `tfsdk:"display_name"`
+	Open                 types.Bool    `tfsdk:"open"`
+	MasterBillingAccount types.String  `tfsdk:"master_billing_account"`
+}
+
+// Metadata returns the data source billing account type name.
+func (d *BillingAccountDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_billing_account"
+}
+
+// Schema defines the schema for the billing account data source.
+func (d *BillingAccountDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the billing account attached to the project " +
+			"on Google Cloud, so budgets and chargeback resources can be wired without " +
+			"hard-coding billing account IDs.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "Project the billing account is attached to, i.e. the project " +
+					"configured in the provider or client_config.",
+				Computed: true,
+			},
+			"billing_enabled": schema.BoolAttribute{
+				Description: "Whether billing is enabled for project.",
+				Computed:    true,
+			},
+			"billing_account_id": schema.StringAttribute{
+				Description: "ID of billing account, e.g. 012345-567890-ABCDEF. Empty if no " +
+					"billing account is attached.",
+				Computed: true,
+			},
+			"billing_account_name": schema.StringAttribute{
+				Description: "Resource name of billing account, e.g. billingAccounts/012345-567890-ABCDEF.",
+				Computed:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of billing account. Null if the billing account " +
+					"cannot be viewed by the credentials.",
+				Computed: true,
+			},
+			"open": schema.BoolAttribute{
+				Description: "Whether billing account is open, a closed billing account " +
+					"cannot pay for the resources. Null if the billing account cannot be " +
+					"viewed by the credentials.",
+				Computed: true,
+			},
+			"master_billing_account": schema.StringAttribute{
+				Description: "Resource name of the master billing account if billing account " +
+					"is a subaccount of a reseller. Null if the billing account cannot be " +
+					"viewed by the credentials.",
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BillingAccountDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read billing account data source information
+func (d *BillingAccountDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *BillingAccountDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	billingClient, err := googleBillingClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Billing client", err.Error())
+		return
+	}
+
+	billingInfo, err := billingClient.Projects.GetBillingInfo("projects/" + clients.project).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get project billing info.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := &BillingAccountDataSourceModel{
+		Project:              types.StringValue(clients.project),
+		BillingEnabled:       types.BoolValue(billingInfo.BillingEnabled),
+		BillingAccountID:     types.StringValue(resourceNameFromSelfLink(billingInfo.BillingAccountName)),
+		BillingAccountName:   types.StringValue(billingInfo.BillingAccountName),
+		DisplayName:          types.StringNull(),
+		Open:                 types.BoolNull(),
+		MasterBillingAccount: types.StringNull(),
+	}
+
+	// Viewing the billing account requires a role on the billing account,
+	// which the credentials managing the project may not have.
+	if billingInfo.BillingAccountName != "" {
+		billingAccount, err := billingClient.BillingAccounts.Get(billingInfo.BillingAccountName).Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"[Warning] Failed to get billing account",
+				"The display name and state of billing account "+billingInfo.BillingAccountName+
+					" are unknown: "+err.Error(),
+			)
+		} else {
+			state.DisplayName = types.StringValue(billingAccount.DisplayName)
+			state.Open = types.BoolValue(billingAccount.Open)
+			state.MasterBillingAccount = types.StringValue(billingAccount.MasterBillingAccount)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,316 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleCertificateManagerClient "google.golang.org/api/certificatemanager/v1"
+)
+
+var (
+	_ datasource.DataSource              = &CertificateManagerCertificatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &CertificateManagerCertificatesDataSource{}
+)
+
+// NewCertificateManagerCertificatesDataSource
+func NewCertificateManagerCertificatesDataSource() datasource.DataSource {
+	return &CertificateManagerCertificatesDataSource{}
+}
+
+// CertificateManagerCertificatesDataSource
+type CertificateManagerCertificatesDataSource struct {
+	client *gcpClients
+}
+
+// CertificateManagerCertificatesDataSourceModel
+type CertificateManagerCertificatesDataSourceModel struct {
+	ClientConfig    *clientConfig                              `tfsdk:"client_config"`
+	Location        types.String                               `tfsdk:"location"`
+	Name            types.String                               `tfsdk:"name"`
+	Labels          types.Map                                  `tfsdk:"labels"`
+	Items           []*certificateManagerCertificatesItemModel `tfsdk:"items"`
+	CertificateMaps []*certificateManagerMapsItemModel         `tfsdk:"certificate_maps"`
+}
+
+type certificateManagerCertificatesItemModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Labels            types.Map    `tfsdk:"labels"`
+	Type              types.String `tfsdk:"type"`
+	Scope             types.String `tfsdk:"scope"`
+	SanDnsnames       types.List   `tfsdk:"san_dnsnames"`
+	ExpireTime        types.String `tfsdk:"expire_time"`
+	State             types.String `tfsdk:"state"`
+	ProvisioningIssue types.String `tfsdk:"provisioning_issue"`
+}
+
+type certificateManagerMapsItemModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Labels             types.Map    `tfsdk:"labels"`
+	TargetHttpsProxies types.List   `tfsdk:"target_https_proxies"`
+	TargetSslProxies   types.List   `tfsdk:"target_ssl_proxies"`
+}
+
+// Metadata returns the data source Certificate Manager certificates type name.
+func (d *CertificateManagerCertificatesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_manager_certificates"
+}
+
+// Schema defines the schema for the Certificate Manager certificates data source.
+func (d *CertificateManagerCertificatesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Certificate Manager certificates " +
+			"and certificate maps on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				Description: "Location of certificates and certificate maps. Default to global.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of certificate and certificate map to be filtered.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of certificate and certificate map to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried certificates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of certificate.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of certificate.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of certificate.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of certificate, MANAGED or SELF_MANAGED.",
+							Computed:    true,
+						},
+						"scope": schema.StringAttribute{
+							Description: "Scope of certificate, e.g. DEFAULT or EDGE_CACHE.",
+							Computed:    true,
+						},
+						"san_dnsnames": schema.ListAttribute{
+							Description: "Subject alternative DNS names of certificate.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"expire_time": schema.StringAttribute{
+							Description: "Expire time of certificate in RFC3339 format.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Provisioning state of managed certificate, e.g. " +
+								"PROVISIONING or ACTIVE. Empty for self-managed certificate.",
+							Computed: true,
+						},
+						"provisioning_issue": schema.StringAttribute{
+							Description: "Reason of the provisioning issue of managed certificate, if any.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"certificate_maps": schema.ListNestedAttribute{
+				Description: "List of queried certificate maps.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of certificate map.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of certificate map.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of certificate map.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"target_https_proxies": schema.ListAttribute{
+							Description: "Target HTTPS proxies serving certificate map.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"target_ssl_proxies": schema.ListAttribute{
+							Description: "Target SSL proxies serving certificate map.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CertificateManagerCertificatesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Certificate Manager certificates data source information
+func (d *CertificateManagerCertificatesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *CertificateManagerCertificatesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificateManagerClient, err := googleCertificateManagerClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Certificate Manager client", err.Error())
+		return
+	}
+
+	state := &CertificateManagerCertificatesDataSourceModel{
+		Location:        plan.Location,
+		Name:            plan.Name,
+		Labels:          plan.Labels,
+		Items:           []*certificateManagerCertificatesItemModel{},
+		CertificateMaps: []*certificateManagerMapsItemModel{},
+	}
+
+	location := globalScope
+	if plan.Location.ValueString() != "" {
+		location = plan.Location.ValueString()
+	}
+	parent := fmt.Sprintf("projects/%s/locations/%s", clients.project, location)
+
+	err = certificateManagerClient.Projects.Locations.Certificates.List(parent).Pages(ctx,
+		func(page *googleCertificateManagerClient.ListCertificatesResponse) error {
+			for _, certificate := range page.Certificates {
+				name := resourceNameFromSelfLink(certificate.Name)
+				if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != name {
+					continue
+				}
+				labels, labelsTfType := labelsValue(certificate.Labels)
+				if !matchTags(plan.Labels, labels) {
+					continue
+				}
+				state.Items = append(state.Items, newCertificateManagerCertificatesItem(certificate, labelsTfType))
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list Certificate Manager certificates.",
+			err.Error(),
+		)
+		return
+	}
+
+	err = certificateManagerClient.Projects.Locations.CertificateMaps.List(parent).Pages(ctx,
+		func(page *googleCertificateManagerClient.ListCertificateMapsResponse) error {
+			for _, certificateMap := range page.CertificateMaps {
+				name := resourceNameFromSelfLink(certificateMap.Name)
+				if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != name {
+					continue
+				}
+				labels, labelsTfType := labelsValue(certificateMap.Labels)
+				if !matchTags(plan.Labels, labels) {
+					continue
+				}
+
+				httpsProxies := []attr.Value{}
+				sslProxies := []attr.Value{}
+				for _, target := range certificateMap.GclbTargets {
+					if target.TargetHttpsProxy != "" {
+						httpsProxies = append(httpsProxies, types.StringValue(target.TargetHttpsProxy))
+					}
+					if target.TargetSslProxy != "" {
+						sslProxies = append(sslProxies, types.StringValue(target.TargetSslProxy))
+					}
+				}
+
+				state.CertificateMaps = append(state.CertificateMaps, &certificateManagerMapsItemModel{
+					ID:                 types.StringValue(certificateMap.Name),
+					Name:               types.StringValue(name),
+					Labels:             labelsTfType,
+					TargetHttpsProxies: types.ListValueMust(types.StringType, httpsProxies),
+					TargetSslProxies:   types.ListValueMust(types.StringType, sslProxies),
+				})
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list Certificate Manager certificate maps.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newCertificateManagerCertificatesItem converts the certificate into queried
+// item.
+func newCertificateManagerCertificatesItem(certificate *googleCertificateManagerClient.Certificate,
+	labels types.Map) *certificateManagerCertificatesItemModel {
+	sans := []attr.Value{}
+	for _, san := range certificate.SanDnsnames {
+		sans = append(sans, types.StringValue(san))
+	}
+
+	item := &certificateManagerCertificatesItemModel{
+		ID:                types.StringValue(certificate.Name),
+		Name:              types.StringValue(resourceNameFromSelfLink(certificate.Name)),
+		Labels:            labels,
+		Type:              types.StringValue("SELF_MANAGED"),
+		Scope:             types.StringValue(certificate.Scope),
+		SanDnsnames:       types.ListValueMust(types.StringType, sans),
+		ExpireTime:        types.StringValue(certificate.ExpireTime),
+		State:             types.StringValue(""),
+		ProvisioningIssue: types.StringValue(""),
+	}
+	if certificate.Managed != nil {
+		item.Type = types.StringValue("MANAGED")
+		item.State = types.StringValue(certificate.Managed.State)
+		if certificate.Managed.ProvisioningIssue != nil {
+			item.ProvisioningIssue = types.StringValue(certificate.Managed.ProvisioningIssue.Reason)
+		}
+	}
+	return item
+}
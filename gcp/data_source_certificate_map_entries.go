@@ -0,0 +1,245 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleCertificateManagerClient "google.golang.org/api/certificatemanager/v1"
+)
+
+var (
+	_ datasource.DataSource              = &CertificateMapEntriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &CertificateMapEntriesDataSource{}
+)
+
+// NewCertificateMapEntriesDataSource
+func NewCertificateMapEntriesDataSource() datasource.DataSource {
+	return &CertificateMapEntriesDataSource{}
+}
+
+// CertificateMapEntriesDataSource
+type CertificateMapEntriesDataSource struct {
+	client *gcpClients
+}
+
+// CertificateMapEntriesDataSourceModel
+type CertificateMapEntriesDataSourceModel struct {
+	ClientConfig   *clientConfig                     `tfsdk:"client_config"`
+	Location       types.String                      `tfsdk:"location"`
+	CertificateMap types.String                      `tfsdk:"certificate_map"`
+	Hostname       types.String                      `tfsdk:"hostname"`
+	Labels         types.Map                         `tfsdk:"labels"`
+	AllowMissing   types.Bool                        `tfsdk:"allow_missing"`
+	Items          []*certificateMapEntriesItemModel `tfsdk:"items"`
+}
+
+type certificateMapEntriesItemModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Labels             types.Map    `tfsdk:"labels"`
+	Hostname           types.String `tfsdk:"hostname"`
+	Matcher            types.String `tfsdk:"matcher"`
+	Certificates       types.List   `tfsdk:"certificates"`
+	PrimaryCertificate types.String `tfsdk:"primary_certificate"`
+	BackupCertificates types.List   `tfsdk:"backup_certificates"`
+	State              types.String `tfsdk:"state"`
+}
+
+// Metadata returns the data source certificate map entries type name.
+func (d *CertificateMapEntriesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_map_entries"
+}
+
+// Schema defines the schema for the certificate map entries data source.
+func (d *CertificateMapEntriesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the entries of a Certificate Manager " +
+			"certificate map on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				Description: "Location of certificate map. Default to global.",
+				Optional:    true,
+			},
+			"certificate_map": schema.StringAttribute{
+				Description: "Name of certificate map.",
+				Required:    true,
+			},
+			"hostname": schema.StringAttribute{
+				Description: "Hostname of certificate map entry to be filtered.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of certificate map entry to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"allow_missing": allowMissingAttribute("certificate map"),
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried certificate map entries.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of certificate map entry.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of certificate map entry.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of certificate map entry.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "Hostname served by certificate map entry, empty for matcher entry.",
+							Computed:    true,
+						},
+						"matcher": schema.StringAttribute{
+							Description: "Matcher of certificate map entry, e.g. PRIMARY. " +
+								"Empty for hostname entry.",
+							Computed: true,
+						},
+						"certificates": schema.ListAttribute{
+							Description: "Resource names of certificates served by certificate map entry.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"primary_certificate": schema.StringAttribute{
+							Description: "Resource name of the first certificate served by certificate map entry.",
+							Computed:    true,
+						},
+						"backup_certificates": schema.ListAttribute{
+							Description: "Resource names of the other certificates served by " +
+								"certificate map entry.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of certificate map entry, e.g. ACTIVE or PENDING.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CertificateMapEntriesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read certificate map entries data source information
+func (d *CertificateMapEntriesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *CertificateMapEntriesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificateManagerClient, err := googleCertificateManagerClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Certificate Manager client", err.Error())
+		return
+	}
+
+	state := &CertificateMapEntriesDataSourceModel{
+		Location:       plan.Location,
+		CertificateMap: plan.CertificateMap,
+		Hostname:       plan.Hostname,
+		Labels:         plan.Labels,
+		AllowMissing:   plan.AllowMissing,
+		Items:          []*certificateMapEntriesItemModel{},
+	}
+
+	location := globalScope
+	if plan.Location.ValueString() != "" {
+		location = plan.Location.ValueString()
+	}
+	parent := fmt.Sprintf("projects/%s/locations/%s/certificateMaps/%s",
+		clients.project, location, plan.CertificateMap.ValueString())
+
+	err = certificateManagerClient.Projects.Locations.CertificateMaps.CertificateMapEntries.List(parent).Pages(ctx,
+		func(page *googleCertificateManagerClient.ListCertificateMapEntriesResponse) error {
+			for _, entry := range page.CertificateMapEntries {
+				if !(plan.Hostname.IsUnknown() || plan.Hostname.IsNull()) && plan.Hostname.ValueString() != entry.Hostname {
+					continue
+				}
+				labels, labelsTfType := labelsValue(entry.Labels)
+				if !matchTags(plan.Labels, labels) {
+					continue
+				}
+				state.Items = append(state.Items, newCertificateMapEntriesItem(entry, labelsTfType))
+			}
+			return nil
+		})
+	if isMissingAllowed(plan.AllowMissing, err) {
+		state.Items, err = []*certificateMapEntriesItemModel{}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list certificate map entries.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newCertificateMapEntriesItem converts the certificate map entry into
+// queried item.
+func newCertificateMapEntriesItem(entry *googleCertificateManagerClient.CertificateMapEntry,
+	labels types.Map) *certificateMapEntriesItemModel {
+	certificates := []attr.Value{}
+	for _, certificate := range entry.Certificates {
+		certificates = append(certificates, types.StringValue(certificate))
+	}
+
+	item := &certificateMapEntriesItemModel{
+		ID:                 types.StringValue(entry.Name),
+		Name:               types.StringValue(resourceNameFromSelfLink(entry.Name)),
+		Labels:             labels,
+		Hostname:           types.StringValue(entry.Hostname),
+		Matcher:            types.StringValue(entry.Matcher),
+		Certificates:       types.ListValueMust(types.StringType, certificates),
+		PrimaryCertificate: types.StringValue(""),
+		BackupCertificates: types.ListValueMust(types.StringType, []attr.Value{}),
+		State:              types.StringValue(entry.State),
+	}
+	if len(certificates) > 0 {
+		item.PrimaryCertificate = types.StringValue(entry.Certificates[0])
+		item.BackupCertificates = types.ListValueMust(types.StringType, certificates[1:])
+	}
+	return item
+}
@@ -0,0 +1,310 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	// Embed the time zone database, since the provider may run on hosts
+	// without it.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleStorageClient "google.golang.org/api/storage/v1"
+)
+
+const (
+	holidayDateLayout = "2006-01-02"
+	// nextChangeWindowSearchDays is how far the next change window is
+	// searched for.
+	nextChangeWindowSearchDays = 31
+)
+
+var (
+	_ datasource.DataSource              = &ChangeWindowDataSource{}
+	_ datasource.DataSourceWithConfigure = &ChangeWindowDataSource{}
+)
+
+// NewChangeWindowDataSource
+func NewChangeWindowDataSource() datasource.DataSource {
+	return &ChangeWindowDataSource{}
+}
+
+// ChangeWindowDataSource
+type ChangeWindowDataSource struct {
+	client *gcpClients
+}
+
+// ChangeWindowDataSourceModel
+type ChangeWindowDataSourceModel struct {
+	ClientConfig       *clientConfig        `tfsdk:"client_config"`
+	Windows            []*changeWindowModel `tfsdk:"windows"`
+	Timezone           types.String         `tfsdk:"timezone"`
+	HolidayCalendar    types.String         `tfsdk:"holiday_calendar"`
+	Time               types.String         `tfsdk:"time"`
+	InWindow           types.Bool           `tfsdk:"in_window"`
+	IsHoliday          types.Bool           `tfsdk:"is_holiday"`
+	CurrentWindowStart types.String         `tfsdk:"current_window_start"`
+	CurrentWindowEnd   types.String         `tfsdk:"current_window_end"`
+	NextWindowStart    types.String         `tfsdk:"next_window_start"`
+}
+
+type changeWindowModel struct {
+	Schedule        types.String `tfsdk:"schedule"`
+	DurationMinutes types.Int64  `tfsdk:"duration_minutes"`
+}
+
+// changeWindow is a parsed change window.
+type changeWindow struct {
+	schedule *cronSchedule
+	duration time.Duration
+}
+
+// Metadata returns the data source change window type name.
+func (d *ChangeWindowDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_change_window"
+}
+
+// Schema defines the schema for the change window data source.
+func (d *ChangeWindowDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source evaluates whether the current time is inside the change " +
+			"windows, so the production affecting resources can refuse the applies outside the " +
+			"change windows by preconditions. The days in the holiday calendar are never inside " +
+			"the change windows.",
+		Attributes: map[string]schema.Attribute{
+			"windows": schema.ListNestedAttribute{
+				Description: "Change windows.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"schedule": schema.StringAttribute{
+							Description: "Standard 5 fields cron expression of the start of change " +
+								"window, e.g. \"0 22 * * MON-THU\".",
+							Required: true,
+						},
+						"duration_minutes": schema.Int64Attribute{
+							Description: "Duration of change window in minutes.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA time zone of the schedules and the holidays, e.g. " +
+					"Asia/Kuala_Lumpur. Default to UTC.",
+				Optional: true,
+			},
+			"holiday_calendar": schema.StringAttribute{
+				Description: "GCS object of the holiday calendar in the format of {bucket}/{object}. " +
+					"The object is a JSON array of the holiday dates in the format of YYYY-MM-DD.",
+				Optional: true,
+			},
+			"time": schema.StringAttribute{
+				Description: "Time to be evaluated in RFC3339 format. Default to the current time.",
+				Optional:    true,
+			},
+			"in_window": schema.BoolAttribute{
+				Description: "Whether the time is inside any change window.",
+				Computed:    true,
+			},
+			"is_holiday": schema.BoolAttribute{
+				Description: "Whether the date of the time is a holiday.",
+				Computed:    true,
+			},
+			"current_window_start": schema.StringAttribute{
+				Description: "Start time of the current change window in RFC3339 format, empty " +
+					"if the time is outside the change windows.",
+				Computed: true,
+			},
+			"current_window_end": schema.StringAttribute{
+				Description: "End time of the current change window in RFC3339 format, empty " +
+					"if the time is outside the change windows.",
+				Computed: true,
+			},
+			"next_window_start": schema.StringAttribute{
+				Description: fmt.Sprintf("Start time of the next change window in RFC3339 format, "+
+					"empty if there is no change window in %d days.", nextChangeWindowSearchDays),
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ChangeWindowDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read change window data source information
+func (d *ChangeWindowDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ChangeWindowDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location := time.UTC
+	if plan.Timezone.ValueString() != "" {
+		var err error
+		if location, err = time.LoadLocation(plan.Timezone.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Invalid timezone", err.Error())
+			return
+		}
+	}
+	now := time.Now()
+	if plan.Time.ValueString() != "" {
+		var err error
+		if now, err = time.Parse(time.RFC3339, plan.Time.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Invalid time", err.Error())
+			return
+		}
+	}
+	now = now.In(location)
+
+	windows := []*changeWindow{}
+	for _, window := range plan.Windows {
+		schedule, err := parseCronSchedule(window.Schedule.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid schedule", err.Error())
+			return
+		}
+		if window.DurationMinutes.ValueInt64() <= 0 {
+			resp.Diagnostics.AddError("Invalid duration_minutes",
+				fmt.Sprintf("duration_minutes of schedule %q must be positive.", window.Schedule.ValueString()))
+			return
+		}
+		windows = append(windows, &changeWindow{
+			schedule: schedule,
+			duration: time.Duration(window.DurationMinutes.ValueInt64()) * time.Minute,
+		})
+	}
+
+	holidays := map[string]bool{}
+	if plan.HolidayCalendar.ValueString() != "" {
+		clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var err error
+		if holidays, err = readHolidayCalendar(ctx, clients, plan.HolidayCalendar.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to read holiday calendar.",
+				err.Error(),
+			)
+			return
+		}
+	}
+	isHoliday := func(t time.Time) bool {
+		return holidays[t.Format(holidayDateLayout)]
+	}
+
+	state := plan
+	state.InWindow = types.BoolValue(false)
+	state.IsHoliday = types.BoolValue(isHoliday(now))
+	state.CurrentWindowStart = types.StringValue("")
+	state.CurrentWindowEnd = types.StringValue("")
+	state.NextWindowStart = types.StringValue("")
+
+	// The latest end of the change windows covering the time is used, so the
+	// overlapping change windows are merged.
+	if !isHoliday(now) {
+		var currentStart, currentEnd time.Time
+		for _, window := range windows {
+			start, ok := window.schedule.lastMatchSince(now, window.duration)
+			if !ok || !now.Before(start.Add(window.duration)) {
+				continue
+			}
+			if currentEnd.IsZero() || start.Add(window.duration).After(currentEnd) {
+				currentStart, currentEnd = start, start.Add(window.duration)
+			}
+		}
+		if !currentEnd.IsZero() {
+			state.InWindow = types.BoolValue(true)
+			state.CurrentWindowStart = types.StringValue(currentStart.Format(time.RFC3339))
+			state.CurrentWindowEnd = types.StringValue(currentEnd.Format(time.RFC3339))
+		}
+	}
+
+	var nextStart time.Time
+	searchEnd := now.Add(nextChangeWindowSearchDays * 24 * time.Hour)
+	for _, window := range windows {
+		for from := now; ; {
+			start, ok := window.schedule.nextMatch(from, searchEnd.Sub(from))
+			if !ok {
+				break
+			}
+			if !isHoliday(start) {
+				if nextStart.IsZero() || start.Before(nextStart) {
+					nextStart = start
+				}
+				break
+			}
+			from = start
+		}
+	}
+	if !nextStart.IsZero() {
+		state.NextWindowStart = types.StringValue(nextStart.Format(time.RFC3339))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// readHolidayCalendar reads the holiday dates from the JSON array in the GCS
+// object {bucket}/{object}.
+func readHolidayCalendar(ctx context.Context, clients *gcpClients, calendar string) (map[string]bool, error) {
+	bucket, object, ok := strings.Cut(calendar, "/")
+	if !ok || bucket == "" || object == "" {
+		return nil, fmt.Errorf("holiday_calendar %q is not in the format of {bucket}/{object}", calendar)
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := storageClient.Objects.Get(bucket, object).Context(ctx).Download()
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var dates []string
+	if err := json.Unmarshal(content, &dates); err != nil {
+		return nil, fmt.Errorf("holiday calendar is not a JSON array of dates: %v", err)
+	}
+	holidays := make(map[string]bool, len(dates))
+	for _, date := range dates {
+		if _, err := time.Parse(holidayDateLayout, date); err != nil {
+			return nil, fmt.Errorf("invalid holiday date %q: %v", date, err)
+		}
+		holidays[date] = true
+	}
+	return holidays, nil
+}
@@ -0,0 +1,305 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &CloudArmorPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &CloudArmorPoliciesDataSource{}
+)
+
+// NewCloudArmorPoliciesDataSource
+func NewCloudArmorPoliciesDataSource() datasource.DataSource {
+	return &CloudArmorPoliciesDataSource{}
+}
+
+// CloudArmorPoliciesDataSource
+type CloudArmorPoliciesDataSource struct {
+	client *gcpClients
+}
+
+// CloudArmorPoliciesDataSourceModel
+type CloudArmorPoliciesDataSourceModel struct {
+	ClientConfig *clientConfig                  `tfsdk:"client_config"`
+	Name         types.String                   `tfsdk:"name"`
+	Labels       types.Map                      `tfsdk:"labels"`
+	Region       types.String                   `tfsdk:"region"`
+	Items        []*cloudArmorPoliciesItemModel `tfsdk:"items"`
+}
+
+type cloudArmorPoliciesItemModel struct {
+	ID                           types.Int64                `tfsdk:"id"`
+	Name                         types.String               `tfsdk:"name"`
+	Labels                       types.Map                  `tfsdk:"labels"`
+	Description                  types.String               `tfsdk:"description"`
+	Type                         types.String               `tfsdk:"type"`
+	Region                       types.String               `tfsdk:"region"`
+	AdaptiveProtectionEnabled    types.Bool                 `tfsdk:"adaptive_protection_enabled"`
+	AdaptiveProtectionVisibility types.String               `tfsdk:"adaptive_protection_visibility"`
+	Rules                        []*cloudArmorRuleItemModel `tfsdk:"rules"`
+	SelfLink                     types.String               `tfsdk:"self_link"`
+}
+
+type cloudArmorRuleItemModel struct {
+	Priority    types.Int64  `tfsdk:"priority"`
+	Action      types.String `tfsdk:"action"`
+	Description types.String `tfsdk:"description"`
+	Preview     types.Bool   `tfsdk:"preview"`
+	Expression  types.String `tfsdk:"expression"`
+	SrcIPRanges types.List   `tfsdk:"src_ip_ranges"`
+}
+
+// Metadata returns the data source Cloud Armor policies type name.
+func (d *CloudArmorPoliciesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_armor_policies"
+}
+
+// Schema defines the schema for the Cloud Armor policies data source.
+func (d *CloudArmorPoliciesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Cloud Armor security policies " +
+			"with their rules on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of security policy to be filtered.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of security policy to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of security policies to be filtered, or global for " +
+					"the global security policies. Default to list the security " +
+					"policies in all scopes.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried security policies.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of security policy.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of security policy.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of security policy.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of security policy.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of security policy, e.g. CLOUD_ARMOR or " +
+								"CLOUD_ARMOR_EDGE.",
+							Computed: true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of security policy, empty for global security policy.",
+							Computed:    true,
+						},
+						"adaptive_protection_enabled": schema.BoolAttribute{
+							Description: "Whether the layer 7 DDoS defense of adaptive protection is enabled.",
+							Computed:    true,
+						},
+						"adaptive_protection_visibility": schema.StringAttribute{
+							Description: "Rule visibility of adaptive protection, STANDARD or PREMIUM.",
+							Computed:    true,
+						},
+						"rules": schema.ListNestedAttribute{
+							Description: "Rules of security policy ordered by priority.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"priority": schema.Int64Attribute{
+										Description: "Priority of rule.",
+										Computed:    true,
+									},
+									"action": schema.StringAttribute{
+										Description: "Action of rule, e.g. allow, deny(403) or throttle.",
+										Computed:    true,
+									},
+									"description": schema.StringAttribute{
+										Description: "Description of rule.",
+										Computed:    true,
+									},
+									"preview": schema.BoolAttribute{
+										Description: "Whether the rule is in preview mode.",
+										Computed:    true,
+									},
+									"expression": schema.StringAttribute{
+										Description: "CEL expression of rule, or the versioned " +
+											"expression, e.g. SRC_IPS_V1, for basic match rule.",
+										Computed: true,
+									},
+									"src_ip_ranges": schema.ListAttribute{
+										Description: "Source IP ranges of basic match rule.",
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of security policy.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CloudArmorPoliciesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Cloud Armor policies data source information
+func (d *CloudArmorPoliciesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *CloudArmorPoliciesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &CloudArmorPoliciesDataSourceModel{
+		Name:   plan.Name,
+		Labels: plan.Labels,
+		Region: plan.Region,
+		Items:  []*cloudArmorPoliciesItemModel{},
+	}
+
+	appendItems := func(policies []*googleComputeClient.SecurityPolicy) {
+		for _, policy := range policies {
+			if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != policy.Name {
+				continue
+			}
+			labels, labelsTfType := labelsValue(policy.Labels)
+			if !matchTags(plan.Labels, labels) {
+				continue
+			}
+			state.Items = append(state.Items, newCloudArmorPoliciesItem(policy, labelsTfType))
+		}
+	}
+
+	var err error
+	switch region := plan.Region.ValueString(); region {
+	case "":
+		err = clients.computeClient.SecurityPolicies.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.SecurityPoliciesAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendItems(page.Items[scope].SecurityPolicies)
+				}
+				return nil
+			})
+	case globalScope:
+		err = clients.computeClient.SecurityPolicies.List(clients.project).Pages(ctx,
+			func(page *googleComputeClient.SecurityPolicyList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	default:
+		err = clients.computeClient.RegionSecurityPolicies.List(clients.project, region).Pages(ctx,
+			func(page *googleComputeClient.SecurityPolicyList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list security policies.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newCloudArmorPoliciesItem converts the security policy into queried item.
+func newCloudArmorPoliciesItem(policy *googleComputeClient.SecurityPolicy,
+	labels types.Map) *cloudArmorPoliciesItemModel {
+	item := &cloudArmorPoliciesItemModel{
+		ID:                           types.Int64Value(int64(policy.Id)),
+		Name:                         types.StringValue(policy.Name),
+		Labels:                       labels,
+		Description:                  types.StringValue(policy.Description),
+		Type:                         types.StringValue(policy.Type),
+		Region:                       types.StringValue(resourceNameFromSelfLink(policy.Region)),
+		AdaptiveProtectionEnabled:    types.BoolValue(false),
+		AdaptiveProtectionVisibility: types.StringValue(""),
+		Rules:                        []*cloudArmorRuleItemModel{},
+		SelfLink:                     types.StringValue(policy.SelfLink),
+	}
+	if policy.AdaptiveProtectionConfig != nil && policy.AdaptiveProtectionConfig.Layer7DdosDefenseConfig != nil {
+		config := policy.AdaptiveProtectionConfig.Layer7DdosDefenseConfig
+		item.AdaptiveProtectionEnabled = types.BoolValue(config.Enable)
+		item.AdaptiveProtectionVisibility = types.StringValue(config.RuleVisibility)
+	}
+
+	// Rules are returned ordered by priority.
+	for _, rule := range policy.Rules {
+		expression := ""
+		srcIPRanges := []attr.Value{}
+		if rule.Match != nil {
+			expression = rule.Match.VersionedExpr
+			if rule.Match.Expr != nil {
+				expression = rule.Match.Expr.Expression
+			}
+			if rule.Match.Config != nil {
+				for _, ipRange := range rule.Match.Config.SrcIpRanges {
+					srcIPRanges = append(srcIPRanges, types.StringValue(ipRange))
+				}
+			}
+		}
+		item.Rules = append(item.Rules, &cloudArmorRuleItemModel{
+			Priority:    types.Int64Value(rule.Priority),
+			Action:      types.StringValue(rule.Action),
+			Description: types.StringValue(rule.Description),
+			Preview:     types.BoolValue(rule.Preview),
+			Expression:  types.StringValue(expression),
+			SrcIPRanges: types.ListValueMust(types.StringType, srcIPRanges),
+		})
+	}
+	return item
+}
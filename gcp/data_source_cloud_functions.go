@@ -0,0 +1,287 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleFunctionsClient "google.golang.org/api/cloudfunctions/v2"
+)
+
+const (
+	cloudFunctionTriggerHTTP  = "HTTP"
+	cloudFunctionTriggerEvent = "EVENT"
+)
+
+var (
+	_ datasource.DataSource              = &CloudFunctionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &CloudFunctionsDataSource{}
+)
+
+// NewCloudFunctionsDataSource
+func NewCloudFunctionsDataSource() datasource.DataSource {
+	return &CloudFunctionsDataSource{}
+}
+
+// CloudFunctionsDataSource
+type CloudFunctionsDataSource struct {
+	client *gcpClients
+}
+
+// CloudFunctionsDataSourceModel
+type CloudFunctionsDataSourceModel struct {
+	ClientConfig *clientConfig              `tfsdk:"client_config"`
+	Location     types.String               `tfsdk:"location"`
+	Labels       types.Map                  `tfsdk:"labels"`
+	TriggerType  types.String               `tfsdk:"trigger_type"`
+	Items        []*cloudFunctionsItemModel `tfsdk:"items"`
+}
+
+type cloudFunctionsItemModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Location        types.String `tfsdk:"location"`
+	Labels          types.Map    `tfsdk:"labels"`
+	Environment     types.String `tfsdk:"environment"`
+	State           types.String `tfsdk:"state"`
+	URL             types.String `tfsdk:"url"`
+	Runtime         types.String `tfsdk:"runtime"`
+	EntryPoint      types.String `tfsdk:"entry_point"`
+	ServiceAccount  types.String `tfsdk:"service_account"`
+	IngressSettings types.String `tfsdk:"ingress_settings"`
+	TriggerType     types.String `tfsdk:"trigger_type"`
+	EventType       types.String `tfsdk:"event_type"`
+	PubsubTopic     types.String `tfsdk:"pubsub_topic"`
+	UpdateTime      types.String `tfsdk:"update_time"`
+}
+
+// Metadata returns the data source Cloud Functions type name.
+func (d *CloudFunctionsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_functions"
+}
+
+// Schema defines the schema for the Cloud Functions data source.
+func (d *CloudFunctionsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the 1st gen and 2nd gen Cloud Functions on " +
+			"Google Cloud with their URLs, runtime and service account.",
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				Description: "Region of function to be filtered. Default to all regions.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of function to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"trigger_type": schema.StringAttribute{
+				Description: "Trigger type of function to be filtered, valid values are " +
+					cloudFunctionTriggerHTTP + " and " + cloudFunctionTriggerEvent + ".",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried functions.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of function.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of function.",
+							Computed:    true,
+						},
+						"location": schema.StringAttribute{
+							Description: "Region of function.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of function.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"environment": schema.StringAttribute{
+							Description: "Generation of function, GEN_1 or GEN_2.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of function, e.g. ACTIVE, FAILED or DEPLOYING.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "URL of function.",
+							Computed:    true,
+						},
+						"runtime": schema.StringAttribute{
+							Description: "Runtime of function, e.g. python311.",
+							Computed:    true,
+						},
+						"entry_point": schema.StringAttribute{
+							Description: "Name of the function executed in source code.",
+							Computed:    true,
+						},
+						"service_account": schema.StringAttribute{
+							Description: "Email of the service account function runs as.",
+							Computed:    true,
+						},
+						"ingress_settings": schema.StringAttribute{
+							Description: "Ingress settings of function, e.g. ALLOW_ALL or ALLOW_INTERNAL_ONLY.",
+							Computed:    true,
+						},
+						"trigger_type": schema.StringAttribute{
+							Description: "Trigger type of function, " + cloudFunctionTriggerHTTP +
+								" or " + cloudFunctionTriggerEvent + ".",
+							Computed: true,
+						},
+						"event_type": schema.StringAttribute{
+							Description: "Type of the event triggering function, empty if " +
+								"trigger type is " + cloudFunctionTriggerHTTP + ".",
+							Computed: true,
+						},
+						"pubsub_topic": schema.StringAttribute{
+							Description: "Pub/Sub topic the events are delivered through, empty if " +
+								"trigger type is " + cloudFunctionTriggerHTTP + ".",
+							Computed: true,
+						},
+						"update_time": schema.StringAttribute{
+							Description: "Last update time of function.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CloudFunctionsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Cloud Functions data source information
+func (d *CloudFunctionsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *CloudFunctionsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	functionsClient, err := googleFunctionsClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Functions client", err.Error())
+		return
+	}
+
+	state := &CloudFunctionsDataSourceModel{
+		Location:    plan.Location,
+		Labels:      plan.Labels,
+		TriggerType: plan.TriggerType,
+		Items:       []*cloudFunctionsItemModel{},
+	}
+
+	// The 2nd gen API lists the functions of both generations, "-" lists the
+	// functions of all regions.
+	location := "-"
+	if plan.Location.ValueString() != "" {
+		location = plan.Location.ValueString()
+	}
+	err = functionsClient.Projects.Locations.Functions.List(fmt.Sprintf("projects/%s/locations/%s",
+		clients.project, location)).Pages(ctx,
+		func(page *googleFunctionsClient.ListFunctionsResponse) error {
+			for _, function := range page.Functions {
+				labels, labelsTfType := labelsValue(function.Labels)
+				if !matchTags(plan.Labels, labels) {
+					continue
+				}
+				item := newCloudFunctionsItem(function, labelsTfType)
+				if !(plan.TriggerType.IsUnknown() || plan.TriggerType.IsNull()) &&
+					!strings.EqualFold(plan.TriggerType.ValueString(), item.TriggerType.ValueString()) {
+					continue
+				}
+				state.Items = append(state.Items, item)
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list functions.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newCloudFunctionsItem converts the function into queried item, the function
+// name is in the format of projects/{project}/locations/{location}/functions/{name}.
+func newCloudFunctionsItem(function *googleFunctionsClient.Function, labels types.Map) *cloudFunctionsItemModel {
+	location := ""
+	if parts := strings.Split(function.Name, "/"); len(parts) >= 4 {
+		location = parts[3]
+	}
+
+	item := &cloudFunctionsItemModel{
+		ID:              types.StringValue(function.Name),
+		Name:            types.StringValue(resourceNameFromSelfLink(function.Name)),
+		Location:        types.StringValue(location),
+		Labels:          labels,
+		Environment:     types.StringValue(function.Environment),
+		State:           types.StringValue(function.State),
+		URL:             types.StringValue(function.Url),
+		Runtime:         types.StringValue(""),
+		EntryPoint:      types.StringValue(""),
+		ServiceAccount:  types.StringValue(""),
+		IngressSettings: types.StringValue(""),
+		TriggerType:     types.StringValue(cloudFunctionTriggerHTTP),
+		EventType:       types.StringValue(""),
+		PubsubTopic:     types.StringValue(""),
+		UpdateTime:      types.StringValue(function.UpdateTime),
+	}
+	if function.BuildConfig != nil {
+		item.Runtime = types.StringValue(function.BuildConfig.Runtime)
+		item.EntryPoint = types.StringValue(function.BuildConfig.EntryPoint)
+	}
+	if function.ServiceConfig != nil {
+		item.ServiceAccount = types.StringValue(function.ServiceConfig.ServiceAccountEmail)
+		item.IngressSettings = types.StringValue(function.ServiceConfig.IngressSettings)
+		if function.Url == "" {
+			item.URL = types.StringValue(function.ServiceConfig.Uri)
+		}
+	}
+	if function.EventTrigger != nil {
+		item.TriggerType = types.StringValue(cloudFunctionTriggerEvent)
+		item.EventType = types.StringValue(function.EventTrigger.EventType)
+		item.PubsubTopic = types.StringValue(function.EventTrigger.PubsubTopic)
+	}
+	return item
+}
@@ -0,0 +1,332 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &CloudNatDataSource{}
+	_ datasource.DataSourceWithConfigure = &CloudNatDataSource{}
+)
+
+// NewCloudNatDataSource
+func NewCloudNatDataSource() datasource.DataSource {
+	return &CloudNatDataSource{}
+}
+
+// CloudNatDataSource
+type CloudNatDataSource struct {
+	client *gcpClients
+}
+
+// CloudNatDataSourceModel
+type CloudNatDataSourceModel struct {
+	ClientConfig *clientConfig        `tfsdk:"client_config"`
+	Name         types.String         `tfsdk:"name"`
+	Router       types.String         `tfsdk:"router"`
+	Network      types.String         `tfsdk:"network"`
+	Region       types.String         `tfsdk:"region"`
+	Items        []*cloudNatItemModel `tfsdk:"items"`
+}
+
+type cloudNatItemModel struct {
+	Name                             types.String `tfsdk:"name"`
+	Router                           types.String `tfsdk:"router"`
+	Region                           types.String `tfsdk:"region"`
+	Network                          types.String `tfsdk:"network"`
+	NatIPAllocateOption              types.String `tfsdk:"nat_ip_allocate_option"`
+	NatIPs                           types.List   `tfsdk:"nat_ips"`
+	DrainNatIPs                      types.List   `tfsdk:"drain_nat_ips"`
+	AllocatedNatIPs                  types.List   `tfsdk:"allocated_nat_ips"`
+	MinExtraNatIPsNeeded             types.Int64  `tfsdk:"min_extra_nat_ips_needed"`
+	NumVMEndpointsWithNatMappings    types.Int64  `tfsdk:"num_vm_endpoints_with_nat_mappings"`
+	MinPortsPerVM                    types.Int64  `tfsdk:"min_ports_per_vm"`
+	MaxPortsPerVM                    types.Int64  `tfsdk:"max_ports_per_vm"`
+	EnableDynamicPortAllocation      types.Bool   `tfsdk:"enable_dynamic_port_allocation"`
+	EnableEndpointIndependentMapping types.Bool   `tfsdk:"enable_endpoint_independent_mapping"`
+	SourceSubnetworkIPRangesToNat    types.String `tfsdk:"source_subnetwork_ip_ranges_to_nat"`
+	Subnetworks                      types.List   `tfsdk:"subnetworks"`
+}
+
+// Metadata returns the data source Cloud NAT type name.
+func (d *CloudNatDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_nat"
+}
+
+// Schema defines the schema for the Cloud NAT data source.
+func (d *CloudNatDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Cloud NAT configurations of the Cloud Routers " +
+			"on Google Cloud with the NAT IPs allocated to them.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of Cloud NAT to be filtered.",
+				Optional:    true,
+			},
+			"router": schema.StringAttribute{
+				Description: "Name of Cloud Router of Cloud NAT to be filtered.",
+				Optional:    true,
+			},
+			"network": schema.StringAttribute{
+				Description: "Self link or name of the network of Cloud NAT to be filtered.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of Cloud NAT to be filtered, Cloud NATs in all regions are " +
+					"queried if not set.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried Cloud NATs.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of Cloud NAT.",
+							Computed:    true,
+						},
+						"router": schema.StringAttribute{
+							Description: "Name of Cloud Router of Cloud NAT.",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of Cloud NAT.",
+							Computed:    true,
+						},
+						"network": schema.StringAttribute{
+							Description: "Network of Cloud NAT.",
+							Computed:    true,
+						},
+						"nat_ip_allocate_option": schema.StringAttribute{
+							Description: "NAT IP allocate option, AUTO_ONLY or MANUAL_ONLY.",
+							Computed:    true,
+						},
+						"nat_ips": schema.ListAttribute{
+							Description: "Self links of the addresses configured as NAT IPs.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"drain_nat_ips": schema.ListAttribute{
+							Description: "Self links of the addresses configured as draining NAT IPs.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"allocated_nat_ips": schema.ListAttribute{
+							Description: "Public IPs allocated to Cloud NAT, both auto-allocated " +
+								"and user-allocated, which the egress traffic uses.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"min_extra_nat_ips_needed": schema.Int64Attribute{
+							Description: "Number of extra NAT IPs needed to serve all the VMs.",
+							Computed:    true,
+						},
+						"num_vm_endpoints_with_nat_mappings": schema.Int64Attribute{
+							Description: "Number of VM endpoints with NAT mappings.",
+							Computed:    true,
+						},
+						"min_ports_per_vm": schema.Int64Attribute{
+							Description: "Minimum number of ports allocated to a VM.",
+							Computed:    true,
+						},
+						"max_ports_per_vm": schema.Int64Attribute{
+							Description: "Maximum number of ports allocated to a VM with dynamic " +
+								"port allocation.",
+							Computed: true,
+						},
+						"enable_dynamic_port_allocation": schema.BoolAttribute{
+							Description: "Whether dynamic port allocation is enabled.",
+							Computed:    true,
+						},
+						"enable_endpoint_independent_mapping": schema.BoolAttribute{
+							Description: "Whether endpoint independent mapping is enabled.",
+							Computed:    true,
+						},
+						"source_subnetwork_ip_ranges_to_nat": schema.StringAttribute{
+							Description: "Subnetwork IP ranges to NAT, e.g. ALL_SUBNETWORKS_ALL_IP_RANGES " +
+								"or LIST_OF_SUBNETWORKS.",
+							Computed: true,
+						},
+						"subnetworks": schema.ListAttribute{
+							Description: "Self links of the subnetworks to NAT if " +
+								"source_subnetwork_ip_ranges_to_nat is LIST_OF_SUBNETWORKS.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CloudNatDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Cloud NAT data source information
+func (d *CloudNatDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *CloudNatDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &CloudNatDataSourceModel{
+		Name:    plan.Name,
+		Router:  plan.Router,
+		Network: plan.Network,
+		Region:  plan.Region,
+		Items:   []*cloudNatItemModel{},
+	}
+
+	routers := []*googleComputeClient.Router{}
+	appendRouters := func(items []*googleComputeClient.Router) {
+		for _, router := range items {
+			if !(plan.Router.IsUnknown() || plan.Router.IsNull()) && plan.Router.ValueString() != router.Name {
+				continue
+			}
+			if !(plan.Network.IsUnknown() || plan.Network.IsNull()) &&
+				!matchResourceReference(router.Network, plan.Network.ValueString()) {
+				continue
+			}
+			if len(router.Nats) == 0 {
+				continue
+			}
+			routers = append(routers, router)
+		}
+	}
+
+	var err error
+	switch region := plan.Region.ValueString(); region {
+	case "":
+		err = clients.computeClient.Routers.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.RouterAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendRouters(page.Items[scope].Routers)
+				}
+				return nil
+			})
+	default:
+		err = clients.computeClient.Routers.List(clients.project, region).Pages(ctx,
+			func(page *googleComputeClient.RouterList) error {
+				appendRouters(page.Items)
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list routers.",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, router := range routers {
+		region := resourceNameFromSelfLink(router.Region)
+		// The NAT IPs allocated to Cloud NAT are only available in the
+		// status of router, the auto-allocated NAT IPs in particular.
+		routerStatus, err := clients.computeClient.Routers.GetRouterStatus(clients.project, region, router.Name).
+			Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to get router status.",
+				err.Error(),
+			)
+			return
+		}
+		natStatuses := map[string]*googleComputeClient.RouterStatusNatStatus{}
+		if routerStatus.Result != nil {
+			for _, natStatus := range routerStatus.Result.NatStatus {
+				natStatuses[natStatus.Name] = natStatus
+			}
+		}
+
+		for _, nat := range router.Nats {
+			if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != nat.Name {
+				continue
+			}
+			state.Items = append(state.Items, newCloudNatItem(router, nat, natStatuses[nat.Name]))
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newCloudNatItem converts the Cloud NAT of router into queried item.
+func newCloudNatItem(router *googleComputeClient.Router, nat *googleComputeClient.RouterNat,
+	natStatus *googleComputeClient.RouterStatusNatStatus) *cloudNatItemModel {
+	natIPs := []attr.Value{}
+	for _, natIP := range nat.NatIps {
+		natIPs = append(natIPs, types.StringValue(natIP))
+	}
+	drainNatIPs := []attr.Value{}
+	for _, drainNatIP := range nat.DrainNatIps {
+		drainNatIPs = append(drainNatIPs, types.StringValue(drainNatIP))
+	}
+	subnetworks := []attr.Value{}
+	for _, subnetwork := range nat.Subnetworks {
+		subnetworks = append(subnetworks, types.StringValue(subnetwork.Name))
+	}
+	allocatedNatIPs := []attr.Value{}
+	var minExtraNatIPsNeeded, numVMEndpointsWithNatMappings int64
+	if natStatus != nil {
+		for _, natIP := range natStatus.AutoAllocatedNatIps {
+			allocatedNatIPs = append(allocatedNatIPs, types.StringValue(natIP))
+		}
+		for _, natIP := range natStatus.UserAllocatedNatIps {
+			allocatedNatIPs = append(allocatedNatIPs, types.StringValue(natIP))
+		}
+		minExtraNatIPsNeeded = natStatus.MinExtraNatIpsNeeded
+		numVMEndpointsWithNatMappings = natStatus.NumVmEndpointsWithNatMappings
+	}
+
+	return &cloudNatItemModel{
+		Name:                             types.StringValue(nat.Name),
+		Router:                           types.StringValue(router.Name),
+		Region:                           types.StringValue(resourceNameFromSelfLink(router.Region)),
+		Network:                          types.StringValue(router.Network),
+		NatIPAllocateOption:              types.StringValue(nat.NatIpAllocateOption),
+		NatIPs:                           types.ListValueMust(types.StringType, natIPs),
+		DrainNatIPs:                      types.ListValueMust(types.StringType, drainNatIPs),
+		AllocatedNatIPs:                  types.ListValueMust(types.StringType, allocatedNatIPs),
+		MinExtraNatIPsNeeded:             types.Int64Value(minExtraNatIPsNeeded),
+		NumVMEndpointsWithNatMappings:    types.Int64Value(numVMEndpointsWithNatMappings),
+		MinPortsPerVM:                    types.Int64Value(nat.MinPortsPerVm),
+		MaxPortsPerVM:                    types.Int64Value(nat.MaxPortsPerVm),
+		EnableDynamicPortAllocation:      types.BoolValue(nat.EnableDynamicPortAllocation),
+		EnableEndpointIndependentMapping: types.BoolValue(nat.EnableEndpointIndependentMapping),
+		SourceSubnetworkIPRangesToNat:    types.StringValue(nat.SourceSubnetworkIpRangesToNat),
+		Subnetworks:                      types.ListValueMust(types.StringType, subnetworks),
+	}
+}
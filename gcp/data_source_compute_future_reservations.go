@@ -0,0 +1,275 @@
+package gcp
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeAlphaClient "google.golang.org/api/compute/v0.alpha"
+)
+
+var (
+	_ datasource.DataSource              = &ComputeFutureReservationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ComputeFutureReservationsDataSource{}
+)
+
+// NewComputeFutureReservationsDataSource
+func NewComputeFutureReservationsDataSource() datasource.DataSource {
+	return &ComputeFutureReservationsDataSource{}
+}
+
+// ComputeFutureReservationsDataSource
+type ComputeFutureReservationsDataSource struct {
+	client *gcpClients
+}
+
+// ComputeFutureReservationsDataSourceModel
+type ComputeFutureReservationsDataSourceModel struct {
+	ClientConfig *clientConfig                         `tfsdk:"client_config"`
+	Name         types.String                          `tfsdk:"name"`
+	Zone         types.String                          `tfsdk:"zone"`
+	Items        []*computeFutureReservationsItemModel `tfsdk:"items"`
+}
+
+type computeFutureReservationsItemModel struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Zone                    types.String `tfsdk:"zone"`
+	MachineType             types.String `tfsdk:"machine_type"`
+	TotalCount              types.Int64  `tfsdk:"total_count"`
+	FulfilledCount          types.Int64  `tfsdk:"fulfilled_count"`
+	StartTime               types.String `tfsdk:"start_time"`
+	EndTime                 types.String `tfsdk:"end_time"`
+	PlanningStatus          types.String `tfsdk:"planning_status"`
+	ProcurementStatus       types.String `tfsdk:"procurement_status"`
+	LockTime                types.String `tfsdk:"lock_time"`
+	AutoCreatedReservations types.List   `tfsdk:"auto_created_reservations"`
+	SelfLink                types.String `tfsdk:"self_link"`
+}
+
+// Metadata returns the data source future reservations type name.
+func (d *ComputeFutureReservationsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_future_reservations"
+}
+
+// Schema defines the schema for the future reservations data source.
+func (d *ComputeFutureReservationsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the compute future reservations and " +
+			"their fulfillment status on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of future reservation to be filtered.",
+				Optional:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of future reservations to be listed. Default to list " +
+					"the future reservations in all zones.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried future reservations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of future reservation.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of future reservation.",
+							Computed:    true,
+						},
+						"zone": schema.StringAttribute{
+							Description: "Zone of future reservation.",
+							Computed:    true,
+						},
+						"machine_type": schema.StringAttribute{
+							Description: "Machine type of the reserved instances.",
+							Computed:    true,
+						},
+						"total_count": schema.Int64Attribute{
+							Description: "Total number of instances reserved.",
+							Computed:    true,
+						},
+						"fulfilled_count": schema.Int64Attribute{
+							Description: "Number of instances already fulfilled.",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Start time of the reserved capacity in RFC3339 format.",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "End time of the reserved capacity in RFC3339 format.",
+							Computed:    true,
+						},
+						"planning_status": schema.StringAttribute{
+							Description: "Planning status of future reservation, e.g. DRAFT or SUBMITTED.",
+							Computed:    true,
+						},
+						"procurement_status": schema.StringAttribute{
+							Description: "Procurement status of future reservation, e.g. " +
+								"PENDING_APPROVAL, PROCURING or FULFILLED.",
+							Computed: true,
+						},
+						"lock_time": schema.StringAttribute{
+							Description: "Time after which future reservation can no longer be amended.",
+							Computed:    true,
+						},
+						"auto_created_reservations": schema.ListAttribute{
+							Description: "Self links of the reservations automatically created " +
+								"when future reservation is fulfilled.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of future reservation.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ComputeFutureReservationsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read future reservations data source information
+func (d *ComputeFutureReservationsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ComputeFutureReservationsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Future reservations are only available in the alpha API.
+	computeAlphaClient, err := googleComputeAlphaClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Compute alpha client", err.Error())
+		return
+	}
+
+	state := &ComputeFutureReservationsDataSourceModel{
+		Name:  plan.Name,
+		Zone:  plan.Zone,
+		Items: []*computeFutureReservationsItemModel{},
+	}
+
+	appendItems := func(futureReservations []*googleComputeAlphaClient.FutureReservation) {
+		for _, futureReservation := range futureReservations {
+			if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != futureReservation.Name {
+				continue
+			}
+			state.Items = append(state.Items, newComputeFutureReservationsItem(futureReservation))
+		}
+	}
+
+	if zone := plan.Zone.ValueString(); zone != "" {
+		err = computeAlphaClient.FutureReservations.List(clients.project, zone).Pages(ctx,
+			func(page *googleComputeAlphaClient.FutureReservationsListResponse) error {
+				appendItems(page.Items)
+				return nil
+			})
+	} else {
+		err = computeAlphaClient.FutureReservations.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeAlphaClient.FutureReservationsAggregatedListResponse) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendItems(page.Items[scope].FutureReservations)
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list compute future reservations.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newComputeFutureReservationsItem converts the future reservation into
+// queried item.
+func newComputeFutureReservationsItem(
+	futureReservation *googleComputeAlphaClient.FutureReservation) *computeFutureReservationsItemModel {
+	item := &computeFutureReservationsItemModel{
+		ID:                      types.Int64Value(int64(futureReservation.Id)),
+		Name:                    types.StringValue(futureReservation.Name),
+		Zone:                    types.StringValue(resourceNameFromSelfLink(futureReservation.Zone)),
+		MachineType:             types.StringValue(""),
+		TotalCount:              types.Int64Value(0),
+		FulfilledCount:          types.Int64Value(0),
+		StartTime:               types.StringValue(""),
+		EndTime:                 types.StringValue(""),
+		PlanningStatus:          types.StringValue(futureReservation.PlanningStatus),
+		ProcurementStatus:       types.StringValue(""),
+		LockTime:                types.StringValue(""),
+		AutoCreatedReservations: types.ListValueMust(types.StringType, nil),
+		SelfLink:                types.StringValue(futureReservation.SelfLink),
+	}
+
+	if sku := futureReservation.SpecificSkuProperties; sku != nil {
+		item.TotalCount = types.Int64Value(sku.TotalCount)
+		if sku.InstanceProperties != nil {
+			item.MachineType = types.StringValue(sku.InstanceProperties.MachineType)
+		}
+	}
+
+	if window := futureReservation.TimeWindow; window != nil {
+		item.StartTime = types.StringValue(window.StartTime)
+		item.EndTime = types.StringValue(window.EndTime)
+		// The end time is not set if the time window is specified by duration.
+		if window.EndTime == "" && window.Duration != nil {
+			if startTime, err := time.Parse(time.RFC3339, window.StartTime); err == nil {
+				endTime := startTime.Add(time.Duration(window.Duration.Seconds) * time.Second)
+				item.EndTime = types.StringValue(endTime.Format(time.RFC3339))
+			}
+		}
+	}
+
+	if status := futureReservation.Status; status != nil {
+		item.FulfilledCount = types.Int64Value(status.FulfilledCount)
+		item.ProcurementStatus = types.StringValue(status.ProcurementStatus)
+		item.LockTime = types.StringValue(status.LockTime)
+
+		reservations := []attr.Value{}
+		for _, reservation := range status.AutoCreatedReservations {
+			reservations = append(reservations, types.StringValue(reservation))
+		}
+		item.AutoCreatedReservations = types.ListValueMust(types.StringType, reservations)
+	}
+	return item
+}
@@ -0,0 +1,244 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &ComputeImageDataSource{}
+	_ datasource.DataSourceWithConfigure = &ComputeImageDataSource{}
+)
+
+// NewComputeImageDataSource
+func NewComputeImageDataSource() datasource.DataSource {
+	return &ComputeImageDataSource{}
+}
+
+// ComputeImageDataSource
+type ComputeImageDataSource struct {
+	client *gcpClients
+}
+
+// ComputeImageDataSourceModel
+type ComputeImageDataSourceModel struct {
+	ClientConfig      *clientConfig `tfsdk:"client_config"`
+	Family            types.String  `tfsdk:"family"`
+	Projects          types.List    `tfsdk:"projects"`
+	Labels            types.Map     `tfsdk:"labels"`
+	Architecture      types.String  `tfsdk:"architecture"`
+	ID                types.Int64   `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	SelfLink          types.String  `tfsdk:"self_link"`
+	Project           types.String  `tfsdk:"project"`
+	ImageLabels       types.Map     `tfsdk:"image_labels"`
+	ImageArchitecture types.String  `tfsdk:"image_architecture"`
+	DiskSizeGb        types.Int64   `tfsdk:"disk_size_gb"`
+	Description       types.String  `tfsdk:"description"`
+	CreationTimestamp types.String  `tfsdk:"creation_timestamp"`
+}
+
+// Metadata returns the data source compute image type name.
+func (d *ComputeImageDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_image"
+}
+
+// Schema defines the schema for the compute image data source.
+func (d *ComputeImageDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source resolves the latest non-deprecated image of a family " +
+			"across one or more projects on Google Cloud, so golden image pipelines can " +
+			"feed the instance templates deterministically.",
+		Attributes: map[string]schema.Attribute{
+			"family": schema.StringAttribute{
+				Description: "Family of image, e.g. debian-12.",
+				Required:    true,
+			},
+			"projects": schema.ListAttribute{
+				Description: "Projects to look up the image in, e.g. a shared image project " +
+					"or debian-cloud. Default to the project configured in the provider or " +
+					"client_config. When several projects have a matching image, the latest " +
+					"created image is returned and ties are resolved by the order of projects.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of image to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"architecture": schema.StringAttribute{
+				Description: "Architecture of image to be filtered, X86_64 or ARM64.",
+				Optional:    true,
+			},
+			"id": schema.Int64Attribute{
+				Description: "ID of image.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of image.",
+				Computed:    true,
+			},
+			"self_link": schema.StringAttribute{
+				Description: "Self link of image, used as the source image of instance templates.",
+				Computed:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "Project of image.",
+				Computed:    true,
+			},
+			"image_labels": schema.MapAttribute{
+				Description: "Labels of image.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"image_architecture": schema.StringAttribute{
+				Description: "Architecture of image, empty if image does not specify it.",
+				Computed:    true,
+			},
+			"disk_size_gb": schema.Int64Attribute{
+				Description: "Size of the disks created from image in GB.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of image.",
+				Computed:    true,
+			},
+			"creation_timestamp": schema.StringAttribute{
+				Description: "Creation time of image.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ComputeImageDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read compute image data source information
+func (d *ComputeImageDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ComputeImageDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects := []string{}
+	if !(plan.Projects.IsUnknown() || plan.Projects.IsNull()) {
+		resp.Diagnostics.Append(plan.Projects.ElementsAs(ctx, &projects, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if len(projects) == 0 {
+		projects = append(projects, clients.project)
+	}
+
+	var (
+		latest        *googleComputeClient.Image
+		latestProject string
+		latestTime    time.Time
+	)
+	for _, project := range projects {
+		err := clients.computeClient.Images.List(project).
+			Filter(fmt.Sprintf("family = %q", plan.Family.ValueString())).Pages(ctx,
+			func(page *googleComputeClient.ImageList) error {
+				for _, image := range page.Items {
+					if image.Status != "READY" || isComputeImageDeprecated(image) {
+						continue
+					}
+					if !(plan.Architecture.IsUnknown() || plan.Architecture.IsNull()) &&
+						!strings.EqualFold(plan.Architecture.ValueString(), image.Architecture) {
+						continue
+					}
+					labels, _ := labelsValue(image.Labels)
+					if !matchTags(plan.Labels, labels) {
+						continue
+					}
+					creationTime, err := time.Parse(time.RFC3339, image.CreationTimestamp)
+					if err != nil {
+						return fmt.Errorf("invalid creation timestamp of image %s: %v", image.Name, err)
+					}
+					// Images created at the same time are resolved by the
+					// order of projects, the first one wins.
+					if latest == nil || creationTime.After(latestTime) {
+						latest, latestProject, latestTime = image, project, creationTime
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to list images.",
+				fmt.Sprintf("Failed to list images of project %s: %s", project, err.Error()),
+			)
+			return
+		}
+	}
+
+	if latest == nil {
+		resp.Diagnostics.AddError(
+			"Image not found",
+			fmt.Sprintf("No non-deprecated image of family %s matches the filters in projects %s.",
+				plan.Family.ValueString(), strings.Join(projects, ", ")),
+		)
+		return
+	}
+
+	_, imageLabels := labelsValue(latest.Labels)
+	state := &ComputeImageDataSourceModel{
+		Family:            plan.Family,
+		Projects:          plan.Projects,
+		Labels:            plan.Labels,
+		Architecture:      plan.Architecture,
+		ID:                types.Int64Value(int64(latest.Id)),
+		Name:              types.StringValue(latest.Name),
+		SelfLink:          types.StringValue(latest.SelfLink),
+		Project:           types.StringValue(latestProject),
+		ImageLabels:       imageLabels,
+		ImageArchitecture: types.StringValue(latest.Architecture),
+		DiskSizeGb:        types.Int64Value(latest.DiskSizeGb),
+		Description:       types.StringValue(latest.Description),
+		CreationTimestamp: types.StringValue(latest.CreationTimestamp),
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// isComputeImageDeprecated returns whether the image is deprecated, obsolete
+// or deleted.
+func isComputeImageDeprecated(image *googleComputeClient.Image) bool {
+	return image.Deprecated != nil && image.Deprecated.State != "" && image.Deprecated.State != "ACTIVE"
+}
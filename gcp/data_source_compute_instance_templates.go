@@ -0,0 +1,427 @@
+package gcp
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &ComputeInstanceTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ComputeInstanceTemplatesDataSource{}
+)
+
+// NewComputeInstanceTemplatesDataSource
+func NewComputeInstanceTemplatesDataSource() datasource.DataSource {
+	return &ComputeInstanceTemplatesDataSource{}
+}
+
+// ComputeInstanceTemplatesDataSource
+type ComputeInstanceTemplatesDataSource struct {
+	client *gcpClients
+}
+
+// ComputeInstanceTemplatesDataSourceModel
+type ComputeInstanceTemplatesDataSourceModel struct {
+	ClientConfig *clientConfig                        `tfsdk:"client_config"`
+	NamePrefix   types.String                         `tfsdk:"name_prefix"`
+	Labels       types.Map                            `tfsdk:"labels"`
+	MachineType  types.String                         `tfsdk:"machine_type"`
+	Region       types.String                         `tfsdk:"region"`
+	Latest       *latestInstanceTemplateModel         `tfsdk:"latest"`
+	Items        []*computeInstanceTemplatesItemModel `tfsdk:"items"`
+}
+
+type latestInstanceTemplateModel struct {
+	Name              types.String `tfsdk:"name"`
+	CreationTimestamp types.String `tfsdk:"creation_timestamp"`
+	SelfLink          types.String `tfsdk:"self_link"`
+}
+
+type computeInstanceTemplatesItemModel struct {
+	ID                types.Int64                              `tfsdk:"id"`
+	Name              types.String                             `tfsdk:"name"`
+	Labels            types.Map                                `tfsdk:"labels"`
+	Description       types.String                             `tfsdk:"description"`
+	MachineType       types.String                             `tfsdk:"machine_type"`
+	Region            types.String                             `tfsdk:"region"`
+	Disks             []*instanceTemplateDiskItemModel         `tfsdk:"disks"`
+	NetworkInterfaces []*instanceTemplateNetworkInterfaceModel `tfsdk:"network_interfaces"`
+	NetworkTags       types.List                               `tfsdk:"network_tags"`
+	MetadataKeys      types.List                               `tfsdk:"metadata_keys"`
+	ServiceAccount    types.String                             `tfsdk:"service_account"`
+	CreationTimestamp types.String                             `tfsdk:"creation_timestamp"`
+	SelfLink          types.String                             `tfsdk:"self_link"`
+}
+
+type instanceTemplateDiskItemModel struct {
+	DeviceName  types.String `tfsdk:"device_name"`
+	Boot        types.Bool   `tfsdk:"boot"`
+	SourceImage types.String `tfsdk:"source_image"`
+	DiskType    types.String `tfsdk:"disk_type"`
+	DiskSizeGb  types.Int64  `tfsdk:"disk_size_gb"`
+}
+
+type instanceTemplateNetworkInterfaceModel struct {
+	Network          types.String `tfsdk:"network"`
+	Subnetwork       types.String `tfsdk:"subnetwork"`
+	ExternalIPAccess types.Bool   `tfsdk:"external_ip_access"`
+}
+
+// Metadata returns the data source compute instance templates type name.
+func (d *ComputeInstanceTemplatesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_instance_templates"
+}
+
+// Schema defines the schema for the compute instance templates data source.
+func (d *ComputeInstanceTemplatesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the compute instance templates on Google Cloud " +
+			"with the newest template of the name prefix, e.g. the latest approved template " +
+			"of a template family named <family>-<version>.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Name prefix of instance template to be filtered.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of instance template to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"machine_type": schema.StringAttribute{
+				Description: "Machine type of instance template to be filtered, e.g. e2-medium.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of instance template to be filtered, global for the global " +
+					"instance templates. Instance templates in all regions are queried if not set.",
+				Optional: true,
+			},
+			"latest": schema.SingleNestedAttribute{
+				Description: "The newest instance template of the queried instance templates, " +
+					"null if no instance template is found.",
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of instance template.",
+						Computed:    true,
+					},
+					"creation_timestamp": schema.StringAttribute{
+						Description: "Creation timestamp of instance template.",
+						Computed:    true,
+					},
+					"self_link": schema.StringAttribute{
+						Description: "Self link of instance template.",
+						Computed:    true,
+					},
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried instance templates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of instance template.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of instance template.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of the instances created from instance template.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of instance template.",
+							Computed:    true,
+						},
+						"machine_type": schema.StringAttribute{
+							Description: "Machine type of instance template.",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of instance template, empty for global instance template.",
+							Computed:    true,
+						},
+						"disks": schema.ListNestedAttribute{
+							Description: "Disks of instance template.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"device_name": schema.StringAttribute{
+										Description: "Device name of disk.",
+										Computed:    true,
+									},
+									"boot": schema.BoolAttribute{
+										Description: "Whether disk is the boot disk.",
+										Computed:    true,
+									},
+									"source_image": schema.StringAttribute{
+										Description: "Source image of disk.",
+										Computed:    true,
+									},
+									"disk_type": schema.StringAttribute{
+										Description: "Type of disk, e.g. pd-balanced.",
+										Computed:    true,
+									},
+									"disk_size_gb": schema.Int64Attribute{
+										Description: "Size of disk in GB.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"network_interfaces": schema.ListNestedAttribute{
+							Description: "Network interfaces of instance template.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"network": schema.StringAttribute{
+										Description: "Network of network interface.",
+										Computed:    true,
+									},
+									"subnetwork": schema.StringAttribute{
+										Description: "Subnetwork of network interface.",
+										Computed:    true,
+									},
+									"external_ip_access": schema.BoolAttribute{
+										Description: "Whether network interface has an external IP.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"network_tags": schema.ListAttribute{
+							Description: "Network tags of instance template.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"metadata_keys": schema.ListAttribute{
+							Description: "Metadata keys of instance template, the values are not " +
+								"exposed as they may contain startup scripts or secrets.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"service_account": schema.StringAttribute{
+							Description: "Email of the service account of instance template.",
+							Computed:    true,
+						},
+						"creation_timestamp": schema.StringAttribute{
+							Description: "Creation timestamp of instance template.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of instance template.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ComputeInstanceTemplatesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read compute instance templates data source information
+func (d *ComputeInstanceTemplatesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ComputeInstanceTemplatesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &ComputeInstanceTemplatesDataSourceModel{
+		NamePrefix:  plan.NamePrefix,
+		Labels:      plan.Labels,
+		MachineType: plan.MachineType,
+		Region:      plan.Region,
+		Items:       []*computeInstanceTemplatesItemModel{},
+	}
+
+	templates := []*googleComputeClient.InstanceTemplate{}
+	appendItems := func(instanceTemplates []*googleComputeClient.InstanceTemplate) {
+		for _, template := range instanceTemplates {
+			if !(plan.NamePrefix.IsUnknown() || plan.NamePrefix.IsNull()) &&
+				!strings.HasPrefix(template.Name, plan.NamePrefix.ValueString()) {
+				continue
+			}
+			properties := template.Properties
+			if properties == nil {
+				properties = &googleComputeClient.InstanceProperties{}
+			}
+			if !(plan.MachineType.IsUnknown() || plan.MachineType.IsNull()) &&
+				plan.MachineType.ValueString() != resourceNameFromSelfLink(properties.MachineType) {
+				continue
+			}
+			labels, labelsTfType := labelsValue(properties.Labels)
+			if !matchTags(plan.Labels, labels) {
+				continue
+			}
+			templates = append(templates, template)
+			state.Items = append(state.Items, newComputeInstanceTemplatesItem(template, properties, labelsTfType))
+		}
+	}
+
+	var err error
+	switch region := plan.Region.ValueString(); region {
+	case "":
+		err = clients.computeClient.InstanceTemplates.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.InstanceTemplateAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendItems(page.Items[scope].InstanceTemplates)
+				}
+				return nil
+			})
+	case globalScope:
+		err = clients.computeClient.InstanceTemplates.List(clients.project).Pages(ctx,
+			func(page *googleComputeClient.InstanceTemplateList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	default:
+		err = clients.computeClient.RegionInstanceTemplates.List(clients.project, region).Pages(ctx,
+			func(page *googleComputeClient.InstanceTemplateList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list instance templates.",
+			err.Error(),
+		)
+		return
+	}
+
+	if latest := latestInstanceTemplate(templates); latest != nil {
+		state.Latest = &latestInstanceTemplateModel{
+			Name:              types.StringValue(latest.Name),
+			CreationTimestamp: types.StringValue(latest.CreationTimestamp),
+			SelfLink:          types.StringValue(latest.SelfLink),
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// latestInstanceTemplate returns the newest instance template, the name is
+// compared if the creation timestamps are the same to keep the result stable.
+func latestInstanceTemplate(templates []*googleComputeClient.InstanceTemplate) *googleComputeClient.InstanceTemplate {
+	if len(templates) == 0 {
+		return nil
+	}
+	sorted := make([]*googleComputeClient.InstanceTemplate, len(templates))
+	copy(sorted, templates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ti, _ := time.Parse(time.RFC3339, sorted[i].CreationTimestamp)
+		tj, _ := time.Parse(time.RFC3339, sorted[j].CreationTimestamp)
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return sorted[i].Name > sorted[j].Name
+	})
+	return sorted[0]
+}
+
+// newComputeInstanceTemplatesItem converts the instance template into queried item.
+func newComputeInstanceTemplatesItem(template *googleComputeClient.InstanceTemplate,
+	properties *googleComputeClient.InstanceProperties, labels types.Map) *computeInstanceTemplatesItemModel {
+	disks := []*instanceTemplateDiskItemModel{}
+	for _, disk := range properties.Disks {
+		item := &instanceTemplateDiskItemModel{
+			DeviceName:  types.StringValue(disk.DeviceName),
+			Boot:        types.BoolValue(disk.Boot),
+			SourceImage: types.StringValue(""),
+			DiskType:    types.StringValue(""),
+			DiskSizeGb:  types.Int64Value(disk.DiskSizeGb),
+		}
+		if disk.InitializeParams != nil {
+			item.SourceImage = types.StringValue(disk.InitializeParams.SourceImage)
+			item.DiskType = types.StringValue(resourceNameFromSelfLink(disk.InitializeParams.DiskType))
+			if disk.InitializeParams.DiskSizeGb != 0 {
+				item.DiskSizeGb = types.Int64Value(disk.InitializeParams.DiskSizeGb)
+			}
+		}
+		disks = append(disks, item)
+	}
+
+	networkInterfaces := []*instanceTemplateNetworkInterfaceModel{}
+	for _, networkInterface := range properties.NetworkInterfaces {
+		networkInterfaces = append(networkInterfaces, &instanceTemplateNetworkInterfaceModel{
+			Network:          types.StringValue(networkInterface.Network),
+			Subnetwork:       types.StringValue(networkInterface.Subnetwork),
+			ExternalIPAccess: types.BoolValue(len(networkInterface.AccessConfigs) > 0),
+		})
+	}
+
+	networkTags := []attr.Value{}
+	if properties.Tags != nil {
+		for _, tag := range properties.Tags.Items {
+			networkTags = append(networkTags, types.StringValue(tag))
+		}
+	}
+	metadataKeys := []attr.Value{}
+	if properties.Metadata != nil {
+		for _, item := range properties.Metadata.Items {
+			metadataKeys = append(metadataKeys, types.StringValue(item.Key))
+		}
+	}
+	serviceAccount := ""
+	if len(properties.ServiceAccounts) > 0 {
+		serviceAccount = properties.ServiceAccounts[0].Email
+	}
+
+	return &computeInstanceTemplatesItemModel{
+		ID:                types.Int64Value(int64(template.Id)),
+		Name:              types.StringValue(template.Name),
+		Labels:            labels,
+		Description:       types.StringValue(template.Description),
+		MachineType:       types.StringValue(resourceNameFromSelfLink(properties.MachineType)),
+		Region:            types.StringValue(resourceNameFromSelfLink(template.Region)),
+		Disks:             disks,
+		NetworkInterfaces: networkInterfaces,
+		NetworkTags:       types.ListValueMust(types.StringType, networkTags),
+		MetadataKeys:      types.ListValueMust(types.StringType, metadataKeys),
+		ServiceAccount:    types.StringValue(serviceAccount),
+		CreationTimestamp: types.StringValue(template.CreationTimestamp),
+		SelfLink:          types.StringValue(template.SelfLink),
+	}
+}
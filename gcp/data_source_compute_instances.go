@@ -0,0 +1,294 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &ComputeInstancesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ComputeInstancesDataSource{}
+)
+
+// NewComputeInstancesDataSource
+func NewComputeInstancesDataSource() datasource.DataSource {
+	return &ComputeInstancesDataSource{}
+}
+
+// ComputeInstancesDataSource
+type ComputeInstancesDataSource struct {
+	client *gcpClients
+}
+
+// ComputeInstancesDataSourceModel
+type ComputeInstancesDataSourceModel struct {
+	ClientConfig *clientConfig                `tfsdk:"client_config"`
+	Name         types.String                 `tfsdk:"name"`
+	Labels       types.Map                    `tfsdk:"labels"`
+	NetworkTags  types.List                   `tfsdk:"network_tags"`
+	Status       types.String                 `tfsdk:"status"`
+	Zone         types.String                 `tfsdk:"zone"`
+	Region       types.String                 `tfsdk:"region"`
+	Items        []*computeInstancesItemModel `tfsdk:"items"`
+}
+
+type computeInstancesItemModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Labels      types.Map    `tfsdk:"labels"`
+	NetworkTags types.List   `tfsdk:"network_tags"`
+	Status      types.String `tfsdk:"status"`
+	Zone        types.String `tfsdk:"zone"`
+	MachineType types.String `tfsdk:"machine_type"`
+	InternalIPs types.List   `tfsdk:"internal_ips"`
+	ExternalIPs types.List   `tfsdk:"external_ips"`
+	SelfLink    types.String `tfsdk:"self_link"`
+}
+
+// Metadata returns the data source compute instances type name.
+func (d *ComputeInstancesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_instances"
+}
+
+// Schema defines the schema for the compute instances data source.
+func (d *ComputeInstancesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the compute instances on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of instance to be filtered.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of instance to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"network_tags": schema.ListAttribute{
+				Description: "Network tags of instance to be filtered, instances with all " +
+					"the network tags are included.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of instance to be filtered, e.g. RUNNING or TERMINATED.",
+				Optional:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of instances to be filtered.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of instances to be filtered, instances in all zones " +
+					"of the region are included. Default to list the instances in all zones.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried instances.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of instance.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of instance.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of instance.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"network_tags": schema.ListAttribute{
+							Description: "Network tags of instance.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of instance.",
+							Computed:    true,
+						},
+						"zone": schema.StringAttribute{
+							Description: "Zone of instance.",
+							Computed:    true,
+						},
+						"machine_type": schema.StringAttribute{
+							Description: "Machine type of instance, e.g. e2-medium.",
+							Computed:    true,
+						},
+						"internal_ips": schema.ListAttribute{
+							Description: "Internal IP addresses of the network interfaces of instance.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"external_ips": schema.ListAttribute{
+							Description: "External IP addresses of the network interfaces of instance.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of instance.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ComputeInstancesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read compute instances data source information
+func (d *ComputeInstancesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ComputeInstancesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkTags := []string{}
+	if !(plan.NetworkTags.IsUnknown() || plan.NetworkTags.IsNull()) {
+		resp.Diagnostics.Append(plan.NetworkTags.ElementsAs(ctx, &networkTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state := &ComputeInstancesDataSourceModel{
+		Name:        plan.Name,
+		Labels:      plan.Labels,
+		NetworkTags: plan.NetworkTags,
+		Status:      plan.Status,
+		Zone:        plan.Zone,
+		Region:      plan.Region,
+		Items:       []*computeInstancesItemModel{},
+	}
+
+	appendItems := func(instances []*googleComputeClient.Instance) {
+		for _, instance := range instances {
+			if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != instance.Name {
+				continue
+			}
+			if !(plan.Status.IsUnknown() || plan.Status.IsNull()) && plan.Status.ValueString() != instance.Status {
+				continue
+			}
+			instanceTags := []string{}
+			if instance.Tags != nil {
+				instanceTags = instance.Tags.Items
+			}
+			if len(stringsNotIn(networkTags, instanceTags)) > 0 {
+				continue
+			}
+			labels, labelsTfType := labelsValue(instance.Labels)
+			if !matchTags(plan.Labels, labels) {
+				continue
+			}
+			state.Items = append(state.Items, newComputeInstancesItem(instance, labelsTfType))
+		}
+	}
+
+	var err error
+	if zone := plan.Zone.ValueString(); zone != "" {
+		err = clients.computeClient.Instances.List(clients.project, zone).Pages(ctx,
+			func(page *googleComputeClient.InstanceList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	} else {
+		// Zones are named after their region, e.g. asia-east1-a.
+		zonePrefix := "zones/"
+		if region := plan.Region.ValueString(); region != "" {
+			zonePrefix += region + "-"
+		}
+		err = clients.computeClient.Instances.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.InstanceAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					if strings.HasPrefix(scope, zonePrefix) {
+						appendItems(page.Items[scope].Instances)
+					}
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list instances.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newComputeInstancesItem converts the instance into queried item.
+func newComputeInstancesItem(instance *googleComputeClient.Instance,
+	labels types.Map) *computeInstancesItemModel {
+	networkTags := []attr.Value{}
+	if instance.Tags != nil {
+		for _, tag := range instance.Tags.Items {
+			networkTags = append(networkTags, types.StringValue(tag))
+		}
+	}
+
+	internalIPs := []attr.Value{}
+	externalIPs := []attr.Value{}
+	for _, networkInterface := range instance.NetworkInterfaces {
+		if networkInterface.NetworkIP != "" {
+			internalIPs = append(internalIPs, types.StringValue(networkInterface.NetworkIP))
+		}
+		for _, accessConfig := range networkInterface.AccessConfigs {
+			if accessConfig.NatIP != "" {
+				externalIPs = append(externalIPs, types.StringValue(accessConfig.NatIP))
+			}
+		}
+	}
+
+	return &computeInstancesItemModel{
+		ID:          types.Int64Value(int64(instance.Id)),
+		Name:        types.StringValue(instance.Name),
+		Labels:      labels,
+		NetworkTags: types.ListValueMust(types.StringType, networkTags),
+		Status:      types.StringValue(instance.Status),
+		Zone:        types.StringValue(resourceNameFromSelfLink(instance.Zone)),
+		MachineType: types.StringValue(resourceNameFromSelfLink(instance.MachineType)),
+		InternalIPs: types.ListValueMust(types.StringType, internalIPs),
+		ExternalIPs: types.ListValueMust(types.StringType, externalIPs),
+		SelfLink:    types.StringValue(instance.SelfLink),
+	}
+}
@@ -0,0 +1,293 @@
+package gcp
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const defaultComputeOperationTimeoutSecs = 600
+
+var (
+	_ datasource.DataSource              = &ComputeOperationDataSource{}
+	_ datasource.DataSourceWithConfigure = &ComputeOperationDataSource{}
+)
+
+// NewComputeOperationDataSource
+func NewComputeOperationDataSource() datasource.DataSource {
+	return &ComputeOperationDataSource{}
+}
+
+// ComputeOperationDataSource
+type ComputeOperationDataSource struct {
+	client *gcpClients
+}
+
+// ComputeOperationDataSourceModel
+type ComputeOperationDataSourceModel struct {
+	ClientConfig   *clientConfig `tfsdk:"client_config"`
+	Name           types.String  `tfsdk:"name"`
+	Region         types.String  `tfsdk:"region"`
+	Zone           types.String  `tfsdk:"zone"`
+	WaitForDone    types.Bool    `tfsdk:"wait_for_done"`
+	TimeoutSeconds types.Int64   `tfsdk:"timeout_seconds"`
+	AllowMissing   types.Bool    `tfsdk:"allow_missing"`
+	ID             types.Int64   `tfsdk:"id"`
+	SelfLink       types.String  `tfsdk:"self_link"`
+	OperationType  types.String  `tfsdk:"operation_type"`
+	TargetLink     types.String  `tfsdk:"target_link"`
+	User           types.String  `tfsdk:"user"`
+	Status         types.String  `tfsdk:"status"`
+	StatusMessage  types.String  `tfsdk:"status_message"`
+	Progress       types.Int64   `tfsdk:"progress"`
+	Errors         types.List    `tfsdk:"errors"`
+	HttpErrorCode  types.Int64   `tfsdk:"http_error_status_code"`
+	InsertTime     types.String  `tfsdk:"insert_time"`
+	StartTime      types.String  `tfsdk:"start_time"`
+	EndTime        types.String  `tfsdk:"end_time"`
+}
+
+// Metadata returns the data source compute operation type name.
+func (d *ComputeOperationDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_operation"
+}
+
+// Schema defines the schema for the compute operation data source.
+func (d *ComputeOperationDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source looks up a global, regional or zonal compute " +
+			"operation on Google Cloud by name, optionally waiting until it is done, so " +
+			"Terraform can coordinate with the operations started outside Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of operation.",
+				Required:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of the regional operation. Conflicts with zone.",
+				Optional:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of the zonal operation. Conflicts with region. The " +
+					"operation is global if both region and zone are not set.",
+				Optional: true,
+			},
+			"wait_for_done": schema.BoolAttribute{
+				Description: "Whether to wait until the status of operation is DONE. " +
+					"Default to false.",
+				Optional: true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Timeout in seconds of waiting for operation, only used " +
+					"when wait_for_done is true. Default to " +
+					strconv.Itoa(defaultComputeOperationTimeoutSecs) + ".",
+				Optional: true,
+			},
+			"allow_missing": allowMissingAttribute("operation"),
+			"id": schema.Int64Attribute{
+				Description: "ID of operation.",
+				Computed:    true,
+			},
+			"self_link": schema.StringAttribute{
+				Description: "Self link of operation.",
+				Computed:    true,
+			},
+			"operation_type": schema.StringAttribute{
+				Description: "Type of operation, e.g. insert, delete or setMetadata.",
+				Computed:    true,
+			},
+			"target_link": schema.StringAttribute{
+				Description: "Self link of the resource the operation modifies.",
+				Computed:    true,
+			},
+			"user": schema.StringAttribute{
+				Description: "User who requested operation.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of operation, PENDING, RUNNING or DONE.",
+				Computed:    true,
+			},
+			"status_message": schema.StringAttribute{
+				Description: "Textual description of the current status of operation.",
+				Computed:    true,
+			},
+			"progress": schema.Int64Attribute{
+				Description: "Progress of operation from 0 to 100, which is not " +
+					"guaranteed to be linear.",
+				Computed: true,
+			},
+			"errors": schema.ListAttribute{
+				Description: "Errors of the failed operation with the format code: message, " +
+					"empty if operation is not failed.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"http_error_status_code": schema.Int64Attribute{
+				Description: "HTTP status code of the failed operation, e.g. 404, 0 if " +
+					"operation is not failed.",
+				Computed: true,
+			},
+			"insert_time": schema.StringAttribute{
+				Description: "Time of operation is requested.",
+				Computed:    true,
+			},
+			"start_time": schema.StringAttribute{
+				Description: "Time of operation is started.",
+				Computed:    true,
+			},
+			"end_time": schema.StringAttribute{
+				Description: "Time of operation is done.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ComputeOperationDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read compute operation data source information
+func (d *ComputeOperationDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ComputeOperationDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, zone := plan.Region.ValueString(), plan.Zone.ValueString()
+	if region != "" && zone != "" {
+		resp.Diagnostics.AddError(
+			"Conflicting operation scope",
+			"Only one of region and zone can be set.",
+		)
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clients.computeClient
+	name := plan.Name.ValueString()
+	getOperation := func(ctx context.Context) (*googleComputeClient.Operation, error) {
+		switch {
+		case zone != "":
+			return client.ZoneOperations.Get(clients.project, zone, name).Context(ctx).Do()
+		case region != "":
+			return client.RegionOperations.Get(clients.project, region, name).Context(ctx).Do()
+		default:
+			return client.GlobalOperations.Get(clients.project, name).Context(ctx).Do()
+		}
+	}
+	waitOperation := func(ctx context.Context) (*googleComputeClient.Operation, error) {
+		switch {
+		case zone != "":
+			return client.ZoneOperations.Wait(clients.project, zone, name).Context(ctx).Do()
+		case region != "":
+			return client.RegionOperations.Wait(clients.project, region, name).Context(ctx).Do()
+		default:
+			return client.GlobalOperations.Wait(clients.project, name).Context(ctx).Do()
+		}
+	}
+
+	op, err := getOperation(ctx)
+	missing := isMissingAllowed(plan.AllowMissing, err)
+	if missing {
+		op, err = &googleComputeClient.Operation{}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get compute operation.",
+			err.Error(),
+		)
+		return
+	}
+
+	if plan.WaitForDone.ValueBool() && !missing {
+		timeout := time.Duration(defaultComputeOperationTimeoutSecs) * time.Second
+		if !plan.TimeoutSeconds.IsNull() {
+			timeout = time.Duration(plan.TimeoutSeconds.ValueInt64()) * time.Second
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		// Wait returns when the operation is done or about 2 minutes elapsed,
+		// whichever comes first, so it is called until the operation is done.
+		for op.Status != "DONE" {
+			op, err = waitOperation(waitCtx)
+			if err != nil {
+				if waitCtx.Err() == context.DeadlineExceeded {
+					resp.Diagnostics.AddError(
+						"Timed out waiting for compute operation",
+						"Operation "+name+" is not done in "+timeout.String()+".",
+					)
+					return
+				}
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to wait for compute operation.",
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	errorMessages := []attr.Value{}
+	if op.Error != nil {
+		for _, e := range op.Error.Errors {
+			errorMessages = append(errorMessages, types.StringValue(e.Code+": "+e.Message))
+		}
+	}
+
+	state := &ComputeOperationDataSourceModel{
+		Name:           plan.Name,
+		Region:         plan.Region,
+		Zone:           plan.Zone,
+		WaitForDone:    plan.WaitForDone,
+		TimeoutSeconds: plan.TimeoutSeconds,
+		AllowMissing:   plan.AllowMissing,
+		ID:             types.Int64Value(int64(op.Id)),
+		SelfLink:       types.StringValue(op.SelfLink),
+		OperationType:  types.StringValue(op.OperationType),
+		TargetLink:     types.StringValue(op.TargetLink),
+		User:           types.StringValue(op.User),
+		Status:         types.StringValue(op.Status),
+		StatusMessage:  types.StringValue(op.StatusMessage),
+		Progress:       types.Int64Value(op.Progress),
+		Errors:         types.ListValueMust(types.StringType, errorMessages),
+		HttpErrorCode:  types.Int64Value(op.HttpErrorStatusCode),
+		InsertTime:     types.StringValue(op.InsertTime),
+		StartTime:      types.StringValue(op.StartTime),
+		EndTime:        types.StringValue(op.EndTime),
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,289 @@
+package gcp
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &ComputeSslCertificatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ComputeSslCertificatesDataSource{}
+)
+
+// NewComputeSslCertificatesDataSource
+func NewComputeSslCertificatesDataSource() datasource.DataSource {
+	return &ComputeSslCertificatesDataSource{}
+}
+
+// ComputeSslCertificatesDataSource
+type ComputeSslCertificatesDataSource struct {
+	client *gcpClients
+}
+
+// ComputeSslCertificatesDataSourceModel
+type ComputeSslCertificatesDataSourceModel struct {
+	ClientConfig       *clientConfig                      `tfsdk:"client_config"`
+	Name               types.String                       `tfsdk:"name"`
+	Tags               types.Map                          `tfsdk:"tags"`
+	Type               types.String                       `tfsdk:"type"`
+	Region             types.String                       `tfsdk:"region"`
+	ExpiringWithinDays types.Int64                        `tfsdk:"expiring_within_days"`
+	Items              []*computeSslCertificatesItemModel `tfsdk:"items"`
+}
+
+type computeSslCertificatesItemModel struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Tags                    types.Map    `tfsdk:"tags"`
+	Type                    types.String `tfsdk:"type"`
+	Region                  types.String `tfsdk:"region"`
+	ExpireTime              types.String `tfsdk:"expire_time"`
+	SubjectAlternativeNames types.List   `tfsdk:"subject_alternative_names"`
+	ManagedStatus           types.String `tfsdk:"managed_status"`
+	ManagedDomainStatus     types.Map    `tfsdk:"managed_domain_status"`
+	SelfLink                types.String `tfsdk:"self_link"`
+}
+
+// Metadata returns the data source SSL certificates type name.
+func (d *ComputeSslCertificatesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_ssl_certificates"
+}
+
+// Schema defines the schema for the SSL certificates data source.
+func (d *ComputeSslCertificatesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the self-managed and Google-managed " +
+			"compute SSL certificates on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of SSL certificate to be filtered.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of SSL certificate to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of SSL certificate to be filtered, MANAGED or SELF_MANAGED.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of SSL certificates to be filtered, or global for " +
+					"the global SSL certificates. Default to list the SSL certificates " +
+					"in all scopes.",
+				Optional: true,
+			},
+			"expiring_within_days": schema.Int64Attribute{
+				Description: "Only include the SSL certificates expiring within the " +
+					"number of days. Certificates without expire time, e.g. managed " +
+					"certificates still provisioning, are excluded.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried SSL certificates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of SSL certificate.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of SSL certificate.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Tags of SSL certificate.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of SSL certificate, MANAGED or SELF_MANAGED.",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of SSL certificate, empty for global SSL certificate.",
+							Computed:    true,
+						},
+						"expire_time": schema.StringAttribute{
+							Description: "Expire time of SSL certificate in RFC3339 format.",
+							Computed:    true,
+						},
+						"subject_alternative_names": schema.ListAttribute{
+							Description: "Subject alternative names of SSL certificate.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"managed_status": schema.StringAttribute{
+							Description: "Status of Google-managed SSL certificate, e.g. " +
+								"PROVISIONING or ACTIVE. Empty for self-managed SSL certificate.",
+							Computed: true,
+						},
+						"managed_domain_status": schema.MapAttribute{
+							Description: "Status of each domain of Google-managed SSL certificate.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of SSL certificate.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ComputeSslCertificatesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read SSL certificates data source information
+func (d *ComputeSslCertificatesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ComputeSslCertificatesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &ComputeSslCertificatesDataSourceModel{
+		Name:               plan.Name,
+		Tags:               plan.Tags,
+		Type:               plan.Type,
+		Region:             plan.Region,
+		ExpiringWithinDays: plan.ExpiringWithinDays,
+		Items:              []*computeSslCertificatesItemModel{},
+	}
+
+	var expiringBefore time.Time
+	if !(plan.ExpiringWithinDays.IsUnknown() || plan.ExpiringWithinDays.IsNull()) {
+		expiringBefore = time.Now().AddDate(0, 0, int(plan.ExpiringWithinDays.ValueInt64()))
+	}
+
+	appendItems := func(certificates []*googleComputeClient.SslCertificate) {
+		for _, certificate := range certificates {
+			if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != certificate.Name {
+				continue
+			}
+			if !(plan.Type.IsUnknown() || plan.Type.IsNull()) && plan.Type.ValueString() != certificate.Type {
+				continue
+			}
+			if !expiringBefore.IsZero() {
+				expireTime, err := time.Parse(time.RFC3339, certificate.ExpireTime)
+				if err != nil || expireTime.After(expiringBefore) {
+					continue
+				}
+			}
+
+			tags, tagsTfType, diags := descriptionTags(certificate.Description)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if !matchTags(plan.Tags, tags) {
+				continue
+			}
+			state.Items = append(state.Items, newComputeSslCertificatesItem(certificate, tagsTfType))
+		}
+	}
+
+	var err error
+	switch region := plan.Region.ValueString(); region {
+	case "":
+		err = clients.computeClient.SslCertificates.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.SslCertificateAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendItems(page.Items[scope].SslCertificates)
+				}
+				return nil
+			})
+	case globalScope:
+		err = clients.computeClient.SslCertificates.List(clients.project).Pages(ctx,
+			func(page *googleComputeClient.SslCertificateList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	default:
+		err = clients.computeClient.RegionSslCertificates.List(clients.project, region).Pages(ctx,
+			func(page *googleComputeClient.SslCertificateList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list SSL certificates.",
+			err.Error(),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newComputeSslCertificatesItem converts the SSL certificate into queried
+// item.
+func newComputeSslCertificatesItem(certificate *googleComputeClient.SslCertificate,
+	tags types.Map) *computeSslCertificatesItemModel {
+	sans := []attr.Value{}
+	for _, san := range certificate.SubjectAlternativeNames {
+		sans = append(sans, types.StringValue(san))
+	}
+
+	managedStatus := ""
+	domainStatus := map[string]attr.Value{}
+	if certificate.Managed != nil {
+		managedStatus = certificate.Managed.Status
+		for domain, status := range certificate.Managed.DomainStatus {
+			domainStatus[domain] = types.StringValue(status)
+		}
+	}
+
+	return &computeSslCertificatesItemModel{
+		ID:                      types.Int64Value(int64(certificate.Id)),
+		Name:                    types.StringValue(certificate.Name),
+		Tags:                    tags,
+		Type:                    types.StringValue(certificate.Type),
+		Region:                  types.StringValue(resourceNameFromSelfLink(certificate.Region)),
+		ExpireTime:              types.StringValue(certificate.ExpireTime),
+		SubjectAlternativeNames: types.ListValueMust(types.StringType, sans),
+		ManagedStatus:           types.StringValue(managedStatus),
+		ManagedDomainStatus:     types.MapValueMust(types.StringType, domainStatus),
+		SelfLink:                types.StringValue(certificate.SelfLink),
+	}
+}
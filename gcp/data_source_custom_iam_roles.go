@@ -0,0 +1,232 @@
+package gcp
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleIamClient "google.golang.org/api/iam/v1"
+)
+
+var (
+	_ datasource.DataSource              = &CustomIamRolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &CustomIamRolesDataSource{}
+)
+
+// NewCustomIamRolesDataSource
+func NewCustomIamRolesDataSource() datasource.DataSource {
+	return &CustomIamRolesDataSource{}
+}
+
+// CustomIamRolesDataSource
+type CustomIamRolesDataSource struct {
+	client *gcpClients
+}
+
+// CustomIamRolesDataSourceModel
+type CustomIamRolesDataSourceModel struct {
+	ClientConfig   *clientConfig              `tfsdk:"client_config"`
+	OrganizationID types.String               `tfsdk:"organization_id"`
+	TitlePattern   types.String               `tfsdk:"title_pattern"`
+	Stage          types.String               `tfsdk:"stage"`
+	ShowDeleted    types.Bool                 `tfsdk:"show_deleted"`
+	Items          []*customIamRolesItemModel `tfsdk:"items"`
+}
+
+type customIamRolesItemModel struct {
+	RoleID              types.String `tfsdk:"role_id"`
+	Name                types.String `tfsdk:"name"`
+	Title               types.String `tfsdk:"title"`
+	Description         types.String `tfsdk:"description"`
+	Stage               types.String `tfsdk:"stage"`
+	Deleted             types.Bool   `tfsdk:"deleted"`
+	IncludedPermissions types.List   `tfsdk:"included_permissions"`
+	Etag                types.String `tfsdk:"etag"`
+}
+
+// Metadata returns the data source custom IAM roles type name.
+func (d *CustomIamRolesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_iam_roles"
+}
+
+// Schema defines the schema for the custom IAM roles data source.
+func (d *CustomIamRolesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the custom IAM roles of the project or an " +
+			"organization on Google Cloud with their included permissions.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Description: "ID of organization to list the custom roles of the organization. " +
+					"Default to list the custom roles of the project.",
+				Optional: true,
+			},
+			"title_pattern": schema.StringAttribute{
+				Description: "Regular expression of the title of role to be filtered, e.g. ^Deployer.",
+				Optional:    true,
+			},
+			"stage": schema.StringAttribute{
+				Description: "Launch stage of role to be filtered, e.g. GA, BETA or DISABLED.",
+				Optional:    true,
+			},
+			"show_deleted": schema.BoolAttribute{
+				Description: "Whether to include the deleted roles. Default to false.",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried custom roles.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_id": schema.StringAttribute{
+							Description: "ID of role, e.g. deployer.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Resource name of role used in the role bindings, " +
+								"e.g. projects/my-project/roles/deployer.",
+							Computed: true,
+						},
+						"title": schema.StringAttribute{
+							Description: "Title of role.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of role.",
+							Computed:    true,
+						},
+						"stage": schema.StringAttribute{
+							Description: "Launch stage of role, e.g. GA, BETA or DISABLED.",
+							Computed:    true,
+						},
+						"deleted": schema.BoolAttribute{
+							Description: "Whether role is deleted.",
+							Computed:    true,
+						},
+						"included_permissions": schema.ListAttribute{
+							Description: "Permissions included in role.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"etag": schema.StringAttribute{
+							Description: "Etag of role.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CustomIamRolesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read custom IAM roles data source information
+func (d *CustomIamRolesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *CustomIamRolesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var titlePattern *regexp.Regexp
+	if !(plan.TitlePattern.IsUnknown() || plan.TitlePattern.IsNull()) {
+		var err error
+		titlePattern, err = regexp.Compile(plan.TitlePattern.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid title_pattern", err.Error())
+			return
+		}
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iamClient, err := googleIamClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google IAM client", err.Error())
+		return
+	}
+
+	state := &CustomIamRolesDataSourceModel{
+		OrganizationID: plan.OrganizationID,
+		TitlePattern:   plan.TitlePattern,
+		Stage:          plan.Stage,
+		ShowDeleted:    plan.ShowDeleted,
+		Items:          []*customIamRolesItemModel{},
+	}
+
+	appendRoles := func(page *googleIamClient.ListRolesResponse) error {
+		for _, role := range page.Roles {
+			if titlePattern != nil && !titlePattern.MatchString(role.Title) {
+				continue
+			}
+			if !(plan.Stage.IsUnknown() || plan.Stage.IsNull()) && plan.Stage.ValueString() != role.Stage {
+				continue
+			}
+			state.Items = append(state.Items, newCustomIamRolesItem(role))
+		}
+		return nil
+	}
+	// The included permissions are only returned in the FULL view.
+	if organizationID := plan.OrganizationID.ValueString(); organizationID != "" {
+		err = iamClient.Organizations.Roles.List("organizations/"+organizationID).View("FULL").
+			ShowDeleted(plan.ShowDeleted.ValueBool()).Pages(ctx, appendRoles)
+	} else {
+		err = iamClient.Projects.Roles.List("projects/"+clients.project).View("FULL").
+			ShowDeleted(plan.ShowDeleted.ValueBool()).Pages(ctx, appendRoles)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list custom roles.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newCustomIamRolesItem converts the custom role into queried item.
+func newCustomIamRolesItem(role *googleIamClient.Role) *customIamRolesItemModel {
+	permissions := []attr.Value{}
+	for _, permission := range role.IncludedPermissions {
+		permissions = append(permissions, types.StringValue(permission))
+	}
+
+	return &customIamRolesItemModel{
+		RoleID:              types.StringValue(resourceNameFromSelfLink(role.Name)),
+		Name:                types.StringValue(role.Name),
+		Title:               types.StringValue(role.Title),
+		Description:         types.StringValue(role.Description),
+		Stage:               types.StringValue(role.Stage),
+		Deleted:             types.BoolValue(role.Deleted),
+		IncludedPermissions: types.ListValueMust(types.StringType, permissions),
+		Etag:                types.StringValue(role.Etag),
+	}
+}
@@ -0,0 +1,304 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &DiskSnapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DiskSnapshotsDataSource{}
+)
+
+// NewDiskSnapshotsDataSource
+func NewDiskSnapshotsDataSource() datasource.DataSource {
+	return &DiskSnapshotsDataSource{}
+}
+
+// DiskSnapshotsDataSource
+type DiskSnapshotsDataSource struct {
+	client *gcpClients
+}
+
+// DiskSnapshotsDataSourceModel
+type DiskSnapshotsDataSourceModel struct {
+	ClientConfig  *clientConfig             `tfsdk:"client_config"`
+	SourceDisk    types.String              `tfsdk:"source_disk"`
+	Labels        types.Map                 `tfsdk:"labels"`
+	OlderThanDays types.Int64               `tfsdk:"older_than_days"`
+	NewerThanDays types.Int64               `tfsdk:"newer_than_days"`
+	NewestPerDisk types.Bool                `tfsdk:"newest_per_disk"`
+	Items         []*diskSnapshotsItemModel `tfsdk:"items"`
+}
+
+type diskSnapshotsItemModel struct {
+	ID                           types.Int64  `tfsdk:"id"`
+	Name                         types.String `tfsdk:"name"`
+	SelfLink                     types.String `tfsdk:"self_link"`
+	Labels                       types.Map    `tfsdk:"labels"`
+	Status                       types.String `tfsdk:"status"`
+	SnapshotType                 types.String `tfsdk:"snapshot_type"`
+	SourceDisk                   types.String `tfsdk:"source_disk"`
+	SourceDiskName               types.String `tfsdk:"source_disk_name"`
+	SourceSnapshotSchedulePolicy types.String `tfsdk:"source_snapshot_schedule_policy"`
+	AutoCreated                  types.Bool   `tfsdk:"auto_created"`
+	DiskSizeGb                   types.Int64  `tfsdk:"disk_size_gb"`
+	StorageBytes                 types.Int64  `tfsdk:"storage_bytes"`
+	StorageLocations             types.List   `tfsdk:"storage_locations"`
+	CreationTimestamp            types.String `tfsdk:"creation_timestamp"`
+}
+
+// Metadata returns the data source disk snapshots type name.
+func (d *DiskSnapshotsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk_snapshots"
+}
+
+// Schema defines the schema for the disk snapshots data source.
+func (d *DiskSnapshotsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the disk snapshots on Google Cloud, e.g. " +
+			"the newest snapshot of a disk to be restored, or the snapshots older than " +
+			"the retention to be audited.",
+		Attributes: map[string]schema.Attribute{
+			"source_disk": schema.StringAttribute{
+				Description: "Source disk of snapshot to be filtered, either the name of " +
+					"disk or its self link, e.g. zones/asia-east1-a/disks/my-disk.",
+				Optional: true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of snapshot to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"older_than_days": schema.Int64Attribute{
+				Description: "Only the snapshots created more than the days ago are queried.",
+				Optional:    true,
+			},
+			"newer_than_days": schema.Int64Attribute{
+				Description: "Only the snapshots created less than the days ago are queried.",
+				Optional:    true,
+			},
+			"newest_per_disk": schema.BoolAttribute{
+				Description: "Whether to query only the newest snapshot of every source " +
+					"disk after the other filters. Default to false.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried snapshots, sorted from the newest.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of snapshot.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of snapshot.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of snapshot.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of snapshot.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of snapshot, e.g. READY, CREATING or UPLOADING.",
+							Computed:    true,
+						},
+						"snapshot_type": schema.StringAttribute{
+							Description: "Type of snapshot, STANDARD or ARCHIVE.",
+							Computed:    true,
+						},
+						"source_disk": schema.StringAttribute{
+							Description: "Self link of source disk.",
+							Computed:    true,
+						},
+						"source_disk_name": schema.StringAttribute{
+							Description: "Name of source disk.",
+							Computed:    true,
+						},
+						"source_snapshot_schedule_policy": schema.StringAttribute{
+							Description: "Self link of the snapshot schedule policy creating " +
+								"snapshot, empty if snapshot is created manually.",
+							Computed: true,
+						},
+						"auto_created": schema.BoolAttribute{
+							Description: "Whether snapshot is created by a snapshot schedule policy.",
+							Computed:    true,
+						},
+						"disk_size_gb": schema.Int64Attribute{
+							Description: "Size of source disk in GB.",
+							Computed:    true,
+						},
+						"storage_bytes": schema.Int64Attribute{
+							Description: "Size of the storage used by snapshot in bytes.",
+							Computed:    true,
+						},
+						"storage_locations": schema.ListAttribute{
+							Description: "Storage locations of snapshot, e.g. asia or asia-east1.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"creation_timestamp": schema.StringAttribute{
+							Description: "Creation time of snapshot.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DiskSnapshotsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read disk snapshots data source information
+func (d *DiskSnapshotsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *DiskSnapshotsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	type snapshotWithTime struct {
+		snapshot     *googleComputeClient.Snapshot
+		creationTime time.Time
+	}
+	snapshots := []snapshotWithTime{}
+	err := clients.computeClient.Snapshots.List(clients.project).Pages(ctx,
+		func(page *googleComputeClient.SnapshotList) error {
+			for _, snapshot := range page.Items {
+				if !(plan.SourceDisk.IsUnknown() || plan.SourceDisk.IsNull()) &&
+					!matchSourceDisk(plan.SourceDisk.ValueString(), snapshot.SourceDisk) {
+					continue
+				}
+				labels, _ := labelsValue(snapshot.Labels)
+				if !matchTags(plan.Labels, labels) {
+					continue
+				}
+				creationTime, err := time.Parse(time.RFC3339, snapshot.CreationTimestamp)
+				if err != nil {
+					return fmt.Errorf("invalid creation timestamp of snapshot %s: %v", snapshot.Name, err)
+				}
+				age := now.Sub(creationTime)
+				if !(plan.OlderThanDays.IsUnknown() || plan.OlderThanDays.IsNull()) &&
+					age <= time.Duration(plan.OlderThanDays.ValueInt64())*24*time.Hour {
+					continue
+				}
+				if !(plan.NewerThanDays.IsUnknown() || plan.NewerThanDays.IsNull()) &&
+					age >= time.Duration(plan.NewerThanDays.ValueInt64())*24*time.Hour {
+					continue
+				}
+				snapshots = append(snapshots, snapshotWithTime{snapshot, creationTime})
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list snapshots.",
+			err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].creationTime.After(snapshots[j].creationTime)
+	})
+
+	state := &DiskSnapshotsDataSourceModel{
+		SourceDisk:    plan.SourceDisk,
+		Labels:        plan.Labels,
+		OlderThanDays: plan.OlderThanDays,
+		NewerThanDays: plan.NewerThanDays,
+		NewestPerDisk: plan.NewestPerDisk,
+		Items:         []*diskSnapshotsItemModel{},
+	}
+
+	seenDisks := map[string]bool{}
+	for _, s := range snapshots {
+		if plan.NewestPerDisk.ValueBool() {
+			if seenDisks[s.snapshot.SourceDisk] {
+				continue
+			}
+			seenDisks[s.snapshot.SourceDisk] = true
+		}
+		state.Items = append(state.Items, newDiskSnapshotsItem(s.snapshot))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// matchSourceDisk returns whether the source disk self link of snapshot is
+// the disk, which is either the name or a (partial) self link of disk.
+func matchSourceDisk(disk, sourceDisk string) bool {
+	if !strings.Contains(disk, "/") {
+		return disk == resourceNameFromSelfLink(sourceDisk)
+	}
+	return strings.HasSuffix(sourceDisk, "/"+strings.TrimPrefix(disk, "/")) || sourceDisk == disk
+}
+
+// newDiskSnapshotsItem converts the snapshot into queried item.
+func newDiskSnapshotsItem(snapshot *googleComputeClient.Snapshot) *diskSnapshotsItemModel {
+	_, labels := labelsValue(snapshot.Labels)
+	storageLocations := []attr.Value{}
+	for _, location := range snapshot.StorageLocations {
+		storageLocations = append(storageLocations, types.StringValue(location))
+	}
+
+	return &diskSnapshotsItemModel{
+		ID:                           types.Int64Value(int64(snapshot.Id)),
+		Name:                         types.StringValue(snapshot.Name),
+		SelfLink:                     types.StringValue(snapshot.SelfLink),
+		Labels:                       labels,
+		Status:                       types.StringValue(snapshot.Status),
+		SnapshotType:                 types.StringValue(snapshot.SnapshotType),
+		SourceDisk:                   types.StringValue(snapshot.SourceDisk),
+		SourceDiskName:               types.StringValue(resourceNameFromSelfLink(snapshot.SourceDisk)),
+		SourceSnapshotSchedulePolicy: types.StringValue(snapshot.SourceSnapshotSchedulePolicy),
+		AutoCreated:                  types.BoolValue(snapshot.AutoCreated),
+		DiskSizeGb:                   types.Int64Value(snapshot.DiskSizeGb),
+		StorageBytes:                 types.Int64Value(snapshot.StorageBytes),
+		StorageLocations:             types.ListValueMust(types.StringType, storageLocations),
+		CreationTimestamp:            types.StringValue(snapshot.CreationTimestamp),
+	}
+}
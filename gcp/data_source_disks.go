@@ -0,0 +1,317 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const (
+	diskEncryptionGoogleManaged    = "GOOGLE_MANAGED"
+	diskEncryptionCustomerManaged  = "CUSTOMER_MANAGED"
+	diskEncryptionCustomerSupplied = "CUSTOMER_SUPPLIED"
+)
+
+var (
+	_ datasource.DataSource              = &DisksDataSource{}
+	_ datasource.DataSourceWithConfigure = &DisksDataSource{}
+)
+
+// NewDisksDataSource
+func NewDisksDataSource() datasource.DataSource {
+	return &DisksDataSource{}
+}
+
+// DisksDataSource
+type DisksDataSource struct {
+	client *gcpClients
+}
+
+// DisksDataSourceModel
+type DisksDataSourceModel struct {
+	ClientConfig *clientConfig     `tfsdk:"client_config"`
+	Labels       types.Map         `tfsdk:"labels"`
+	Type         types.String      `tfsdk:"type"`
+	Attached     types.Bool        `tfsdk:"attached"`
+	Zone         types.String      `tfsdk:"zone"`
+	Region       types.String      `tfsdk:"region"`
+	Items        []*disksItemModel `tfsdk:"items"`
+}
+
+type disksItemModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	SelfLink            types.String `tfsdk:"self_link"`
+	Labels              types.Map    `tfsdk:"labels"`
+	Type                types.String `tfsdk:"type"`
+	Status              types.String `tfsdk:"status"`
+	Location            types.String `tfsdk:"location"`
+	SizeGb              types.Int64  `tfsdk:"size_gb"`
+	SourceImage         types.String `tfsdk:"source_image"`
+	SourceSnapshot      types.String `tfsdk:"source_snapshot"`
+	Users               types.List   `tfsdk:"users"`
+	EncryptionType      types.String `tfsdk:"encryption_type"`
+	KmsKeyName          types.String `tfsdk:"kms_key_name"`
+	LastAttachTimestamp types.String `tfsdk:"last_attach_timestamp"`
+	LastDetachTimestamp types.String `tfsdk:"last_detach_timestamp"`
+	CreationTimestamp   types.String `tfsdk:"creation_timestamp"`
+}
+
+// Metadata returns the data source disks type name.
+func (d *DisksDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disks"
+}
+
+// Schema defines the schema for the disks data source.
+func (d *DisksDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the zonal and regional persistent disks " +
+			"on Google Cloud, e.g. the disks not attached to any instance to be cleaned " +
+			"up, or the disks not encrypted by customer managed keys to be audited.",
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.MapAttribute{
+				Description: "Labels of disk to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of disk to be filtered, e.g. pd-ssd or pd-balanced.",
+				Optional:    true,
+			},
+			"attached": schema.BoolAttribute{
+				Description: "Whether disk is attached to any instance to be filtered.",
+				Optional:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of the zonal disks to be queried.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of the disks to be queried, including the zonal " +
+					"disks in the zones of region.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried disks.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of disk.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of disk.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of disk.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of disk.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of disk, e.g. pd-ssd.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of disk, e.g. READY or FAILED.",
+							Computed:    true,
+						},
+						"location": schema.StringAttribute{
+							Description: "Zone of the zonal disk or region of the regional disk.",
+							Computed:    true,
+						},
+						"size_gb": schema.Int64Attribute{
+							Description: "Size of disk in GB.",
+							Computed:    true,
+						},
+						"source_image": schema.StringAttribute{
+							Description: "Self link of the image disk is created from, empty " +
+								"if disk is not created from an image.",
+							Computed: true,
+						},
+						"source_snapshot": schema.StringAttribute{
+							Description: "Self link of the snapshot disk is created from, " +
+								"empty if disk is not created from a snapshot.",
+							Computed: true,
+						},
+						"users": schema.ListAttribute{
+							Description: "Self links of the instances disk is attached to.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"encryption_type": schema.StringAttribute{
+							Description: "Encryption type of disk, " + diskEncryptionGoogleManaged +
+								", " + diskEncryptionCustomerManaged + " or " +
+								diskEncryptionCustomerSupplied + ".",
+							Computed: true,
+						},
+						"kms_key_name": schema.StringAttribute{
+							Description: "Name of the Cloud KMS key encrypting disk, empty if " +
+								"disk is not encrypted by a customer managed key.",
+							Computed: true,
+						},
+						"last_attach_timestamp": schema.StringAttribute{
+							Description: "Last attach time of disk.",
+							Computed:    true,
+						},
+						"last_detach_timestamp": schema.StringAttribute{
+							Description: "Last detach time of disk.",
+							Computed:    true,
+						},
+						"creation_timestamp": schema.StringAttribute{
+							Description: "Creation time of disk.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DisksDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read disks data source information
+func (d *DisksDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *DisksDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &DisksDataSourceModel{
+		Labels:   plan.Labels,
+		Type:     plan.Type,
+		Attached: plan.Attached,
+		Zone:     plan.Zone,
+		Region:   plan.Region,
+		Items:    []*disksItemModel{},
+	}
+
+	appendItems := func(disks []*googleComputeClient.Disk) {
+		for _, disk := range disks {
+			if !(plan.Type.IsUnknown() || plan.Type.IsNull()) &&
+				plan.Type.ValueString() != resourceNameFromSelfLink(disk.Type) {
+				continue
+			}
+			if !(plan.Attached.IsUnknown() || plan.Attached.IsNull()) &&
+				plan.Attached.ValueBool() != (len(disk.Users) > 0) {
+				continue
+			}
+			labels, labelsTfType := labelsValue(disk.Labels)
+			if !matchTags(plan.Labels, labels) {
+				continue
+			}
+			state.Items = append(state.Items, newDisksItem(disk, labelsTfType))
+		}
+	}
+
+	var err error
+	if zone := plan.Zone.ValueString(); zone != "" {
+		err = clients.computeClient.Disks.List(clients.project, zone).Pages(ctx,
+			func(page *googleComputeClient.DiskList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	} else {
+		// Zones are named after their region, e.g. asia-east1-a.
+		region := plan.Region.ValueString()
+		err = clients.computeClient.Disks.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.DiskAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					if region == "" || scope == "regions/"+region ||
+						strings.HasPrefix(scope, "zones/"+region+"-") {
+						appendItems(page.Items[scope].Disks)
+					}
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list disks.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newDisksItem converts the disk into queried item.
+func newDisksItem(disk *googleComputeClient.Disk, labels types.Map) *disksItemModel {
+	users := []attr.Value{}
+	for _, user := range disk.Users {
+		users = append(users, types.StringValue(user))
+	}
+
+	location := resourceNameFromSelfLink(disk.Zone)
+	if disk.Zone == "" {
+		location = resourceNameFromSelfLink(disk.Region)
+	}
+
+	encryptionType, kmsKeyName := diskEncryptionGoogleManaged, ""
+	if key := disk.DiskEncryptionKey; key != nil {
+		switch {
+		case key.KmsKeyName != "":
+			encryptionType, kmsKeyName = diskEncryptionCustomerManaged, key.KmsKeyName
+		case key.Sha256 != "":
+			encryptionType = diskEncryptionCustomerSupplied
+		}
+	}
+
+	return &disksItemModel{
+		ID:                  types.Int64Value(int64(disk.Id)),
+		Name:                types.StringValue(disk.Name),
+		SelfLink:            types.StringValue(disk.SelfLink),
+		Labels:              labels,
+		Type:                types.StringValue(resourceNameFromSelfLink(disk.Type)),
+		Status:              types.StringValue(disk.Status),
+		Location:            types.StringValue(location),
+		SizeGb:              types.Int64Value(disk.SizeGb),
+		SourceImage:         types.StringValue(disk.SourceImage),
+		SourceSnapshot:      types.StringValue(disk.SourceSnapshot),
+		Users:               types.ListValueMust(types.StringType, users),
+		EncryptionType:      types.StringValue(encryptionType),
+		KmsKeyName:          types.StringValue(kmsKeyName),
+		LastAttachTimestamp: types.StringValue(disk.LastAttachTimestamp),
+		LastDetachTimestamp: types.StringValue(disk.LastDetachTimestamp),
+		CreationTimestamp:   types.StringValue(disk.CreationTimestamp),
+	}
+}
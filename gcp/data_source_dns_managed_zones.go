@@ -0,0 +1,258 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleDnsClient "google.golang.org/api/dns/v1"
+)
+
+var (
+	_ datasource.DataSource              = &DNSManagedZonesDataSource{}
+	_ datasource.DataSourceWithConfigure = &DNSManagedZonesDataSource{}
+)
+
+// NewDNSManagedZonesDataSource
+func NewDNSManagedZonesDataSource() datasource.DataSource {
+	return &DNSManagedZonesDataSource{}
+}
+
+// DNSManagedZonesDataSource
+type DNSManagedZonesDataSource struct {
+	client *gcpClients
+}
+
+// DNSManagedZonesDataSourceModel
+type DNSManagedZonesDataSourceModel struct {
+	ClientConfig  *clientConfig               `tfsdk:"client_config"`
+	Name          types.String                `tfsdk:"name"`
+	DNSNameSuffix types.String                `tfsdk:"dns_name_suffix"`
+	Visibility    types.String                `tfsdk:"visibility"`
+	Labels        types.Map                   `tfsdk:"labels"`
+	Items         []*dnsManagedZonesItemModel `tfsdk:"items"`
+}
+
+type dnsManagedZonesItemModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	DNSName         types.String `tfsdk:"dns_name"`
+	Labels          types.Map    `tfsdk:"labels"`
+	Description     types.String `tfsdk:"description"`
+	Visibility      types.String `tfsdk:"visibility"`
+	NameServers     types.List   `tfsdk:"name_servers"`
+	DnssecState     types.String `tfsdk:"dnssec_state"`
+	PrivateNetworks types.List   `tfsdk:"private_networks"`
+	CreationTime    types.String `tfsdk:"creation_time"`
+}
+
+// Metadata returns the data source DNS managed zones type name.
+func (d *DNSManagedZonesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_managed_zones"
+}
+
+// Schema defines the schema for the DNS managed zones data source.
+func (d *DNSManagedZonesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Cloud DNS managed zones on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of managed zone to be filtered.",
+				Optional:    true,
+			},
+			"dns_name_suffix": schema.StringAttribute{
+				Description: "DNS name suffix of managed zone to be filtered, e.g. example.com " +
+					"matches the managed zones of example.com and its subdomains.",
+				Optional: true,
+			},
+			"visibility": schema.StringAttribute{
+				Description: "Visibility of managed zone to be filtered, valid values are public " +
+					"and private.",
+				Optional: true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of managed zone to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried managed zones.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of managed zone.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of managed zone.",
+							Computed:    true,
+						},
+						"dns_name": schema.StringAttribute{
+							Description: "DNS name of managed zone, e.g. example.com.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of managed zone.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of managed zone.",
+							Computed:    true,
+						},
+						"visibility": schema.StringAttribute{
+							Description: "Visibility of managed zone, public or private.",
+							Computed:    true,
+						},
+						"name_servers": schema.ListAttribute{
+							Description: "Name servers delegated to managed zone.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"dnssec_state": schema.StringAttribute{
+							Description: "DNSSEC state of managed zone, on, off or transfer, " +
+								"empty if DNSSEC is not configured.",
+							Computed: true,
+						},
+						"private_networks": schema.ListAttribute{
+							Description: "Networks the private managed zone is visible to.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"creation_time": schema.StringAttribute{
+							Description: "Creation time of managed zone.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DNSManagedZonesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read DNS managed zones data source information
+func (d *DNSManagedZonesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *DNSManagedZonesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dnsClient, err := googleDnsClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud DNS client", err.Error())
+		return
+	}
+
+	state := &DNSManagedZonesDataSourceModel{
+		Name:          plan.Name,
+		DNSNameSuffix: plan.DNSNameSuffix,
+		Visibility:    plan.Visibility,
+		Labels:        plan.Labels,
+		Items:         []*dnsManagedZonesItemModel{},
+	}
+
+	err = dnsClient.ManagedZones.List(clients.project).Pages(ctx,
+		func(page *googleDnsClient.ManagedZonesListResponse) error {
+			for _, managedZone := range page.ManagedZones {
+				if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != managedZone.Name {
+					continue
+				}
+				if !(plan.DNSNameSuffix.IsUnknown() || plan.DNSNameSuffix.IsNull()) &&
+					!matchDNSNameSuffix(managedZone.DnsName, plan.DNSNameSuffix.ValueString()) {
+					continue
+				}
+				if !(plan.Visibility.IsUnknown() || plan.Visibility.IsNull()) &&
+					!strings.EqualFold(plan.Visibility.ValueString(), managedZone.Visibility) {
+					continue
+				}
+				labels, labelsTfType := labelsValue(managedZone.Labels)
+				if !matchTags(plan.Labels, labels) {
+					continue
+				}
+				state.Items = append(state.Items, newDNSManagedZonesItem(managedZone, labelsTfType))
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list managed zones.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// matchDNSNameSuffix returns true if the DNS name is the suffix or a
+// subdomain of the suffix, the trailing dots are ignored.
+func matchDNSNameSuffix(dnsName, suffix string) bool {
+	dnsName = strings.ToLower(strings.TrimSuffix(dnsName, "."))
+	suffix = strings.ToLower(strings.Trim(suffix, "."))
+	return dnsName == suffix || strings.HasSuffix(dnsName, "."+suffix)
+}
+
+// newDNSManagedZonesItem converts the managed zone into queried item.
+func newDNSManagedZonesItem(managedZone *googleDnsClient.ManagedZone,
+	labels types.Map) *dnsManagedZonesItemModel {
+	nameServers := []attr.Value{}
+	for _, nameServer := range managedZone.NameServers {
+		nameServers = append(nameServers, types.StringValue(nameServer))
+	}
+	privateNetworks := []attr.Value{}
+	if managedZone.PrivateVisibilityConfig != nil {
+		for _, network := range managedZone.PrivateVisibilityConfig.Networks {
+			privateNetworks = append(privateNetworks, types.StringValue(network.NetworkUrl))
+		}
+	}
+	dnssecState := ""
+	if managedZone.DnssecConfig != nil {
+		dnssecState = managedZone.DnssecConfig.State
+	}
+
+	return &dnsManagedZonesItemModel{
+		ID:              types.Int64Value(int64(managedZone.Id)),
+		Name:            types.StringValue(managedZone.Name),
+		DNSName:         types.StringValue(managedZone.DnsName),
+		Labels:          labels,
+		Description:     types.StringValue(managedZone.Description),
+		Visibility:      types.StringValue(managedZone.Visibility),
+		NameServers:     types.ListValueMust(types.StringType, nameServers),
+		DnssecState:     types.StringValue(dnssecState),
+		PrivateNetworks: types.ListValueMust(types.StringType, privateNetworks),
+		CreationTime:    types.StringValue(managedZone.CreationTime),
+	}
+}
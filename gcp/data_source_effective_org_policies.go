@@ -0,0 +1,227 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleOrgPolicyClient "google.golang.org/api/orgpolicy/v2"
+)
+
+var (
+	_ datasource.DataSource              = &EffectiveOrgPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &EffectiveOrgPoliciesDataSource{}
+)
+
+// NewEffectiveOrgPoliciesDataSource
+func NewEffectiveOrgPoliciesDataSource() datasource.DataSource {
+	return &EffectiveOrgPoliciesDataSource{}
+}
+
+// EffectiveOrgPoliciesDataSource
+type EffectiveOrgPoliciesDataSource struct {
+	client *gcpClients
+}
+
+// EffectiveOrgPoliciesDataSourceModel
+type EffectiveOrgPoliciesDataSourceModel struct {
+	ClientConfig *clientConfig                    `tfsdk:"client_config"`
+	Constraints  types.List                       `tfsdk:"constraints"`
+	Items        []*effectiveOrgPoliciesItemModel `tfsdk:"items"`
+}
+
+type effectiveOrgPoliciesItemModel struct {
+	Constraint          types.String `tfsdk:"constraint"`
+	Enforced            types.Bool   `tfsdk:"enforced"`
+	AllowAll            types.Bool   `tfsdk:"allow_all"`
+	DenyAll             types.Bool   `tfsdk:"deny_all"`
+	AllowedValues       types.List   `tfsdk:"allowed_values"`
+	DeniedValues        types.List   `tfsdk:"denied_values"`
+	HasConditionalRules types.Bool   `tfsdk:"has_conditional_rules"`
+	UpdateTime          types.String `tfsdk:"update_time"`
+}
+
+// Metadata returns the data source effective org policies type name.
+func (d *EffectiveOrgPoliciesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_org_policies"
+}
+
+// Schema defines the schema for the effective org policies data source.
+func (d *EffectiveOrgPoliciesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the effective organization policies of " +
+			"the project on Google Cloud, which are merged from the policies of the " +
+			"organization, the folders and the project, so modules can adapt or fail " +
+			"early with guidance instead of hitting the precondition errors at apply.",
+		Attributes: map[string]schema.Attribute{
+			"constraints": schema.ListAttribute{
+				Description: "Constraints of the effective policies to be queried, e.g. " +
+					"compute.vmExternalIpAccess or constraints/gcp.resourceLocations.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of the effective policies in the order of constraints. " +
+					"The values are merged from the rules without condition, the rules " +
+					"with condition are only indicated by has_conditional_rules.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"constraint": schema.StringAttribute{
+							Description: "Constraint of policy, e.g. constraints/compute.vmExternalIpAccess.",
+							Computed:    true,
+						},
+						"enforced": schema.BoolAttribute{
+							Description: "Whether the boolean constraint is enforced, e.g. " +
+								"constraints/iam.disableServiceAccountKeyCreation.",
+							Computed: true,
+						},
+						"allow_all": schema.BoolAttribute{
+							Description: "Whether all values are allowed by the list constraint.",
+							Computed:    true,
+						},
+						"deny_all": schema.BoolAttribute{
+							Description: "Whether all values are denied by the list constraint.",
+							Computed:    true,
+						},
+						"allowed_values": schema.ListAttribute{
+							Description: "Values allowed by the list constraint, e.g. " +
+								"in:asia-locations for constraints/gcp.resourceLocations.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"denied_values": schema.ListAttribute{
+							Description: "Values denied by the list constraint.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"has_conditional_rules": schema.BoolAttribute{
+							Description: "Whether policy has the rules applied by the " +
+								"conditions of the tags of resource.",
+							Computed: true,
+						},
+						"update_time": schema.StringAttribute{
+							Description: "Last update time of policy, empty if the " +
+								"default policy of constraint is effective.",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *EffectiveOrgPoliciesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read effective org policies data source information
+func (d *EffectiveOrgPoliciesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *EffectiveOrgPoliciesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var constraints []string
+	resp.Diagnostics.Append(plan.Constraints.ElementsAs(ctx, &constraints, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgPolicyClient, err := googleOrgPolicyClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Org Policy client", err.Error())
+		return
+	}
+
+	state := &EffectiveOrgPoliciesDataSourceModel{
+		Constraints: plan.Constraints,
+		Items:       []*effectiveOrgPoliciesItemModel{},
+	}
+
+	for _, constraint := range constraints {
+		constraint = strings.TrimPrefix(constraint, "constraints/")
+		policy, err := orgPolicyClient.Projects.Policies.GetEffectivePolicy(
+			"projects/" + clients.project + "/policies/" + constraint).Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to get effective org policy of constraint "+constraint+".",
+				err.Error(),
+			)
+			return
+		}
+		state.Items = append(state.Items, newEffectiveOrgPoliciesItem("constraints/"+constraint, policy))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newEffectiveOrgPoliciesItem converts the effective policy of constraint
+// into queried item, merging the rules without condition.
+func newEffectiveOrgPoliciesItem(constraint string,
+	policy *googleOrgPolicyClient.GoogleCloudOrgpolicyV2Policy) *effectiveOrgPoliciesItemModel {
+	var enforced, allowAll, denyAll, hasConditionalRules bool
+	allowedValues, deniedValues := []attr.Value{}, []attr.Value{}
+	updateTime := ""
+	if policy.Spec != nil {
+		updateTime = policy.Spec.UpdateTime
+		for _, rule := range policy.Spec.Rules {
+			if rule.Condition != nil && rule.Condition.Expression != "" {
+				hasConditionalRules = true
+				continue
+			}
+			enforced = enforced || rule.Enforce
+			allowAll = allowAll || rule.AllowAll
+			denyAll = denyAll || rule.DenyAll
+			if rule.Values == nil {
+				continue
+			}
+			for _, value := range rule.Values.AllowedValues {
+				allowedValues = append(allowedValues, types.StringValue(value))
+			}
+			for _, value := range rule.Values.DeniedValues {
+				deniedValues = append(deniedValues, types.StringValue(value))
+			}
+		}
+	}
+
+	return &effectiveOrgPoliciesItemModel{
+		Constraint:          types.StringValue(constraint),
+		Enforced:            types.BoolValue(enforced),
+		AllowAll:            types.BoolValue(allowAll),
+		DenyAll:             types.BoolValue(denyAll),
+		AllowedValues:       types.ListValueMust(types.StringType, allowedValues),
+		DeniedValues:        types.ListValueMust(types.StringType, deniedValues),
+		HasConditionalRules: types.BoolValue(hasConditionalRules),
+		UpdateTime:          types.StringValue(updateTime),
+	}
+}
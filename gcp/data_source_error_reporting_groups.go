@@ -0,0 +1,231 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleErrorReportingClient "google.golang.org/api/clouderrorreporting/v1beta1"
+)
+
+const defaultErrorReportingPeriod = "PERIOD_1_HOUR"
+
+var (
+	_ datasource.DataSource              = &ErrorReportingGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ErrorReportingGroupsDataSource{}
+)
+
+// NewErrorReportingGroupsDataSource
+func NewErrorReportingGroupsDataSource() datasource.DataSource {
+	return &ErrorReportingGroupsDataSource{}
+}
+
+// ErrorReportingGroupsDataSource
+type ErrorReportingGroupsDataSource struct {
+	client *gcpClients
+}
+
+// ErrorReportingGroupsDataSourceModel
+type ErrorReportingGroupsDataSourceModel struct {
+	ClientConfig     *clientConfig                    `tfsdk:"client_config"`
+	Service          types.String                     `tfsdk:"service"`
+	Version          types.String                     `tfsdk:"version"`
+	Period           types.String                     `tfsdk:"period"`
+	ResolutionStatus types.String                     `tfsdk:"resolution_status"`
+	TotalCount       types.Int64                      `tfsdk:"total_count"`
+	Items            []*errorReportingGroupsItemModel `tfsdk:"items"`
+}
+
+type errorReportingGroupsItemModel struct {
+	GroupID            types.String `tfsdk:"group_id"`
+	Name               types.String `tfsdk:"name"`
+	ResolutionStatus   types.String `tfsdk:"resolution_status"`
+	Count              types.Int64  `tfsdk:"count"`
+	AffectedUsersCount types.Int64  `tfsdk:"affected_users_count"`
+	FirstSeenTime      types.String `tfsdk:"first_seen_time"`
+	LastSeenTime       types.String `tfsdk:"last_seen_time"`
+	Message            types.String `tfsdk:"message"`
+}
+
+// Metadata returns the data source Error Reporting groups type name.
+func (d *ErrorReportingGroupsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_error_reporting_groups"
+}
+
+// Schema defines the schema for the Error Reporting groups data source.
+func (d *ErrorReportingGroupsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Error Reporting groups of a service " +
+			"with their occurrence counts in a period, ordered by count.",
+		Attributes: map[string]schema.Attribute{
+			"service": schema.StringAttribute{
+				Description: "Service of the errors to be filtered, e.g. the App Engine " +
+					"service or the Cloud Run service name.",
+				Required: true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the service to be filtered. Default to all versions.",
+				Optional:    true,
+			},
+			"period": schema.StringAttribute{
+				Description: "Period until now to count the errors, valid values are " +
+					"PERIOD_1_HOUR, PERIOD_6_HOURS, PERIOD_1_DAY, PERIOD_1_WEEK and " +
+					"PERIOD_30_DAYS. Default to " + defaultErrorReportingPeriod + ".",
+				Optional: true,
+			},
+			"resolution_status": schema.StringAttribute{
+				Description: "Resolution status of group to be filtered, e.g. OPEN, " +
+					"ACKNOWLEDGED, RESOLVED or MUTED.",
+				Optional: true,
+			},
+			"total_count": schema.Int64Attribute{
+				Description: "Total number of the errors of the queried groups in the period.",
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							Description: "ID of group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Resource name of group.",
+							Computed:    true,
+						},
+						"resolution_status": schema.StringAttribute{
+							Description: "Resolution status of group.",
+							Computed:    true,
+						},
+						"count": schema.Int64Attribute{
+							Description: "Number of errors of group in the period.",
+							Computed:    true,
+						},
+						"affected_users_count": schema.Int64Attribute{
+							Description: "Number of users affected by the errors of group in the period.",
+							Computed:    true,
+						},
+						"first_seen_time": schema.StringAttribute{
+							Description: "Time of the first error of group ever seen.",
+							Computed:    true,
+						},
+						"last_seen_time": schema.StringAttribute{
+							Description: "Time of the last error of group seen in the period.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "Message of an error representing group.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ErrorReportingGroupsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Error Reporting groups data source information
+func (d *ErrorReportingGroupsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ErrorReportingGroupsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorReportingClient, err := googleErrorReportingClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Error Reporting client", err.Error())
+		return
+	}
+
+	period := defaultErrorReportingPeriod
+	if plan.Period.ValueString() != "" {
+		period = plan.Period.ValueString()
+	}
+
+	state := plan
+	state.Items = []*errorReportingGroupsItemModel{}
+	totalCount := int64(0)
+
+	call := errorReportingClient.Projects.GroupStats.List("projects/" + clients.project).
+		ServiceFilterService(plan.Service.ValueString()).
+		TimeRangePeriod(period).
+		Order("COUNT_DESC")
+	if plan.Version.ValueString() != "" {
+		call = call.ServiceFilterVersion(plan.Version.ValueString())
+	}
+	err = call.Pages(ctx, func(page *googleErrorReportingClient.ListGroupStatsResponse) error {
+		for _, stats := range page.ErrorGroupStats {
+			if stats.Group == nil {
+				continue
+			}
+			if !(plan.ResolutionStatus.IsUnknown() || plan.ResolutionStatus.IsNull()) &&
+				plan.ResolutionStatus.ValueString() != stats.Group.ResolutionStatus {
+				continue
+			}
+			totalCount += stats.Count
+			state.Items = append(state.Items, newErrorReportingGroupsItem(stats))
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list error group stats.",
+			err.Error(),
+		)
+		return
+	}
+	state.TotalCount = types.Int64Value(totalCount)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newErrorReportingGroupsItem converts the group stats into queried item.
+func newErrorReportingGroupsItem(stats *googleErrorReportingClient.ErrorGroupStats) *errorReportingGroupsItemModel {
+	message := ""
+	if stats.Representative != nil {
+		message = stats.Representative.Message
+	}
+
+	return &errorReportingGroupsItemModel{
+		GroupID:            types.StringValue(stats.Group.GroupId),
+		Name:               types.StringValue(stats.Group.Name),
+		ResolutionStatus:   types.StringValue(stats.Group.ResolutionStatus),
+		Count:              types.Int64Value(stats.Count),
+		AffectedUsersCount: types.Int64Value(stats.AffectedUsersCount),
+		FirstSeenTime:      types.StringValue(stats.FirstSeenTime),
+		LastSeenTime:       types.StringValue(stats.LastSeenTime),
+		Message:            types.StringValue(message),
+	}
+}
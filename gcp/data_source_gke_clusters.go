@@ -0,0 +1,339 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleContainerClient "google.golang.org/api/container/v1"
+)
+
+var (
+	_ datasource.DataSource              = &GkeClustersDataSource{}
+	_ datasource.DataSourceWithConfigure = &GkeClustersDataSource{}
+)
+
+// NewGkeClustersDataSource
+func NewGkeClustersDataSource() datasource.DataSource {
+	return &GkeClustersDataSource{}
+}
+
+// GkeClustersDataSource
+type GkeClustersDataSource struct {
+	client *gcpClients
+}
+
+// GkeClustersDataSourceModel
+type GkeClustersDataSourceModel struct {
+	ClientConfig *clientConfig           `tfsdk:"client_config"`
+	Name         types.String            `tfsdk:"name"`
+	Location     types.String            `tfsdk:"location"`
+	Labels       types.Map               `tfsdk:"labels"`
+	Items        []*gkeClustersItemModel `tfsdk:"items"`
+}
+
+type gkeClustersItemModel struct {
+	ID                   types.String            `tfsdk:"id"`
+	Name                 types.String            `tfsdk:"name"`
+	Location             types.String            `tfsdk:"location"`
+	Labels               types.Map               `tfsdk:"labels"`
+	Description          types.String            `tfsdk:"description"`
+	Status               types.String            `tfsdk:"status"`
+	Endpoint             types.String            `tfsdk:"endpoint"`
+	MasterVersion        types.String            `tfsdk:"master_version"`
+	ReleaseChannel       types.String            `tfsdk:"release_channel"`
+	WorkloadIdentityPool types.String            `tfsdk:"workload_identity_pool"`
+	Network              types.String            `tfsdk:"network"`
+	Subnetwork           types.String            `tfsdk:"subnetwork"`
+	NodePools            []*gkeNodePoolItemModel `tfsdk:"node_pools"`
+	CreateTime           types.String            `tfsdk:"create_time"`
+	SelfLink             types.String            `tfsdk:"self_link"`
+}
+
+type gkeNodePoolItemModel struct {
+	Name               types.String `tfsdk:"name"`
+	Version            types.String `tfsdk:"version"`
+	Status             types.String `tfsdk:"status"`
+	MachineType        types.String `tfsdk:"machine_type"`
+	InitialNodeCount   types.Int64  `tfsdk:"initial_node_count"`
+	AutoscalingEnabled types.Bool   `tfsdk:"autoscaling_enabled"`
+	MinNodeCount       types.Int64  `tfsdk:"min_node_count"`
+	MaxNodeCount       types.Int64  `tfsdk:"max_node_count"`
+}
+
+// Metadata returns the data source GKE clusters type name.
+func (d *GkeClustersDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gke_clusters"
+}
+
+// Schema defines the schema for the GKE clusters data source.
+func (d *GkeClustersDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the GKE clusters on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of cluster to be filtered.",
+				Optional:    true,
+			},
+			"location": schema.StringAttribute{
+				Description: "Region or zone of cluster to be filtered. Default to all the locations.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Resource labels of cluster to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried clusters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of cluster.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of cluster.",
+							Computed:    true,
+						},
+						"location": schema.StringAttribute{
+							Description: "Region or zone of cluster.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Resource labels of cluster.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of cluster.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of cluster, e.g. RUNNING.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "IP address of the Kubernetes master endpoint.",
+							Computed:    true,
+						},
+						"master_version": schema.StringAttribute{
+							Description: "Current Kubernetes version of master.",
+							Computed:    true,
+						},
+						"release_channel": schema.StringAttribute{
+							Description: "Release channel of cluster, e.g. REGULAR, empty if cluster " +
+								"is not enrolled in any release channel.",
+							Computed: true,
+						},
+						"workload_identity_pool": schema.StringAttribute{
+							Description: "Workload Identity pool of cluster, empty if Workload " +
+								"Identity is not enabled.",
+							Computed: true,
+						},
+						"network": schema.StringAttribute{
+							Description: "Network of cluster.",
+							Computed:    true,
+						},
+						"subnetwork": schema.StringAttribute{
+							Description: "Subnetwork of cluster.",
+							Computed:    true,
+						},
+						"node_pools": schema.ListNestedAttribute{
+							Description: "Node pools of cluster.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "Name of node pool.",
+										Computed:    true,
+									},
+									"version": schema.StringAttribute{
+										Description: "Kubernetes version of node pool.",
+										Computed:    true,
+									},
+									"status": schema.StringAttribute{
+										Description: "Status of node pool, e.g. RUNNING.",
+										Computed:    true,
+									},
+									"machine_type": schema.StringAttribute{
+										Description: "Machine type of nodes.",
+										Computed:    true,
+									},
+									"initial_node_count": schema.Int64Attribute{
+										Description: "Initial number of nodes of each zone.",
+										Computed:    true,
+									},
+									"autoscaling_enabled": schema.BoolAttribute{
+										Description: "Whether autoscaling is enabled.",
+										Computed:    true,
+									},
+									"min_node_count": schema.Int64Attribute{
+										Description: "Minimum number of nodes of each zone when autoscaling.",
+										Computed:    true,
+									},
+									"max_node_count": schema.Int64Attribute{
+										Description: "Maximum number of nodes of each zone when autoscaling.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"create_time": schema.StringAttribute{
+							Description: "Creation time of cluster.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of cluster.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *GkeClustersDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read GKE clusters data source information
+func (d *GkeClustersDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *GkeClustersDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	containerClient, err := googleContainerClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Kubernetes Engine client", err.Error())
+		return
+	}
+
+	state := &GkeClustersDataSourceModel{
+		Name:     plan.Name,
+		Location: plan.Location,
+		Labels:   plan.Labels,
+		Items:    []*gkeClustersItemModel{},
+	}
+
+	// "-" lists the clusters of all the locations.
+	location := "-"
+	if plan.Location.ValueString() != "" {
+		location = plan.Location.ValueString()
+	}
+	clusters, err := containerClient.Projects.Locations.Clusters.List(
+		fmt.Sprintf("projects/%s/locations/%s", clients.project, location)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list clusters.",
+			err.Error(),
+		)
+		return
+	}
+	if len(clusters.MissingZones) > 0 {
+		resp.Diagnostics.AddWarning(
+			"[Warning] Clusters of some zones may be missing",
+			fmt.Sprintf("Missing zones: %v", clusters.MissingZones),
+		)
+	}
+
+	for _, cluster := range clusters.Clusters {
+		if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != cluster.Name {
+			continue
+		}
+		labels, labelsTfType := labelsValue(cluster.ResourceLabels)
+		if !matchTags(plan.Labels, labels) {
+			continue
+		}
+		state.Items = append(state.Items, newGkeClustersItem(cluster, labelsTfType))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newGkeClustersItem converts the cluster into queried item.
+func newGkeClustersItem(cluster *googleContainerClient.Cluster, labels types.Map) *gkeClustersItemModel {
+	releaseChannel := ""
+	if cluster.ReleaseChannel != nil && cluster.ReleaseChannel.Channel != "UNSPECIFIED" {
+		releaseChannel = cluster.ReleaseChannel.Channel
+	}
+	workloadIdentityPool := ""
+	if cluster.WorkloadIdentityConfig != nil {
+		workloadIdentityPool = cluster.WorkloadIdentityConfig.WorkloadPool
+	}
+	nodePools := []*gkeNodePoolItemModel{}
+	for _, nodePool := range cluster.NodePools {
+		nodePools = append(nodePools, newGkeNodePoolItem(nodePool))
+	}
+
+	return &gkeClustersItemModel{
+		ID:                   types.StringValue(cluster.Id),
+		Name:                 types.StringValue(cluster.Name),
+		Location:             types.StringValue(cluster.Location),
+		Labels:               labels,
+		Description:          types.StringValue(cluster.Description),
+		Status:               types.StringValue(cluster.Status),
+		Endpoint:             types.StringValue(cluster.Endpoint),
+		MasterVersion:        types.StringValue(cluster.CurrentMasterVersion),
+		ReleaseChannel:       types.StringValue(releaseChannel),
+		WorkloadIdentityPool: types.StringValue(workloadIdentityPool),
+		Network:              types.StringValue(cluster.Network),
+		Subnetwork:           types.StringValue(cluster.Subnetwork),
+		NodePools:            nodePools,
+		CreateTime:           types.StringValue(cluster.CreateTime),
+		SelfLink:             types.StringValue(cluster.SelfLink),
+	}
+}
+
+// newGkeNodePoolItem converts the node pool into queried item.
+func newGkeNodePoolItem(nodePool *googleContainerClient.NodePool) *gkeNodePoolItemModel {
+	item := &gkeNodePoolItemModel{
+		Name:               types.StringValue(nodePool.Name),
+		Version:            types.StringValue(nodePool.Version),
+		Status:             types.StringValue(nodePool.Status),
+		MachineType:        types.StringValue(""),
+		InitialNodeCount:   types.Int64Value(nodePool.InitialNodeCount),
+		AutoscalingEnabled: types.BoolValue(false),
+		MinNodeCount:       types.Int64Value(0),
+		MaxNodeCount:       types.Int64Value(0),
+	}
+	if nodePool.Config != nil {
+		item.MachineType = types.StringValue(nodePool.Config.MachineType)
+	}
+	if nodePool.Autoscaling != nil && nodePool.Autoscaling.Enabled {
+		item.AutoscalingEnabled = types.BoolValue(true)
+		item.MinNodeCount = types.Int64Value(nodePool.Autoscaling.MinNodeCount)
+		item.MaxNodeCount = types.Int64Value(nodePool.Autoscaling.MaxNodeCount)
+	}
+	return item
+}
@@ -0,0 +1,351 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+	googleContainerClient "google.golang.org/api/container/v1"
+)
+
+// nodePoolStatusReconciling is the status of the node pool which is being
+// upgraded or updated.
+const nodePoolStatusReconciling = "RECONCILING"
+
+var (
+	_ datasource.DataSource              = &GkeNodePoolsDataSource{}
+	_ datasource.DataSourceWithConfigure = &GkeNodePoolsDataSource{}
+)
+
+// NewGkeNodePoolsDataSource
+func NewGkeNodePoolsDataSource() datasource.DataSource {
+	return &GkeNodePoolsDataSource{}
+}
+
+// GkeNodePoolsDataSource
+type GkeNodePoolsDataSource struct {
+	client *gcpClients
+}
+
+// GkeNodePoolsDataSourceModel
+type GkeNodePoolsDataSourceModel struct {
+	ClientConfig  *clientConfig            `tfsdk:"client_config"`
+	Cluster       types.String             `tfsdk:"cluster"`
+	Location      types.String             `tfsdk:"location"`
+	Name          types.String             `tfsdk:"name"`
+	AllowMissing  types.Bool               `tfsdk:"allow_missing"`
+	MasterVersion types.String             `tfsdk:"master_version"`
+	Items         []*gkeNodePoolsItemModel `tfsdk:"items"`
+}
+
+type gkeNodePoolsItemModel struct {
+	Name               types.String         `tfsdk:"name"`
+	Version            types.String         `tfsdk:"version"`
+	Status             types.String         `tfsdk:"status"`
+	StatusMessage      types.String         `tfsdk:"status_message"`
+	Upgrading          types.Bool           `tfsdk:"upgrading"`
+	UpgradeAvailable   types.Bool           `tfsdk:"upgrade_available"`
+	UpgradeStrategy    types.String         `tfsdk:"upgrade_strategy"`
+	MachineType        types.String         `tfsdk:"machine_type"`
+	Spot               types.Bool           `tfsdk:"spot"`
+	NodeCount          types.Int64          `tfsdk:"node_count"`
+	AutoscalingEnabled types.Bool           `tfsdk:"autoscaling_enabled"`
+	MinNodeCount       types.Int64          `tfsdk:"min_node_count"`
+	MaxNodeCount       types.Int64          `tfsdk:"max_node_count"`
+	TotalMinNodeCount  types.Int64          `tfsdk:"total_min_node_count"`
+	TotalMaxNodeCount  types.Int64          `tfsdk:"total_max_node_count"`
+	Locations          types.List           `tfsdk:"locations"`
+	Taints             []*gkeNodeTaintModel `tfsdk:"taints"`
+}
+
+type gkeNodeTaintModel struct {
+	Key    types.String `tfsdk:"key"`
+	Value  types.String `tfsdk:"value"`
+	Effect types.String `tfsdk:"effect"`
+}
+
+// Metadata returns the data source GKE node pools type name.
+func (d *GkeNodePoolsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gke_node_pools"
+}
+
+// Schema defines the schema for the GKE node pools data source.
+func (d *GkeNodePoolsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the node pools of a GKE cluster on Google Cloud " +
+			"with their capacity and upgrade status.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name of cluster.",
+				Required:    true,
+			},
+			"location": schema.StringAttribute{
+				Description: "Region or zone of cluster.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of node pool to be filtered.",
+				Optional:    true,
+			},
+			"allow_missing": allowMissingAttribute("cluster"),
+			"master_version": schema.StringAttribute{
+				Description: "Current Kubernetes version of master.",
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried node pools.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of node pool.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Kubernetes version of node pool.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of node pool, e.g. RUNNING or RECONCILING.",
+							Computed:    true,
+						},
+						"status_message": schema.StringAttribute{
+							Description: "Message of the status of node pool.",
+							Computed:    true,
+						},
+						"upgrading": schema.BoolAttribute{
+							Description: "Whether node pool is being upgraded or updated.",
+							Computed:    true,
+						},
+						"upgrade_available": schema.BoolAttribute{
+							Description: "Whether the version of node pool is different from the " +
+								"version of master.",
+							Computed: true,
+						},
+						"upgrade_strategy": schema.StringAttribute{
+							Description: "Upgrade strategy of node pool, SURGE or BLUE_GREEN.",
+							Computed:    true,
+						},
+						"machine_type": schema.StringAttribute{
+							Description: "Machine type of nodes.",
+							Computed:    true,
+						},
+						"spot": schema.BoolAttribute{
+							Description: "Whether nodes are Spot VMs.",
+							Computed:    true,
+						},
+						"node_count": schema.Int64Attribute{
+							Description: "Current number of nodes, i.e. the sum of the target sizes " +
+								"of the managed instance groups of node pool.",
+							Computed: true,
+						},
+						"autoscaling_enabled": schema.BoolAttribute{
+							Description: "Whether autoscaling is enabled.",
+							Computed:    true,
+						},
+						"min_node_count": schema.Int64Attribute{
+							Description: "Minimum number of nodes of each zone when autoscaling.",
+							Computed:    true,
+						},
+						"max_node_count": schema.Int64Attribute{
+							Description: "Maximum number of nodes of each zone when autoscaling.",
+							Computed:    true,
+						},
+						"total_min_node_count": schema.Int64Attribute{
+							Description: "Minimum number of nodes of node pool when autoscaling.",
+							Computed:    true,
+						},
+						"total_max_node_count": schema.Int64Attribute{
+							Description: "Maximum number of nodes of node pool when autoscaling.",
+							Computed:    true,
+						},
+						"locations": schema.ListAttribute{
+							Description: "Zones of nodes.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"taints": schema.ListNestedAttribute{
+							Description: "Kubernetes taints of nodes.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Description: "Key of taint.",
+										Computed:    true,
+									},
+									"value": schema.StringAttribute{
+										Description: "Value of taint.",
+										Computed:    true,
+									},
+									"effect": schema.StringAttribute{
+										Description: "Effect of taint, e.g. NO_SCHEDULE.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *GkeNodePoolsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read GKE node pools data source information
+func (d *GkeNodePoolsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *GkeNodePoolsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	containerClient, err := googleContainerClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Kubernetes Engine client", err.Error())
+		return
+	}
+
+	cluster, err := containerClient.Projects.Locations.Clusters.Get(fmt.Sprintf("projects/%s/locations/%s/clusters/%s",
+		clients.project, plan.Location.ValueString(), plan.Cluster.ValueString())).Context(ctx).Do()
+	if isMissingAllowed(plan.AllowMissing, err) {
+		cluster, err = &googleContainerClient.Cluster{}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get cluster.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := &GkeNodePoolsDataSourceModel{
+		Cluster:       plan.Cluster,
+		Location:      plan.Location,
+		Name:          plan.Name,
+		AllowMissing:  plan.AllowMissing,
+		MasterVersion: types.StringValue(cluster.CurrentMasterVersion),
+		Items:         []*gkeNodePoolsItemModel{},
+	}
+
+	for _, nodePool := range cluster.NodePools {
+		if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != nodePool.Name {
+			continue
+		}
+		nodeCount, err := nodePoolNodeCount(ctx, clients.computeClient, clients.project, nodePool)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to get instance group managers of node pool.",
+				err.Error(),
+			)
+			return
+		}
+		state.Items = append(state.Items, newGkeNodePoolsItem(nodePool, cluster.CurrentMasterVersion, nodeCount))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// nodePoolNodeCount returns the sum of the target sizes of the managed
+// instance groups of node pool, the instance group URLs are in the format of
+// .../projects/{project}/zones/{zone}/instanceGroupManagers/{name}.
+func nodePoolNodeCount(ctx context.Context, client *googleComputeClient.Service,
+	project string, nodePool *googleContainerClient.NodePool) (int64, error) {
+	var nodeCount int64
+	for _, instanceGroupURL := range nodePool.InstanceGroupUrls {
+		parts := strings.Split(instanceGroupURL, "/")
+		if len(parts) < 4 || parts[len(parts)-4] != "zones" {
+			return 0, fmt.Errorf("unexpected instance group URL %s", instanceGroupURL)
+		}
+		instanceGroupManager, err := client.InstanceGroupManagers.Get(project,
+			parts[len(parts)-3], parts[len(parts)-1]).Context(ctx).Do()
+		if err != nil {
+			return 0, err
+		}
+		nodeCount += instanceGroupManager.TargetSize
+	}
+	return nodeCount, nil
+}
+
+// newGkeNodePoolsItem converts the node pool into queried item.
+func newGkeNodePoolsItem(nodePool *googleContainerClient.NodePool,
+	masterVersion string, nodeCount int64) *gkeNodePoolsItemModel {
+	locations := []attr.Value{}
+	for _, location := range nodePool.Locations {
+		locations = append(locations, types.StringValue(location))
+	}
+
+	item := &gkeNodePoolsItemModel{
+		Name:               types.StringValue(nodePool.Name),
+		Version:            types.StringValue(nodePool.Version),
+		Status:             types.StringValue(nodePool.Status),
+		StatusMessage:      types.StringValue(nodePool.StatusMessage),
+		Upgrading:          types.BoolValue(nodePool.Status == nodePoolStatusReconciling),
+		UpgradeAvailable:   types.BoolValue(nodePool.Version != masterVersion),
+		UpgradeStrategy:    types.StringValue(""),
+		MachineType:        types.StringValue(""),
+		Spot:               types.BoolValue(false),
+		NodeCount:          types.Int64Value(nodeCount),
+		AutoscalingEnabled: types.BoolValue(false),
+		MinNodeCount:       types.Int64Value(0),
+		MaxNodeCount:       types.Int64Value(0),
+		TotalMinNodeCount:  types.Int64Value(0),
+		TotalMaxNodeCount:  types.Int64Value(0),
+		Locations:          types.ListValueMust(types.StringType, locations),
+		Taints:             []*gkeNodeTaintModel{},
+	}
+	if nodePool.UpgradeSettings != nil {
+		item.UpgradeStrategy = types.StringValue(nodePool.UpgradeSettings.Strategy)
+	}
+	if nodePool.Config != nil {
+		item.MachineType = types.StringValue(nodePool.Config.MachineType)
+		item.Spot = types.BoolValue(nodePool.Config.Spot || nodePool.Config.Preemptible)
+		for _, taint := range nodePool.Config.Taints {
+			item.Taints = append(item.Taints, &gkeNodeTaintModel{
+				Key:    types.StringValue(taint.Key),
+				Value:  types.StringValue(taint.Value),
+				Effect: types.StringValue(taint.Effect),
+			})
+		}
+	}
+	if nodePool.Autoscaling != nil && nodePool.Autoscaling.Enabled {
+		item.AutoscalingEnabled = types.BoolValue(true)
+		item.MinNodeCount = types.Int64Value(nodePool.Autoscaling.MinNodeCount)
+		item.MaxNodeCount = types.Int64Value(nodePool.Autoscaling.MaxNodeCount)
+		item.TotalMinNodeCount = types.Int64Value(nodePool.Autoscaling.TotalMinNodeCount)
+		item.TotalMaxNodeCount = types.Int64Value(nodePool.Autoscaling.TotalMaxNodeCount)
+	}
+	return item
+}
@@ -0,0 +1,362 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &HealthChecksDataSource{}
+	_ datasource.DataSourceWithConfigure = &HealthChecksDataSource{}
+)
+
+// NewHealthChecksDataSource
+func NewHealthChecksDataSource() datasource.DataSource {
+	return &HealthChecksDataSource{}
+}
+
+// HealthChecksDataSource
+type HealthChecksDataSource struct {
+	client *gcpClients
+}
+
+// HealthChecksDataSourceModel
+type HealthChecksDataSourceModel struct {
+	ClientConfig *clientConfig            `tfsdk:"client_config"`
+	Name         types.String             `tfsdk:"name"`
+	Tags         types.Map                `tfsdk:"tags"`
+	Type         types.String             `tfsdk:"type"`
+	Region       types.String             `tfsdk:"region"`
+	Items        []*healthChecksItemModel `tfsdk:"items"`
+}
+
+type healthChecksItemModel struct {
+	ID                 types.Int64             `tfsdk:"id"`
+	Name               types.String            `tfsdk:"name"`
+	Tags               types.Map               `tfsdk:"tags"`
+	Type               types.String            `tfsdk:"type"`
+	Region             types.String            `tfsdk:"region"`
+	CheckIntervalSec   types.Int64             `tfsdk:"check_interval_sec"`
+	TimeoutSec         types.Int64             `tfsdk:"timeout_sec"`
+	HealthyThreshold   types.Int64             `tfsdk:"healthy_threshold"`
+	UnhealthyThreshold types.Int64             `tfsdk:"unhealthy_threshold"`
+	LogEnabled         types.Bool              `tfsdk:"log_enabled"`
+	Check              *healthCheckParamsModel `tfsdk:"check"`
+	SelfLink           types.String            `tfsdk:"self_link"`
+}
+
+type healthCheckParamsModel struct {
+	Port              types.Int64  `tfsdk:"port"`
+	PortName          types.String `tfsdk:"port_name"`
+	PortSpecification types.String `tfsdk:"port_specification"`
+	Host              types.String `tfsdk:"host"`
+	RequestPath       types.String `tfsdk:"request_path"`
+	Request           types.String `tfsdk:"request"`
+	Response          types.String `tfsdk:"response"`
+	ProxyHeader       types.String `tfsdk:"proxy_header"`
+	GrpcServiceName   types.String `tfsdk:"grpc_service_name"`
+}
+
+// Metadata returns the data source health checks type name.
+func (d *HealthChecksDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health_checks"
+}
+
+// Schema defines the schema for the health checks data source.
+func (d *HealthChecksDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the global and regional health checks on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of health check to be filtered.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of health check to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of health check to be filtered, one of TCP, SSL, " +
+					"HTTP, HTTPS, HTTP2 or GRPC.",
+				Optional: true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of health checks to be filtered, or global for " +
+					"the global health checks. Default to list the health checks in " +
+					"all scopes.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried health checks.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of health check.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of health check.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Tags of health check.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of health check.",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of health check, empty for global health check.",
+							Computed:    true,
+						},
+						"check_interval_sec": schema.Int64Attribute{
+							Description: "How often in seconds to send a health check.",
+							Computed:    true,
+						},
+						"timeout_sec": schema.Int64Attribute{
+							Description: "How long in seconds to wait before claiming failure.",
+							Computed:    true,
+						},
+						"healthy_threshold": schema.Int64Attribute{
+							Description: "Number of consecutive successes to be marked healthy.",
+							Computed:    true,
+						},
+						"unhealthy_threshold": schema.Int64Attribute{
+							Description: "Number of consecutive failures to be marked unhealthy.",
+							Computed:    true,
+						},
+						"log_enabled": schema.BoolAttribute{
+							Description: "Whether health check logging is enabled.",
+							Computed:    true,
+						},
+						"check": schema.SingleNestedAttribute{
+							Description: "Parameters of the check of the health check type. " +
+								"Attributes not supported by the type are empty.",
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"port": schema.Int64Attribute{
+									Description: "Port number of the health check request.",
+									Computed:    true,
+								},
+								"port_name": schema.StringAttribute{
+									Description: "Named port of the health check request.",
+									Computed:    true,
+								},
+								"port_specification": schema.StringAttribute{
+									Description: "How the port is selected, e.g. USE_FIXED_PORT.",
+									Computed:    true,
+								},
+								"host": schema.StringAttribute{
+									Description: "Host header of the HTTP, HTTPS or HTTP2 health check request.",
+									Computed:    true,
+								},
+								"request_path": schema.StringAttribute{
+									Description: "Request path of the HTTP, HTTPS or HTTP2 health check request.",
+									Computed:    true,
+								},
+								"request": schema.StringAttribute{
+									Description: "Application data sent by the TCP or SSL health check.",
+									Computed:    true,
+								},
+								"response": schema.StringAttribute{
+									Description: "Expected response of the health check.",
+									Computed:    true,
+								},
+								"proxy_header": schema.StringAttribute{
+									Description: "Type of proxy header appended before sending data.",
+									Computed:    true,
+								},
+								"grpc_service_name": schema.StringAttribute{
+									Description: "gRPC service name of the GRPC health check.",
+									Computed:    true,
+								},
+							},
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of health check.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *HealthChecksDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read health checks data source information
+func (d *HealthChecksDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *HealthChecksDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &HealthChecksDataSourceModel{
+		Name:   plan.Name,
+		Tags:   plan.Tags,
+		Type:   plan.Type,
+		Region: plan.Region,
+		Items:  []*healthChecksItemModel{},
+	}
+
+	appendItems := func(healthChecks []*googleComputeClient.HealthCheck) {
+		for _, healthCheck := range healthChecks {
+			if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != healthCheck.Name {
+				continue
+			}
+			if !(plan.Type.IsUnknown() || plan.Type.IsNull()) && plan.Type.ValueString() != healthCheck.Type {
+				continue
+			}
+
+			tags, tagsTfType, diags := descriptionTags(healthCheck.Description)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if !matchTags(plan.Tags, tags) {
+				continue
+			}
+			state.Items = append(state.Items, newHealthChecksItem(healthCheck, tagsTfType))
+		}
+	}
+
+	var err error
+	switch region := plan.Region.ValueString(); region {
+	case "":
+		err = clients.computeClient.HealthChecks.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.HealthChecksAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendItems(page.Items[scope].HealthChecks)
+				}
+				return nil
+			})
+	case globalScope:
+		err = clients.computeClient.HealthChecks.List(clients.project).Pages(ctx,
+			func(page *googleComputeClient.HealthCheckList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	default:
+		err = clients.computeClient.RegionHealthChecks.List(clients.project, region).Pages(ctx,
+			func(page *googleComputeClient.HealthCheckList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list health checks.",
+			err.Error(),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newHealthChecksItem converts the health check into queried item, with the
+// parameters of the health check type flattened into check.
+func newHealthChecksItem(healthCheck *googleComputeClient.HealthCheck, tags types.Map) *healthChecksItemModel {
+	params := &googleComputeClient.HTTPHealthCheck{}
+	var request, grpcServiceName string
+	switch {
+	case healthCheck.HttpHealthCheck != nil:
+		params = healthCheck.HttpHealthCheck
+	case healthCheck.HttpsHealthCheck != nil:
+		c := healthCheck.HttpsHealthCheck
+		params = &googleComputeClient.HTTPHealthCheck{
+			Host: c.Host, Port: c.Port, PortName: c.PortName, PortSpecification: c.PortSpecification,
+			ProxyHeader: c.ProxyHeader, RequestPath: c.RequestPath, Response: c.Response,
+		}
+	case healthCheck.Http2HealthCheck != nil:
+		c := healthCheck.Http2HealthCheck
+		params = &googleComputeClient.HTTPHealthCheck{
+			Host: c.Host, Port: c.Port, PortName: c.PortName, PortSpecification: c.PortSpecification,
+			ProxyHeader: c.ProxyHeader, RequestPath: c.RequestPath, Response: c.Response,
+		}
+	case healthCheck.TcpHealthCheck != nil:
+		c := healthCheck.TcpHealthCheck
+		params = &googleComputeClient.HTTPHealthCheck{
+			Port: c.Port, PortName: c.PortName, PortSpecification: c.PortSpecification,
+			ProxyHeader: c.ProxyHeader, Response: c.Response,
+		}
+		request = c.Request
+	case healthCheck.SslHealthCheck != nil:
+		c := healthCheck.SslHealthCheck
+		params = &googleComputeClient.HTTPHealthCheck{
+			Port: c.Port, PortName: c.PortName, PortSpecification: c.PortSpecification,
+			ProxyHeader: c.ProxyHeader, Response: c.Response,
+		}
+		request = c.Request
+	case healthCheck.GrpcHealthCheck != nil:
+		c := healthCheck.GrpcHealthCheck
+		params = &googleComputeClient.HTTPHealthCheck{
+			Port: c.Port, PortName: c.PortName, PortSpecification: c.PortSpecification,
+		}
+		grpcServiceName = c.GrpcServiceName
+	}
+
+	return &healthChecksItemModel{
+		ID:                 types.Int64Value(int64(healthCheck.Id)),
+		Name:               types.StringValue(healthCheck.Name),
+		Tags:               tags,
+		Type:               types.StringValue(healthCheck.Type),
+		Region:             types.StringValue(resourceNameFromSelfLink(healthCheck.Region)),
+		CheckIntervalSec:   types.Int64Value(healthCheck.CheckIntervalSec),
+		TimeoutSec:         types.Int64Value(healthCheck.TimeoutSec),
+		HealthyThreshold:   types.Int64Value(healthCheck.HealthyThreshold),
+		UnhealthyThreshold: types.Int64Value(healthCheck.UnhealthyThreshold),
+		LogEnabled:         types.BoolValue(healthCheck.LogConfig != nil && healthCheck.LogConfig.Enable),
+		Check: &healthCheckParamsModel{
+			Port:              types.Int64Value(params.Port),
+			PortName:          types.StringValue(params.PortName),
+			PortSpecification: types.StringValue(params.PortSpecification),
+			Host:              types.StringValue(params.Host),
+			RequestPath:       types.StringValue(params.RequestPath),
+			Request:           types.StringValue(request),
+			Response:          types.StringValue(params.Response),
+			ProxyHeader:       types.StringValue(params.ProxyHeader),
+			GrpcServiceName:   types.StringValue(grpcServiceName),
+		},
+		SelfLink: types.StringValue(healthCheck.SelfLink),
+	}
+}
@@ -0,0 +1,372 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeAlphaClient "google.golang.org/api/compute/v0.alpha"
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const defaultMaintenanceEventsLookbackHours = 24
+
+// maintenanceEventOperationTypes are the types of the system operations
+// recorded when instances are interrupted by Compute Engine.
+var maintenanceEventOperationTypes = []string{
+	"compute.instances.preempted",
+	"compute.instances.hostError",
+	"compute.instances.migrateOnHostMaintenance",
+	"compute.instances.terminateOnHostMaintenance",
+	"compute.instances.guestTerminate",
+	"compute.instances.automaticRestart",
+}
+
+var (
+	_ datasource.DataSource              = &InstanceMaintenanceEventsDataSource{}
+	_ datasource.DataSourceWithConfigure = &InstanceMaintenanceEventsDataSource{}
+)
+
+// NewInstanceMaintenanceEventsDataSource
+func NewInstanceMaintenanceEventsDataSource() datasource.DataSource {
+	return &InstanceMaintenanceEventsDataSource{}
+}
+
+// InstanceMaintenanceEventsDataSource
+type InstanceMaintenanceEventsDataSource struct {
+	client *gcpClients
+}
+
+// InstanceMaintenanceEventsDataSourceModel
+type InstanceMaintenanceEventsDataSourceModel struct {
+	ClientConfig  *clientConfig                         `tfsdk:"client_config"`
+	Labels        types.Map                             `tfsdk:"labels"`
+	Zone          types.String                          `tfsdk:"zone"`
+	LookbackHours types.Int64                           `tfsdk:"lookback_hours"`
+	Items         []*instanceMaintenanceEventsItemModel `tfsdk:"items"`
+}
+
+type instanceMaintenanceEventsItemModel struct {
+	ID                  types.Int64                  `tfsdk:"id"`
+	Name                types.String                 `tfsdk:"name"`
+	Zone                types.String                 `tfsdk:"zone"`
+	Status              types.String                 `tfsdk:"status"`
+	OnHostMaintenance   types.String                 `tfsdk:"on_host_maintenance"`
+	ProvisioningModel   types.String                 `tfsdk:"provisioning_model"`
+	UpcomingMaintenance *upcomingMaintenanceModel    `tfsdk:"upcoming_maintenance"`
+	Events              []*maintenanceEventItemModel `tfsdk:"events"`
+}
+
+type upcomingMaintenanceModel struct {
+	Type            types.String `tfsdk:"type"`
+	Status          types.String `tfsdk:"status"`
+	CanReschedule   types.Bool   `tfsdk:"can_reschedule"`
+	WindowStartTime types.String `tfsdk:"window_start_time"`
+	WindowEndTime   types.String `tfsdk:"window_end_time"`
+}
+
+type maintenanceEventItemModel struct {
+	Type          types.String `tfsdk:"type"`
+	Time          types.String `tfsdk:"time"`
+	StatusMessage types.String `tfsdk:"status_message"`
+}
+
+// Metadata returns the data source instance maintenance events type name.
+func (d *InstanceMaintenanceEventsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_maintenance_events"
+}
+
+// Schema defines the schema for the instance maintenance events data source.
+func (d *InstanceMaintenanceEventsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source reports the upcoming or ongoing maintenance and " +
+			"the recent interruptions, e.g. preemptions, host errors or live " +
+			"migrations, of the instances on Google Cloud, so operators can schedule " +
+			"the drains through the Terraform-driven runbooks.",
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.MapAttribute{
+				Description: "Labels of instance to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of the instances to be queried. Default to all zones.",
+				Optional:    true,
+			},
+			"lookback_hours": schema.Int64Attribute{
+				Description: "Number of hours the recent interruptions are queried. " +
+					"Default to " + strconv.Itoa(defaultMaintenanceEventsLookbackHours) + ".",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried instances.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of instance.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of instance.",
+							Computed:    true,
+						},
+						"zone": schema.StringAttribute{
+							Description: "Zone of instance.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of instance, e.g. RUNNING or TERMINATED.",
+							Computed:    true,
+						},
+						"on_host_maintenance": schema.StringAttribute{
+							Description: "Maintenance behavior of instance, MIGRATE or TERMINATE.",
+							Computed:    true,
+						},
+						"provisioning_model": schema.StringAttribute{
+							Description: "Provisioning model of instance, STANDARD or SPOT.",
+							Computed:    true,
+						},
+						"upcoming_maintenance": schema.SingleNestedAttribute{
+							Description: "Upcoming or ongoing maintenance of instance, null " +
+								"if no maintenance is scheduled.",
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Description: "Type of maintenance, SCHEDULED or UNSCHEDULED.",
+									Computed:    true,
+								},
+								"status": schema.StringAttribute{
+									Description: "Status of maintenance, PENDING or ONGOING.",
+									Computed:    true,
+								},
+								"can_reschedule": schema.BoolAttribute{
+									Description: "Whether maintenance can be triggered by customer.",
+									Computed:    true,
+								},
+								"window_start_time": schema.StringAttribute{
+									Description: "Start time of the maintenance window.",
+									Computed:    true,
+								},
+								"window_end_time": schema.StringAttribute{
+									Description: "Time by which maintenance is completed.",
+									Computed:    true,
+								},
+							},
+						},
+						"events": schema.ListNestedAttribute{
+							Description: "Recent interruptions of instance, sorted from the newest.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "Type of interruption, e.g. " +
+											"compute.instances.preempted or " +
+											"compute.instances.migrateOnHostMaintenance.",
+										Computed: true,
+									},
+									"time": schema.StringAttribute{
+										Description: "Time of interruption.",
+										Computed:    true,
+									},
+									"status_message": schema.StringAttribute{
+										Description: "Status message of interruption.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *InstanceMaintenanceEventsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read instance maintenance events data source information
+func (d *InstanceMaintenanceEventsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *InstanceMaintenanceEventsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Upcoming maintenance of instances is only available in the alpha API.
+	computeAlphaClient, err := googleComputeAlphaClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Compute alpha client", err.Error())
+		return
+	}
+
+	state := &InstanceMaintenanceEventsDataSourceModel{
+		Labels:        plan.Labels,
+		Zone:          plan.Zone,
+		LookbackHours: plan.LookbackHours,
+		Items:         []*instanceMaintenanceEventsItemModel{},
+	}
+
+	// Queried instances by ID, which is the target ID of the operations.
+	instanceItems := map[uint64]*instanceMaintenanceEventsItemModel{}
+	appendItems := func(instances []*googleComputeAlphaClient.Instance) {
+		for _, instance := range instances {
+			labels, _ := labelsValue(instance.Labels)
+			if !matchTags(plan.Labels, labels) {
+				continue
+			}
+			item := newInstanceMaintenanceEventsItem(instance)
+			instanceItems[instance.Id] = item
+			state.Items = append(state.Items, item)
+		}
+	}
+
+	zone := plan.Zone.ValueString()
+	if zone != "" {
+		err = computeAlphaClient.Instances.List(clients.project, zone).Pages(ctx,
+			func(page *googleComputeAlphaClient.InstanceList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	} else {
+		err = computeAlphaClient.Instances.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeAlphaClient.InstanceAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendItems(page.Items[scope].Instances)
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list instances.",
+			err.Error(),
+		)
+		return
+	}
+
+	lookbackHours := int64(defaultMaintenanceEventsLookbackHours)
+	if !(plan.LookbackHours.IsUnknown() || plan.LookbackHours.IsNull()) {
+		lookbackHours = plan.LookbackHours.ValueInt64()
+	}
+	since := time.Now().Add(-time.Duration(lookbackHours) * time.Hour)
+
+	filters := make([]string, 0, len(maintenanceEventOperationTypes))
+	for _, operationType := range maintenanceEventOperationTypes {
+		filters = append(filters, fmt.Sprintf("(operationType = %q)", operationType))
+	}
+	filter := strings.Join(filters, " OR ")
+
+	appendEvents := func(operations []*googleComputeClient.Operation) {
+		for _, op := range operations {
+			item, ok := instanceItems[op.TargetId]
+			if !ok {
+				continue
+			}
+			insertTime, err := time.Parse(time.RFC3339, op.InsertTime)
+			if err != nil || insertTime.Before(since) {
+				continue
+			}
+			item.Events = append(item.Events, &maintenanceEventItemModel{
+				Type:          types.StringValue(op.OperationType),
+				Time:          types.StringValue(op.InsertTime),
+				StatusMessage: types.StringValue(op.StatusMessage),
+			})
+		}
+	}
+
+	if zone != "" {
+		err = clients.computeClient.ZoneOperations.List(clients.project, zone).Filter(filter).Pages(ctx,
+			func(page *googleComputeClient.OperationList) error {
+				appendEvents(page.Items)
+				return nil
+			})
+	} else {
+		err = clients.computeClient.GlobalOperations.AggregatedList(clients.project).Filter(filter).Pages(ctx,
+			func(page *googleComputeClient.OperationAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendEvents(page.Items[scope].Operations)
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list operations.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The timestamps of the operations are in the same format, so they are
+	// sorted as strings.
+	for _, item := range state.Items {
+		events := item.Events
+		sort.SliceStable(events, func(i, j int) bool {
+			return events[i].Time.ValueString() > events[j].Time.ValueString()
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newInstanceMaintenanceEventsItem converts the instance into queried item
+// without its events.
+func newInstanceMaintenanceEventsItem(
+	instance *googleComputeAlphaClient.Instance) *instanceMaintenanceEventsItemModel {
+	item := &instanceMaintenanceEventsItemModel{
+		ID:                types.Int64Value(int64(instance.Id)),
+		Name:              types.StringValue(instance.Name),
+		Zone:              types.StringValue(resourceNameFromSelfLink(instance.Zone)),
+		Status:            types.StringValue(instance.Status),
+		OnHostMaintenance: types.StringValue(""),
+		ProvisioningModel: types.StringValue(""),
+		Events:            []*maintenanceEventItemModel{},
+	}
+	if scheduling := instance.Scheduling; scheduling != nil {
+		item.OnHostMaintenance = types.StringValue(scheduling.OnHostMaintenance)
+		item.ProvisioningModel = types.StringValue(scheduling.ProvisioningModel)
+	}
+	if maintenance := instance.UpcomingMaintenance; maintenance != nil {
+		item.UpcomingMaintenance = &upcomingMaintenanceModel{
+			Type:            types.StringValue(maintenance.Type),
+			Status:          types.StringValue(maintenance.MaintenanceStatus),
+			CanReschedule:   types.BoolValue(maintenance.CanReschedule),
+			WindowStartTime: types.StringValue(maintenance.WindowStartTime),
+			WindowEndTime:   types.StringValue(maintenance.WindowEndTime),
+		}
+	}
+	return item
+}
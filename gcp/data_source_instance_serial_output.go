@@ -0,0 +1,200 @@
+package gcp
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const defaultSerialPort = 1
+
+var (
+	_ datasource.DataSource              = &InstanceSerialOutputDataSource{}
+	_ datasource.DataSourceWithConfigure = &InstanceSerialOutputDataSource{}
+)
+
+// NewInstanceSerialOutputDataSource
+func NewInstanceSerialOutputDataSource() datasource.DataSource {
+	return &InstanceSerialOutputDataSource{}
+}
+
+// InstanceSerialOutputDataSource
+type InstanceSerialOutputDataSource struct {
+	client *gcpClients
+}
+
+// InstanceSerialOutputDataSourceModel
+type InstanceSerialOutputDataSourceModel struct {
+	ClientConfig *clientConfig `tfsdk:"client_config"`
+	Instance     types.String  `tfsdk:"instance"`
+	Zone         types.String  `tfsdk:"zone"`
+	Port         types.Int64   `tfsdk:"port"`
+	TailLines    types.Int64   `tfsdk:"tail_lines"`
+	Pattern      types.String  `tfsdk:"pattern"`
+	AllowMissing types.Bool    `tfsdk:"allow_missing"`
+	Contents     types.String  `tfsdk:"contents"`
+	Matched      types.Bool    `tfsdk:"matched"`
+	Next         types.Int64   `tfsdk:"next"`
+}
+
+// Metadata returns the data source instance serial output type name.
+func (d *InstanceSerialOutputDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_serial_output"
+}
+
+// Schema defines the schema for the instance serial output data source.
+func (d *InstanceSerialOutputDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source fetches the serial port output of an instance on " +
+			"Google Cloud, so the bootstrap automation can assert cloud-init completed " +
+			"before the dependent resources are applied, e.g. with a postcondition. " +
+			"Only the most recent 1 MB of the output is retained by Compute Engine.",
+		Attributes: map[string]schema.Attribute{
+			"instance": schema.StringAttribute{
+				Description: "Name of instance.",
+				Required:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of instance.",
+				Required:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Serial port number, 1 to 4. Default to " +
+					strconv.Itoa(defaultSerialPort) + ".",
+				Optional: true,
+			},
+			"tail_lines": schema.Int64Attribute{
+				Description: "Number of the last lines of the output returned. Default to " +
+					"the whole output.",
+				Optional: true,
+			},
+			"pattern": schema.StringAttribute{
+				Description: "Regular expression matched against the returned output, " +
+					"e.g. \"Cloud-init v. .* finished\".",
+				Optional: true,
+			},
+			"allow_missing": allowMissingAttribute("instance"),
+			"contents": schema.StringAttribute{
+				Description: "Serial port output.",
+				Computed:    true,
+			},
+			"matched": schema.BoolAttribute{
+				Description: "Whether pattern matches the returned output, null if pattern is not set.",
+				Computed:    true,
+			},
+			"next": schema.Int64Attribute{
+				Description: "Byte offset of the end of the output, i.e. the start of the " +
+					"output written after this read.",
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *InstanceSerialOutputDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read instance serial output data source information
+func (d *InstanceSerialOutputDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *InstanceSerialOutputDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var pattern *regexp.Regexp
+	if !(plan.Pattern.IsUnknown() || plan.Pattern.IsNull()) {
+		var err error
+		pattern, err = regexp.Compile(plan.Pattern.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid pattern", err.Error())
+			return
+		}
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port := int64(defaultSerialPort)
+	if !(plan.Port.IsUnknown() || plan.Port.IsNull()) {
+		port = plan.Port.ValueInt64()
+	}
+	output, err := clients.computeClient.Instances.GetSerialPortOutput(clients.project,
+		plan.Zone.ValueString(), plan.Instance.ValueString()).Port(port).Context(ctx).Do()
+	if isMissingAllowed(plan.AllowMissing, err) {
+		output, err = &googleComputeClient.SerialPortOutput{}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get serial port output.",
+			err.Error(),
+		)
+		return
+	}
+
+	contents := output.Contents
+	if !(plan.TailLines.IsUnknown() || plan.TailLines.IsNull()) {
+		contents = tailLines(contents, int(plan.TailLines.ValueInt64()))
+	}
+
+	state := &InstanceSerialOutputDataSourceModel{
+		Instance:     plan.Instance,
+		Zone:         plan.Zone,
+		Port:         plan.Port,
+		TailLines:    plan.TailLines,
+		Pattern:      plan.Pattern,
+		AllowMissing: plan.AllowMissing,
+		Contents:     types.StringValue(contents),
+		Matched:      types.BoolNull(),
+		Next:         types.Int64Value(output.Next),
+	}
+	if pattern != nil {
+		state.Matched = types.BoolValue(pattern.MatchString(contents))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// tailLines returns the last n lines of the contents, the trailing newline of
+// the contents is not counted as an empty line.
+func tailLines(contents string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	lines := strings.SplitAfter(contents, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "")
+}
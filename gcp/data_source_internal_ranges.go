@@ -0,0 +1,255 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleNetworkConnectivityClient "google.golang.org/api/networkconnectivity/v1"
+)
+
+var (
+	_ datasource.DataSource              = &InternalRangesDataSource{}
+	_ datasource.DataSourceWithConfigure = &InternalRangesDataSource{}
+)
+
+// NewInternalRangesDataSource
+func NewInternalRangesDataSource() datasource.DataSource {
+	return &InternalRangesDataSource{}
+}
+
+// InternalRangesDataSource
+type InternalRangesDataSource struct {
+	client *gcpClients
+}
+
+// InternalRangesDataSourceModel
+type InternalRangesDataSourceModel struct {
+	ClientConfig *clientConfig              `tfsdk:"client_config"`
+	Name         types.String               `tfsdk:"name"`
+	Labels       types.Map                  `tfsdk:"labels"`
+	Network      types.String               `tfsdk:"network"`
+	Usage        types.String               `tfsdk:"usage"`
+	Items        []*internalRangesItemModel `tfsdk:"items"`
+}
+
+type internalRangesItemModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Labels       types.Map    `tfsdk:"labels"`
+	Description  types.String `tfsdk:"description"`
+	IPCidrRange  types.String `tfsdk:"ip_cidr_range"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	Network      types.String `tfsdk:"network"`
+	Usage        types.String `tfsdk:"usage"`
+	Peering      types.String `tfsdk:"peering"`
+	Overlaps     types.List   `tfsdk:"overlaps"`
+	Users        types.List   `tfsdk:"users"`
+	CreateTime   types.String `tfsdk:"create_time"`
+}
+
+// Metadata returns the data source internal ranges type name.
+func (d *InternalRangesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_ranges"
+}
+
+// Schema defines the schema for the internal ranges data source.
+func (d *InternalRangesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Network Connectivity Center internal " +
+			"ranges on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of internal range to be filtered.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of internal range to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"network": schema.StringAttribute{
+				Description: "Self link or name of the network of internal range to be filtered.",
+				Optional:    true,
+			},
+			"usage": schema.StringAttribute{
+				Description: "Usage of internal range to be filtered, valid values are " +
+					"FOR_VPC and EXTERNAL_TO_VPC.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried internal ranges.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of internal range.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of internal range.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of internal range.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of internal range.",
+							Computed:    true,
+						},
+						"ip_cidr_range": schema.StringAttribute{
+							Description: "IP CIDR range of internal range.",
+							Computed:    true,
+						},
+						"prefix_length": schema.Int64Attribute{
+							Description: "Prefix length of internal range.",
+							Computed:    true,
+						},
+						"network": schema.StringAttribute{
+							Description: "Network of internal range.",
+							Computed:    true,
+						},
+						"usage": schema.StringAttribute{
+							Description: "Usage of internal range.",
+							Computed:    true,
+						},
+						"peering": schema.StringAttribute{
+							Description: "Peering type of internal range, e.g. FOR_SELF or FOR_PEER.",
+							Computed:    true,
+						},
+						"overlaps": schema.ListAttribute{
+							Description: "Types of the resources allowed to overlap with internal range.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"users": schema.ListAttribute{
+							Description: "Resources using internal range.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"create_time": schema.StringAttribute{
+							Description: "Creation time of internal range.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *InternalRangesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read internal ranges data source information
+func (d *InternalRangesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *InternalRangesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	state := &InternalRangesDataSourceModel{
+		Name:    plan.Name,
+		Labels:  plan.Labels,
+		Network: plan.Network,
+		Usage:   plan.Usage,
+		Items:   []*internalRangesItemModel{},
+	}
+
+	parent := networkConnectivityParent(clients.project, globalScope)
+	err = networkConnectivityClient.Projects.Locations.InternalRanges.List(parent).
+		Pages(ctx, func(page *googleNetworkConnectivityClient.ListInternalRangesResponse) error {
+			for _, internalRange := range page.InternalRanges {
+				if !(plan.Name.IsUnknown() || plan.Name.IsNull()) &&
+					plan.Name.ValueString() != resourceNameFromSelfLink(internalRange.Name) {
+					continue
+				}
+				if !(plan.Network.IsUnknown() || plan.Network.IsNull()) &&
+					!matchResourceReference(internalRange.Network, plan.Network.ValueString()) {
+					continue
+				}
+				if !(plan.Usage.IsUnknown() || plan.Usage.IsNull()) && plan.Usage.ValueString() != internalRange.Usage {
+					continue
+				}
+				labels, labelsTfType := labelsValue(internalRange.Labels)
+				if !matchTags(plan.Labels, labels) {
+					continue
+				}
+				state.Items = append(state.Items, newInternalRangesItem(internalRange, labelsTfType))
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list internal ranges.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newInternalRangesItem converts the internal range into queried item.
+func newInternalRangesItem(internalRange *googleNetworkConnectivityClient.InternalRange,
+	labels types.Map) *internalRangesItemModel {
+	overlaps := []attr.Value{}
+	for _, overlap := range internalRange.Overlaps {
+		overlaps = append(overlaps, types.StringValue(overlap))
+	}
+	users := []attr.Value{}
+	for _, user := range internalRange.Users {
+		users = append(users, types.StringValue(user))
+	}
+
+	return &internalRangesItemModel{
+		ID:           types.StringValue(internalRange.Name),
+		Name:         types.StringValue(resourceNameFromSelfLink(internalRange.Name)),
+		Labels:       labels,
+		Description:  types.StringValue(internalRange.Description),
+		IPCidrRange:  types.StringValue(internalRange.IpCidrRange),
+		PrefixLength: types.Int64Value(internalRange.PrefixLength),
+		Network:      types.StringValue(internalRange.Network),
+		Usage:        types.StringValue(internalRange.Usage),
+		Peering:      types.StringValue(internalRange.Peering),
+		Overlaps:     types.ListValueMust(types.StringType, overlaps),
+		Users:        types.ListValueMust(types.StringType, users),
+		CreateTime:   types.StringValue(internalRange.CreateTime),
+	}
+}
@@ -0,0 +1,361 @@
+package gcp
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const (
+	ipLookupResourceAddress        = "address"
+	ipLookupResourceForwardingRule = "forwarding_rule"
+	ipLookupResourceInstance       = "instance"
+)
+
+var (
+	_ datasource.DataSource              = &IPLookupDataSource{}
+	_ datasource.DataSourceWithConfigure = &IPLookupDataSource{}
+)
+
+// NewIPLookupDataSource
+func NewIPLookupDataSource() datasource.DataSource {
+	return &IPLookupDataSource{}
+}
+
+// IPLookupDataSource
+type IPLookupDataSource struct {
+	client *gcpClients
+}
+
+// IPLookupDataSourceModel
+type IPLookupDataSourceModel struct {
+	ClientConfig *clientConfig        `tfsdk:"client_config"`
+	IPAddress    types.String         `tfsdk:"ip_address"`
+	Found        types.Bool           `tfsdk:"found"`
+	External     types.Bool           `tfsdk:"external"`
+	Items        []*ipLookupItemModel `tfsdk:"items"`
+}
+
+type ipLookupItemModel struct {
+	ResourceType     types.String `tfsdk:"resource_type"`
+	Name             types.String `tfsdk:"name"`
+	SelfLink         types.String `tfsdk:"self_link"`
+	Location         types.String `tfsdk:"location"`
+	External         types.Bool   `tfsdk:"external"`
+	NetworkInterface types.String `tfsdk:"network_interface"`
+	Users            types.List   `tfsdk:"users"`
+}
+
+// Metadata returns the data source IP lookup type name.
+func (d *IPLookupDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_lookup"
+}
+
+// Schema defines the schema for the IP lookup data source.
+func (d *IPLookupDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source looks up the resources owning an IP address in " +
+			"the project on Google Cloud by searching the addresses, the forwarding " +
+			"rules and the instances, e.g. for the incident response runbooks.",
+		Attributes: map[string]schema.Attribute{
+			"ip_address": schema.StringAttribute{
+				Description: "IPv4 or IPv6 address to be looked up.",
+				Required:    true,
+			},
+			"found": schema.BoolAttribute{
+				Description: "Whether any resource owns the IP address.",
+				Computed:    true,
+			},
+			"external": schema.BoolAttribute{
+				Description: "Whether the IP address is external to any resource owning it.",
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of the resources owning the IP address. An address " +
+					"reserved for a forwarding rule or an instance is listed together " +
+					"with its user.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Description: "Type of resource, " + ipLookupResourceAddress + ", " +
+								ipLookupResourceForwardingRule + " or " + ipLookupResourceInstance + ".",
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of resource.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of resource.",
+							Computed:    true,
+						},
+						"location": schema.StringAttribute{
+							Description: "Region or zone of resource, " + globalScope +
+								" for the global resources.",
+							Computed: true,
+						},
+						"external": schema.BoolAttribute{
+							Description: "Whether the IP address is external to resource.",
+							Computed:    true,
+						},
+						"network_interface": schema.StringAttribute{
+							Description: "Name of the network interface of instance using " +
+								"the IP address, e.g. nic0, empty if resource is not an instance.",
+							Computed: true,
+						},
+						"users": schema.ListAttribute{
+							Description: "Self links of the resources using the address, " +
+								"empty if resource is not an address.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IPLookupDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read IP lookup data source information
+func (d *IPLookupDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *IPLookupDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip := net.ParseIP(plan.IPAddress.ValueString())
+	if ip == nil {
+		resp.Diagnostics.AddError(
+			"Invalid ip_address",
+			plan.IPAddress.ValueString()+" is not a valid IPv4 or IPv6 address.",
+		)
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &IPLookupDataSourceModel{
+		IPAddress: plan.IPAddress,
+		Items:     []*ipLookupItemModel{},
+	}
+
+	c := clients.computeClient
+	appendAddresses := func(addresses []*googleComputeClient.Address) {
+		for _, address := range addresses {
+			if matchIPAddress(ip, address.Address, address.PrefixLength) {
+				state.Items = append(state.Items, newIPLookupAddressItem(address))
+			}
+		}
+	}
+	err := c.GlobalAddresses.List(clients.project).Pages(ctx,
+		func(page *googleComputeClient.AddressList) error {
+			appendAddresses(page.Items)
+			return nil
+		})
+	if err == nil {
+		err = c.Addresses.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.AddressAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					if scope != globalScope {
+						appendAddresses(page.Items[scope].Addresses)
+					}
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list addresses.",
+			err.Error(),
+		)
+		return
+	}
+
+	appendForwardingRules := func(rules []*googleComputeClient.ForwardingRule) {
+		for _, rule := range rules {
+			if matchIPAddress(ip, rule.IPAddress, 0) {
+				state.Items = append(state.Items, newIPLookupForwardingRuleItem(rule))
+			}
+		}
+	}
+	err = c.GlobalForwardingRules.List(clients.project).Pages(ctx,
+		func(page *googleComputeClient.ForwardingRuleList) error {
+			appendForwardingRules(page.Items)
+			return nil
+		})
+	if err == nil {
+		err = c.ForwardingRules.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.ForwardingRuleAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					if scope != globalScope {
+						appendForwardingRules(page.Items[scope].ForwardingRules)
+					}
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list forwarding rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	err = c.Instances.AggregatedList(clients.project).Pages(ctx,
+		func(page *googleComputeClient.InstanceAggregatedList) error {
+			for _, scope := range sortedKeys(page.Items) {
+				for _, instance := range page.Items[scope].Instances {
+					state.Items = append(state.Items, newIPLookupInstanceItems(ip, instance)...)
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list instances.",
+			err.Error(),
+		)
+		return
+	}
+
+	external := false
+	for _, item := range state.Items {
+		external = external || item.External.ValueBool()
+	}
+	state.Found = types.BoolValue(len(state.Items) > 0)
+	state.External = types.BoolValue(external)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// matchIPAddress returns whether the address is the IP, or the range of the
+// address with the prefix length contains the IP if the prefix length is set.
+func matchIPAddress(ip net.IP, address string, prefixLength int64) bool {
+	if address == "" {
+		return false
+	}
+	if prefixLength > 0 {
+		_, ipNet, err := net.ParseCIDR(address + "/" + strconv.FormatInt(prefixLength, 10))
+		return err == nil && ipNet.Contains(ip)
+	}
+	if strings.Contains(address, "/") {
+		_, ipNet, err := net.ParseCIDR(address)
+		return err == nil && ipNet.Contains(ip)
+	}
+	return ip.Equal(net.ParseIP(address))
+}
+
+// ipLookupLocation returns the name of the region or the zone of resource,
+// or global if resource has neither.
+func ipLookupLocation(region, zone string) string {
+	switch {
+	case zone != "":
+		return resourceNameFromSelfLink(zone)
+	case region != "":
+		return resourceNameFromSelfLink(region)
+	default:
+		return globalScope
+	}
+}
+
+// newIPLookupAddressItem converts the address owning the IP into queried item.
+func newIPLookupAddressItem(address *googleComputeClient.Address) *ipLookupItemModel {
+	users := []attr.Value{}
+	for _, user := range address.Users {
+		users = append(users, types.StringValue(user))
+	}
+
+	return &ipLookupItemModel{
+		ResourceType:     types.StringValue(ipLookupResourceAddress),
+		Name:             types.StringValue(address.Name),
+		SelfLink:         types.StringValue(address.SelfLink),
+		Location:         types.StringValue(ipLookupLocation(address.Region, "")),
+		External:         types.BoolValue(address.AddressType == "EXTERNAL"),
+		NetworkInterface: types.StringValue(""),
+		Users:            types.ListValueMust(types.StringType, users),
+	}
+}
+
+// newIPLookupForwardingRuleItem converts the forwarding rule owning the IP
+// into queried item.
+func newIPLookupForwardingRuleItem(rule *googleComputeClient.ForwardingRule) *ipLookupItemModel {
+	return &ipLookupItemModel{
+		ResourceType:     types.StringValue(ipLookupResourceForwardingRule),
+		Name:             types.StringValue(rule.Name),
+		SelfLink:         types.StringValue(rule.SelfLink),
+		Location:         types.StringValue(ipLookupLocation(rule.Region, "")),
+		External:         types.BoolValue(strings.HasPrefix(rule.LoadBalancingScheme, "EXTERNAL")),
+		NetworkInterface: types.StringValue(""),
+		Users:            types.ListValueMust(types.StringType, []attr.Value{}),
+	}
+}
+
+// newIPLookupInstanceItems converts the network interfaces of instance using
+// the IP into queried items, either internally or externally.
+func newIPLookupInstanceItems(ip net.IP, instance *googleComputeClient.Instance) []*ipLookupItemModel {
+	items := []*ipLookupItemModel{}
+	for _, networkInterface := range instance.NetworkInterfaces {
+		internal := matchIPAddress(ip, networkInterface.NetworkIP, 0) ||
+			matchIPAddress(ip, networkInterface.Ipv6Address, 0)
+		for _, aliasIPRange := range networkInterface.AliasIpRanges {
+			internal = internal || matchIPAddress(ip, aliasIPRange.IpCidrRange, 0)
+		}
+		external := false
+		for _, accessConfig := range networkInterface.AccessConfigs {
+			external = external || matchIPAddress(ip, accessConfig.NatIP, 0)
+		}
+		for _, accessConfig := range networkInterface.Ipv6AccessConfigs {
+			external = external ||
+				matchIPAddress(ip, accessConfig.ExternalIpv6, accessConfig.ExternalIpv6PrefixLength)
+		}
+		if !internal && !external {
+			continue
+		}
+		items = append(items, &ipLookupItemModel{
+			ResourceType:     types.StringValue(ipLookupResourceInstance),
+			Name:             types.StringValue(instance.Name),
+			SelfLink:         types.StringValue(instance.SelfLink),
+			Location:         types.StringValue(ipLookupLocation("", instance.Zone)),
+			External:         types.BoolValue(external),
+			NetworkInterface: types.StringValue(networkInterface.Name),
+			Users:            types.ListValueMust(types.StringType, []attr.Value{}),
+		})
+	}
+	return items
+}
@@ -0,0 +1,269 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleKmsClient "google.golang.org/api/cloudkms/v1"
+)
+
+var (
+	_ datasource.DataSource              = &KmsKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &KmsKeysDataSource{}
+)
+
+// NewKmsKeysDataSource
+func NewKmsKeysDataSource() datasource.DataSource {
+	return &KmsKeysDataSource{}
+}
+
+// KmsKeysDataSource
+type KmsKeysDataSource struct {
+	client *gcpClients
+}
+
+// KmsKeysDataSourceModel
+type KmsKeysDataSourceModel struct {
+	ClientConfig *clientConfig       `tfsdk:"client_config"`
+	Location     types.String        `tfsdk:"location"`
+	KeyRing      types.String        `tfsdk:"key_ring"`
+	Purpose      types.String        `tfsdk:"purpose"`
+	Labels       types.Map           `tfsdk:"labels"`
+	Items        []*kmsKeysItemModel `tfsdk:"items"`
+}
+
+type kmsKeysItemModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	KeyRing             types.String `tfsdk:"key_ring"`
+	Labels              types.Map    `tfsdk:"labels"`
+	Purpose             types.String `tfsdk:"purpose"`
+	Algorithm           types.String `tfsdk:"algorithm"`
+	ProtectionLevel     types.String `tfsdk:"protection_level"`
+	RotationPeriod      types.String `tfsdk:"rotation_period"`
+	NextRotationTime    types.String `tfsdk:"next_rotation_time"`
+	PrimaryVersion      types.String `tfsdk:"primary_version"`
+	PrimaryVersionState types.String `tfsdk:"primary_version_state"`
+	CreateTime          types.String `tfsdk:"create_time"`
+}
+
+// Metadata returns the data source KMS keys type name.
+func (d *KmsKeysDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_keys"
+}
+
+// Schema defines the schema for the KMS keys data source.
+func (d *KmsKeysDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Cloud KMS crypto keys of the key rings " +
+			"in a location on Google Cloud, e.g. to discover the CMEK keys owned by another team.",
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				Description: "Location of key rings, e.g. global or asia-east1.",
+				Required:    true,
+			},
+			"key_ring": schema.StringAttribute{
+				Description: "Name of key ring to be filtered.",
+				Optional:    true,
+			},
+			"purpose": schema.StringAttribute{
+				Description: "Purpose of crypto key to be filtered, e.g. ENCRYPT_DECRYPT, " +
+					"ASYMMETRIC_SIGN, ASYMMETRIC_DECRYPT or MAC.",
+				Optional: true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of crypto key to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried crypto keys.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of crypto key, used as the CMEK key name.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of crypto key.",
+							Computed:    true,
+						},
+						"key_ring": schema.StringAttribute{
+							Description: "Name of key ring.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of crypto key.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"purpose": schema.StringAttribute{
+							Description: "Purpose of crypto key.",
+							Computed:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "Algorithm of new crypto key versions, e.g. GOOGLE_SYMMETRIC_ENCRYPTION.",
+							Computed:    true,
+						},
+						"protection_level": schema.StringAttribute{
+							Description: "Protection level of new crypto key versions, e.g. SOFTWARE or HSM.",
+							Computed:    true,
+						},
+						"rotation_period": schema.StringAttribute{
+							Description: "Rotation period of crypto key, e.g. 7776000s. Empty if " +
+								"crypto key is not rotated automatically.",
+							Computed: true,
+						},
+						"next_rotation_time": schema.StringAttribute{
+							Description: "Time of next automatic rotation.",
+							Computed:    true,
+						},
+						"primary_version": schema.StringAttribute{
+							Description: "Resource name of primary crypto key version. Empty for " +
+								"the crypto keys without primary version, e.g. asymmetric keys.",
+							Computed: true,
+						},
+						"primary_version_state": schema.StringAttribute{
+							Description: "State of primary crypto key version, e.g. ENABLED, " +
+								"DISABLED or DESTROY_SCHEDULED.",
+							Computed: true,
+						},
+						"create_time": schema.StringAttribute{
+							Description: "Creation time of crypto key.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *KmsKeysDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read KMS keys data source information
+func (d *KmsKeysDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *KmsKeysDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kmsClient, err := googleKmsClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud KMS client", err.Error())
+		return
+	}
+
+	keyRings := []string{}
+	parent := fmt.Sprintf("projects/%s/locations/%s", clients.project, plan.Location.ValueString())
+	if !(plan.KeyRing.IsUnknown() || plan.KeyRing.IsNull()) {
+		keyRings = append(keyRings, parent+"/keyRings/"+plan.KeyRing.ValueString())
+	} else {
+		err = kmsClient.Projects.Locations.KeyRings.List(parent).Pages(ctx,
+			func(page *googleKmsClient.ListKeyRingsResponse) error {
+				for _, keyRing := range page.KeyRings {
+					keyRings = append(keyRings, keyRing.Name)
+				}
+				return nil
+			})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to list key rings.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &KmsKeysDataSourceModel{
+		Location: plan.Location,
+		KeyRing:  plan.KeyRing,
+		Purpose:  plan.Purpose,
+		Labels:   plan.Labels,
+		Items:    []*kmsKeysItemModel{},
+	}
+
+	for _, keyRing := range keyRings {
+		err = kmsClient.Projects.Locations.KeyRings.CryptoKeys.List(keyRing).Pages(ctx,
+			func(page *googleKmsClient.ListCryptoKeysResponse) error {
+				for _, cryptoKey := range page.CryptoKeys {
+					if !(plan.Purpose.IsUnknown() || plan.Purpose.IsNull()) && plan.Purpose.ValueString() != cryptoKey.Purpose {
+						continue
+					}
+					labels, labelsTfType := labelsValue(cryptoKey.Labels)
+					if !matchTags(plan.Labels, labels) {
+						continue
+					}
+					state.Items = append(state.Items, newKmsKeysItem(cryptoKey, keyRing, labelsTfType))
+				}
+				return nil
+			})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to list crypto keys.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newKmsKeysItem converts the crypto key into queried item.
+func newKmsKeysItem(cryptoKey *googleKmsClient.CryptoKey, keyRing string, labels types.Map) *kmsKeysItemModel {
+	item := &kmsKeysItemModel{
+		ID:                  types.StringValue(cryptoKey.Name),
+		Name:                types.StringValue(resourceNameFromSelfLink(cryptoKey.Name)),
+		KeyRing:             types.StringValue(resourceNameFromSelfLink(keyRing)),
+		Labels:              labels,
+		Purpose:             types.StringValue(cryptoKey.Purpose),
+		Algorithm:           types.StringValue(""),
+		ProtectionLevel:     types.StringValue(""),
+		RotationPeriod:      types.StringValue(cryptoKey.RotationPeriod),
+		NextRotationTime:    types.StringValue(cryptoKey.NextRotationTime),
+		PrimaryVersion:      types.StringValue(""),
+		PrimaryVersionState: types.StringValue(""),
+		CreateTime:          types.StringValue(cryptoKey.CreateTime),
+	}
+	if cryptoKey.VersionTemplate != nil {
+		item.Algorithm = types.StringValue(cryptoKey.VersionTemplate.Algorithm)
+		item.ProtectionLevel = types.StringValue(cryptoKey.VersionTemplate.ProtectionLevel)
+	}
+	if cryptoKey.Primary != nil {
+		item.PrimaryVersion = types.StringValue(cryptoKey.Primary.Name)
+		item.PrimaryVersionState = types.StringValue(cryptoKey.Primary.State)
+	}
+	return item
+}
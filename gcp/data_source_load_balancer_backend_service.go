@@ -0,0 +1,169 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &LbBackendServiceDataSource{}
+	_ datasource.DataSourceWithConfigure = &LbBackendServiceDataSource{}
+)
+
+// NewLbBackendServiceDataSource
+func NewLbBackendServiceDataSource() datasource.DataSource {
+	return &LbBackendServiceDataSource{}
+}
+
+// LbBackendServiceDataSource
+type LbBackendServiceDataSource struct {
+	client *gcpClients
+}
+
+// LbBackendServiceDataSourceModel
+type LbBackendServiceDataSourceModel struct {
+	ClientConfig *clientConfig               `tfsdk:"client_config"`
+	Name         types.String                `tfsdk:"name"`
+	Tags         types.Map                   `tfsdk:"tags"`
+	Strict       types.Bool                  `tfsdk:"strict"`
+	Found        types.Bool                  `tfsdk:"found"`
+	Item         *lbBackendServicesItemModel `tfsdk:"item"`
+}
+
+// Metadata returns the data source backend service type name.
+func (d *LbBackendServiceDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_backend_service"
+}
+
+// Schema defines the schema for the backend service data source.
+func (d *LbBackendServiceDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides a single load balancer backend service on " +
+			"Google Cloud matched by name or tags, and fails if zero or multiple backend " +
+			"services are matched.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of backend service to be matched. At least one of name " +
+					"and tags must be set.",
+				Optional: true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of backend service to be matched. At least one of name " +
+					"and tags must be set.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"strict": schema.BoolAttribute{
+				Description: "Whether to fail if no backend service is matched. If false, " +
+					"found is false and item is null instead. Multiple matched backend " +
+					"services always fail. Default to true.",
+				Optional: true,
+			},
+			"found": schema.BoolAttribute{
+				Description: "Whether a backend service is matched.",
+				Computed:    true,
+			},
+			"item": schema.SingleNestedAttribute{
+				Description: "Matched load balancer backend service.",
+				Computed:    true,
+				Attributes:  lbBackendServicesItemObject().Attributes,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *LbBackendServiceDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read backend service data source information
+func (d *LbBackendServiceDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *LbBackendServiceDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.IsNull() && plan.Tags.IsNull() {
+		resp.Diagnostics.AddError("Missing filter", "At least one of name and tags must be set.")
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The backend services are matched in the same way as the
+	// st-gcp_load_balancer_backend_services data source.
+	matched := &LbBackendServicesDataSourceModel{
+		Items:    []*lbBackendServicesItemModel{},
+		ItemsMap: map[string]*lbBackendServicesItemModel{},
+	}
+	err := (&LbBackendServicesDataSource{}).runBackendServices(ctx, clients, resp,
+		&LbBackendServicesDataSourceModel{
+			Name:       plan.Name,
+			Tags:       plan.Tags,
+			PageToken:  types.StringNull(),
+			MaxResults: types.Int64Null(),
+		}, matched)
+	if err != nil {
+		return
+	}
+
+	switch {
+	case len(matched.Items) > 1:
+		names := []string{}
+		for _, item := range matched.Items {
+			names = append(names, item.Name.ValueString())
+		}
+		resp.Diagnostics.AddError(
+			"Multiple backend services matched",
+			fmt.Sprintf("Exactly one backend service must be matched, but %d are matched: %s.",
+				len(names), strings.Join(names, ", ")),
+		)
+		return
+	case len(matched.Items) == 0 && (plan.Strict.IsNull() || plan.Strict.ValueBool()):
+		resp.Diagnostics.AddError(
+			"No backend service matched",
+			"Exactly one backend service must be matched, but none is matched. Set strict "+
+				"to false to allow no backend service to be matched.",
+		)
+		return
+	}
+
+	state := &LbBackendServiceDataSourceModel{
+		Name:   plan.Name,
+		Tags:   plan.Tags,
+		Strict: plan.Strict,
+		Found:  types.BoolValue(len(matched.Items) == 1),
+	}
+	if len(matched.Items) == 1 {
+		state.Item = matched.Items[0]
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
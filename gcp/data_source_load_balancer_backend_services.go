@@ -3,16 +3,12 @@ package gcp
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	googleComputeClient "google.golang.org/api/compute/v1"
-	"google.golang.org/api/option"
 )
 
 var (
@@ -27,26 +23,32 @@ func NewLbBackendServicesDataSource() datasource.DataSource {
 
 // LbBackendServicesDataSource
 type LbBackendServicesDataSource struct {
-	project string
-	client  *googleComputeClient.Service
+	client *gcpClients
 }
 
 // LbBackendServicesDataSourceModel
 type LbBackendServicesDataSourceModel struct {
-	ClientConfig *clientConfig                 `tfsdk:"client_config"`
-	Name         types.String                  `tfsdk:"name"`
-	Tags         types.Map                     `tfsdk:"tags"`
-	Items        []*lbBackendServicesItemModel `tfsdk:"items"`
+	ClientConfig  *clientConfig                          `tfsdk:"client_config"`
+	Name          types.String                           `tfsdk:"name"`
+	Tags          types.Map                              `tfsdk:"tags"`
+	PageToken     types.String                           `tfsdk:"page_token"`
+	MaxResults    types.Int64                            `tfsdk:"max_results"`
+	NextPageToken types.String                           `tfsdk:"next_page_token"`
+	Items         []*lbBackendServicesItemModel          `tfsdk:"items"`
+	ItemsMap      map[string]*lbBackendServicesItemModel `tfsdk:"items_map"`
 }
 
 type lbBackendServicesItemModel struct {
-	ID   types.Int64 `tfsdk:"id"`
-	Tags types.Map   `tfsdk:"tags"`
+	ID                           types.Int64                     `tfsdk:"id"`
+	Name                         types.String                    `tfsdk:"name"`
+	Tags                         types.Map                       `tfsdk:"tags"`
+	LogConfig                    *lbBackendServiceLogConfigModel `tfsdk:"log_config"`
+	ConnectionDrainingTimeoutSec types.Int64                     `tfsdk:"connection_draining_timeout_sec"`
 }
 
-type clientConfig struct {
-	Project     types.String `tfsdk:"project"`
-	Credentials types.String `tfsdk:"credentials"`
+type lbBackendServiceLogConfigModel struct {
+	Enable     types.Bool    `tfsdk:"enable"`
+	SampleRate types.Float64 `tfsdk:"sample_rate"`
 }
 
 // Metadata returns the data source backend services type name.
@@ -70,42 +72,76 @@ func (d *LbBackendServicesDataSource) Schema(_ context.Context,
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"page_token": schema.StringAttribute{
+				Description: "Page token returned in next_page_token of previous query " +
+					"to resume listing from. Only one page of backend services is " +
+					"listed if page_token or max_results is set.",
+				Optional: true,
+			},
+			"max_results": schema.Int64Attribute{
+				Description: "Maximum number of backend services listed in one page, " +
+					"before name and tags filtering.",
+				Optional: true,
+			},
+			"next_page_token": schema.StringAttribute{
+				Description: "Page token to list the next page of backend services, " +
+					"empty if there are no more pages or all pages are listed.",
+				Computed: true,
+			},
 			"items": schema.ListNestedAttribute{
-				Description: "List of queried load balancer backend services.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.Int64Attribute{
-							Description: "ID of backend service.",
-							Computed:    true,
-						},
-						"tags": schema.MapAttribute{
-							Description: "Tags of backend service.",
-							ElementType: types.StringType,
-							Computed:    true,
-						},
-					},
-				},
+				Description:  "List of queried load balancer backend services.",
+				Computed:     true,
+				NestedObject: lbBackendServicesItemObject(),
+			},
+			"items_map": schema.MapNestedAttribute{
+				Description: "Map of queried load balancer backend services, keyed by " +
+					"backend service name.",
+				Computed:     true,
+				NestedObject: lbBackendServicesItemObject(),
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"client_config": schema.SingleNestedBlock{
-				Description: "Config to override default client created in Provider. " +
-					"This block will not be recorded in state file.",
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// lbBackendServicesItemObject returns the nested object schema of queried
+// backend service, shared by items and items_map.
+func lbBackendServicesItemObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "ID of backend service.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of backend service.",
+				Computed:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of backend service.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"log_config": schema.SingleNestedAttribute{
+				Description: "Logging config of backend service.",
+				Computed:    true,
 				Attributes: map[string]schema.Attribute{
-					"project": schema.StringAttribute{
-						Description: "Project Name for Google Cloud API. Default " +
-							"to use project configured in the provider.",
-						Optional: true,
+					"enable": schema.BoolAttribute{
+						Description: "Whether logging is enabled.",
+						Computed:    true,
 					},
-					"credentials": schema.StringAttribute{
-						Description: "The credentials of service account in JSON format " +
-							" Default to use credentials configured in the provider.",
-						Optional:  true,
-						Sensitive: true,
+					"sample_rate": schema.Float64Attribute{
+						Description: "Sampling rate of requests, between 0.0 and 1.0.",
+						Computed:    true,
 					},
 				},
 			},
+			"connection_draining_timeout_sec": schema.Int64Attribute{
+				Description: "Time in seconds for which instance will be drained.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -117,8 +153,7 @@ func (d *LbBackendServicesDataSource) Configure(_ context.Context,
 		return
 	}
 
-	d.project = req.ProviderData.(*gcpClients).project
-	d.client = req.ProviderData.(*gcpClients).computeClient
+	d.client = req.ProviderData.(*gcpClients)
 }
 
 // Read backend services data source information
@@ -131,27 +166,16 @@ func (d *LbBackendServicesDataSource) Read(ctx context.Context,
 		return
 	}
 
-	if plan.ClientConfig == nil {
-		plan.ClientConfig = &clientConfig{}
-	}
-
-	initClient := false
-	project := plan.ClientConfig.Project.ValueString()
-	credentials := plan.ClientConfig.Credentials.ValueString()
-	if project != "" || credentials != "" {
-		initClient = true
-	}
-
-	if initClient {
-		err := d.initClient(ctx, project, credentials, resp)
-		if err != nil {
-			return
-		}
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Initialize input into state
 	state := &LbBackendServicesDataSourceModel{}
 	state.Items = []*lbBackendServicesItemModel{}
+	state.ItemsMap = map[string]*lbBackendServicesItemModel{}
 
 	// Get list of backend services
 	// if backendService.Description != "" {
@@ -166,13 +190,15 @@ func (d *LbBackendServicesDataSource) Read(ctx context.Context,
 	// If the key is not found or the tag value is not matched,
 	// then break the checking and continue to next backend service.
 	// }
-	err := d.runBackendServices(ctx, resp, plan, state)
+	err := d.runBackendServices(ctx, clients, resp, plan, state)
 	if err != nil {
 		return
 	}
 
 	state.Name = plan.Name
 	state.Tags = plan.Tags
+	state.PageToken = plan.PageToken
+	state.MaxResults = plan.MaxResults
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -182,64 +208,72 @@ func (d *LbBackendServicesDataSource) Read(ctx context.Context,
 }
 
 func (d *LbBackendServicesDataSource) runBackendServices(ctx context.Context,
-	resp *datasource.ReadResponse, plan *LbBackendServicesDataSourceModel,
+	clients *gcpClients, resp *datasource.ReadResponse,
+	plan *LbBackendServicesDataSourceModel,
 	state *LbBackendServicesDataSourceModel) error {
-	responseByList := d.client.BackendServices.List(d.project)
-	if err := responseByList.Pages(
-		ctx,
-		func(page *googleComputeClient.BackendServiceList) error {
-			for _, backendService := range page.Items {
-
-				slbTags := make(map[string]attr.Value)
-				slbTagsTfType := types.MapNull(types.StringType)
-
-				if backendService.Description != "" {
-					tags := strings.Split(backendService.Description, "|")
-					for _, tag := range tags {
-						t := strings.Split(tag, ":")
-						slbTags[t[0]] = types.StringValue(t[1])
-					}
+	appendPage := func(page *googleComputeClient.BackendServiceList) error {
+		for _, backendService := range page.Items {
 
-					var convertMapDiags diag.Diagnostics
-					slbTagsTfType, convertMapDiags = types.MapValue(types.StringType, slbTags)
-					resp.Diagnostics.Append(convertMapDiags...)
-					if resp.Diagnostics.HasError() {
-						return fmt.Errorf("[INTERNAL ERROR] Failed to convert description to tags")
-					}
-				}
+			slbTags, slbTagsTfType, convertMapDiags := descriptionTags(backendService.Description)
+			resp.Diagnostics.Append(convertMapDiags...)
+			if resp.Diagnostics.HasError() {
+				return fmt.Errorf("[INTERNAL ERROR] Failed to convert description to tags")
+			}
 
-				serviceItem := &lbBackendServicesItemModel{
-					ID:   types.Int64Value(int64(backendService.Id)),
-					Tags: slbTagsTfType,
-				}
+			serviceItem := newLbBackendServicesItem(backendService, slbTagsTfType)
 
-				if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != backendService.Name {
-					continue
-				}
+			if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != backendService.Name {
+				continue
+			}
 
-				if !(plan.Tags.IsUnknown() || plan.Tags.IsNull()) {
+			if !(plan.Tags.IsUnknown() || plan.Tags.IsNull()) {
 
-					matched := true
-					goInputMap := plan.Tags.Elements()
-					for inputKey, inputValue := range goInputMap {
-						value, ok := slbTags[inputKey]
+				matched := true
+				goInputMap := plan.Tags.Elements()
+				for inputKey, inputValue := range goInputMap {
+					value, ok := slbTags[inputKey]
 
-						if !ok || value != inputValue {
-							matched = false
-							break
-						}
-					}
-					if !matched {
-						continue
+					if !ok || value != inputValue {
+						matched = false
+						break
 					}
 				}
-
-				state.Items = append(state.Items, serviceItem)
+				if !matched {
+					continue
+				}
 			}
 
-			return nil
-		},
-	); err != nil {
+			state.Items = append(state.Items, serviceItem)
+			state.ItemsMap[backendService.Name] = serviceItem
+		}
+
+		return nil
+	}
+
+	var err error
+	state.NextPageToken = types.StringValue("")
+	responseByList := clients.computeClient.BackendServices.List(clients.project)
+	if !(plan.PageToken.IsUnknown() || plan.PageToken.IsNull()) ||
+		!(plan.MaxResults.IsUnknown() || plan.MaxResults.IsNull()) {
+		// List a single page only, so that the listing can be resumed from
+		// the next page token.
+		if plan.PageToken.ValueString() != "" {
+			responseByList = responseByList.PageToken(plan.PageToken.ValueString())
+		}
+		if plan.MaxResults.ValueInt64() > 0 {
+			responseByList = responseByList.MaxResults(plan.MaxResults.ValueInt64())
+		}
+
+		var page *googleComputeClient.BackendServiceList
+		page, err = responseByList.Context(ctx).Do()
+		if err == nil {
+			err = appendPage(page)
+			state.NextPageToken = types.StringValue(page.NextPageToken)
+		}
+	} else {
+		err = responseByList.Pages(ctx, appendPage)
+	}
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to list load balancer backend services.",
 			err.Error(),
@@ -249,23 +283,26 @@ func (d *LbBackendServicesDataSource) runBackendServices(ctx context.Context,
 	return nil
 }
 
-func (d *LbBackendServicesDataSource) initClient(ctx context.Context,
-	project string, credentials string, resp *datasource.ReadResponse) error {
-	if project != "" {
-		d.project = project
+// newLbBackendServicesItem converts the backend service into queried item.
+func newLbBackendServicesItem(backendService *googleComputeClient.BackendService,
+	tags types.Map) *lbBackendServicesItemModel {
+	serviceItem := &lbBackendServicesItemModel{
+		ID:   types.Int64Value(int64(backendService.Id)),
+		Name: types.StringValue(backendService.Name),
+		Tags: tags,
+		LogConfig: &lbBackendServiceLogConfigModel{
+			Enable:     types.BoolValue(false),
+			SampleRate: types.Float64Value(0),
+		},
+		ConnectionDrainingTimeoutSec: types.Int64Value(0),
 	}
-	if credentials != "" {
-		googleClientOption := option.WithCredentialsJSON([]byte(credentials))
-		var err error
-		d.client, err = googleComputeClient.NewService(ctx, googleClientOption)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"[API ERROR] Failed to Reinitialize Google Cloud client",
-				"Please make sure the credentials is valid.\n"+
-					"Additional error message: "+err.Error(),
-			)
-			return err
-		}
+	if backendService.LogConfig != nil {
+		serviceItem.LogConfig.Enable = types.BoolValue(backendService.LogConfig.Enable)
+		serviceItem.LogConfig.SampleRate = types.Float64Value(backendService.LogConfig.SampleRate)
 	}
-	return nil
+	if backendService.ConnectionDraining != nil {
+		serviceItem.ConnectionDrainingTimeoutSec = types.Int64Value(
+			backendService.ConnectionDraining.DrainingTimeoutSec)
+	}
+	return serviceItem
 }
@@ -0,0 +1,801 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &LbChainDataSource{}
+	_ datasource.DataSourceWithConfigure = &LbChainDataSource{}
+)
+
+// NewLbChainDataSource
+func NewLbChainDataSource() datasource.DataSource {
+	return &LbChainDataSource{}
+}
+
+// LbChainDataSource
+type LbChainDataSource struct {
+	client *gcpClients
+}
+
+// LbChainDataSourceModel
+type LbChainDataSourceModel struct {
+	ClientConfig    *clientConfig                 `tfsdk:"client_config"`
+	Name            types.String                  `tfsdk:"name"`
+	IPAddress       types.String                  `tfsdk:"ip_address"`
+	Region          types.String                  `tfsdk:"region"`
+	AllowMissing    types.Bool                    `tfsdk:"allow_missing"`
+	ForwardingRule  *lbChainForwardingRuleModel   `tfsdk:"forwarding_rule"`
+	TargetProxy     *lbChainTargetProxyModel      `tfsdk:"target_proxy"`
+	URLMap          *lbChainURLMapModel           `tfsdk:"url_map"`
+	BackendServices []*lbChainBackendServiceModel `tfsdk:"backend_services"`
+	BackendBuckets  []*lbChainBackendBucketModel  `tfsdk:"backend_buckets"`
+}
+
+type lbChainForwardingRuleModel struct {
+	Name                types.String `tfsdk:"name"`
+	SelfLink            types.String `tfsdk:"self_link"`
+	IPAddress           types.String `tfsdk:"ip_address"`
+	IPProtocol          types.String `tfsdk:"ip_protocol"`
+	PortRange           types.String `tfsdk:"port_range"`
+	Ports               types.List   `tfsdk:"ports"`
+	LoadBalancingScheme types.String `tfsdk:"load_balancing_scheme"`
+	Target              types.String `tfsdk:"target"`
+}
+
+type lbChainTargetProxyModel struct {
+	Name            types.String `tfsdk:"name"`
+	SelfLink        types.String `tfsdk:"self_link"`
+	Type            types.String `tfsdk:"type"`
+	URLMap          types.String `tfsdk:"url_map"`
+	Service         types.String `tfsdk:"service"`
+	SslCertificates types.List   `tfsdk:"ssl_certificates"`
+	CertificateMap  types.String `tfsdk:"certificate_map"`
+	SslPolicy       types.String `tfsdk:"ssl_policy"`
+}
+
+type lbChainURLMapModel struct {
+	Name           types.String `tfsdk:"name"`
+	SelfLink       types.String `tfsdk:"self_link"`
+	DefaultService types.String `tfsdk:"default_service"`
+	Hosts          types.List   `tfsdk:"hosts"`
+}
+
+type lbChainBackendServiceModel struct {
+	Name                types.String               `tfsdk:"name"`
+	SelfLink            types.String               `tfsdk:"self_link"`
+	Protocol            types.String               `tfsdk:"protocol"`
+	LoadBalancingScheme types.String               `tfsdk:"load_balancing_scheme"`
+	SecurityPolicy      types.String               `tfsdk:"security_policy"`
+	EnableCDN           types.Bool                 `tfsdk:"enable_cdn"`
+	Backends            []*lbChainBackendModel     `tfsdk:"backends"`
+	HealthChecks        []*lbChainHealthCheckModel `tfsdk:"health_checks"`
+}
+
+type lbChainBackendModel struct {
+	Group              types.String  `tfsdk:"group"`
+	BalancingMode      types.String  `tfsdk:"balancing_mode"`
+	CapacityScaler     types.Float64 `tfsdk:"capacity_scaler"`
+	MaxUtilization     types.Float64 `tfsdk:"max_utilization"`
+	MaxRatePerInstance types.Float64 `tfsdk:"max_rate_per_instance"`
+}
+
+type lbChainHealthCheckModel struct {
+	Name        types.String `tfsdk:"name"`
+	SelfLink    types.String `tfsdk:"self_link"`
+	Type        types.String `tfsdk:"type"`
+	Port        types.Int64  `tfsdk:"port"`
+	RequestPath types.String `tfsdk:"request_path"`
+}
+
+type lbChainBackendBucketModel struct {
+	Name       types.String `tfsdk:"name"`
+	SelfLink   types.String `tfsdk:"self_link"`
+	BucketName types.String `tfsdk:"bucket_name"`
+	EnableCDN  types.Bool   `tfsdk:"enable_cdn"`
+}
+
+// Metadata returns the data source load balancer chain type name.
+func (d *LbChainDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_chain"
+}
+
+// Schema defines the schema for the load balancer chain data source.
+func (d *LbChainDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source walks the chain of a load balancer on Google Cloud " +
+			"from the forwarding rule, through the target proxy and the URL map, to the " +
+			"backend services with their backends and health checks, and returns it as " +
+			"a single object.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of forwarding rule. Either name or ip_address must be set.",
+				Optional:    true,
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "IP address of forwarding rule. Either name or ip_address must " +
+					"be set, it is an error if several forwarding rules share the IP address.",
+				Optional: true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of forwarding rule. Default to the global forwarding rules.",
+				Optional:    true,
+			},
+			"allow_missing": allowMissingAttribute("forwarding rule, target proxy or URL map"),
+			"forwarding_rule": schema.SingleNestedAttribute{
+				Description: "Forwarding rule of load balancer.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of forwarding rule.",
+						Computed:    true,
+					},
+					"self_link": schema.StringAttribute{
+						Description: "Self link of forwarding rule.",
+						Computed:    true,
+					},
+					"ip_address": schema.StringAttribute{
+						Description: "IP address of forwarding rule.",
+						Computed:    true,
+					},
+					"ip_protocol": schema.StringAttribute{
+						Description: "IP protocol of forwarding rule.",
+						Computed:    true,
+					},
+					"port_range": schema.StringAttribute{
+						Description: "Port range of forwarding rule.",
+						Computed:    true,
+					},
+					"ports": schema.ListAttribute{
+						Description: "Ports of forwarding rule.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"load_balancing_scheme": schema.StringAttribute{
+						Description: "Load balancing scheme of forwarding rule.",
+						Computed:    true,
+					},
+					"target": schema.StringAttribute{
+						Description: "Self link of the target proxy or the backend service " +
+							"of forwarding rule.",
+						Computed: true,
+					},
+				},
+			},
+			"target_proxy": schema.SingleNestedAttribute{
+				Description: "Target proxy of forwarding rule, null if forwarding rule " +
+					"targets a backend service directly, or targets a target pool or " +
+					"a target instance.",
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of target proxy.",
+						Computed:    true,
+					},
+					"self_link": schema.StringAttribute{
+						Description: "Self link of target proxy.",
+						Computed:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "Type of target proxy, e.g. targetHttpsProxies or targetTcpProxies.",
+						Computed:    true,
+					},
+					"url_map": schema.StringAttribute{
+						Description: "Self link of the URL map of the HTTP, HTTPS and gRPC proxies.",
+						Computed:    true,
+					},
+					"service": schema.StringAttribute{
+						Description: "Self link of the backend service of the SSL and TCP proxies.",
+						Computed:    true,
+					},
+					"ssl_certificates": schema.ListAttribute{
+						Description: "Self links of the SSL certificates of the HTTPS and SSL proxies.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"certificate_map": schema.StringAttribute{
+						Description: "Certificate map of the HTTPS and SSL proxies.",
+						Computed:    true,
+					},
+					"ssl_policy": schema.StringAttribute{
+						Description: "Self link of the SSL policy of the HTTPS and SSL proxies.",
+						Computed:    true,
+					},
+				},
+			},
+			"url_map": schema.SingleNestedAttribute{
+				Description: "URL map of target proxy, null if target proxy has no URL map.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of URL map.",
+						Computed:    true,
+					},
+					"self_link": schema.StringAttribute{
+						Description: "Self link of URL map.",
+						Computed:    true,
+					},
+					"default_service": schema.StringAttribute{
+						Description: "Self link of the default backend service or backend bucket.",
+						Computed:    true,
+					},
+					"hosts": schema.ListAttribute{
+						Description: "Hosts of the host rules of URL map.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+			"backend_services": schema.ListNestedAttribute{
+				Description: "Backend services referenced by URL map or target proxy, or " +
+					"targeted by forwarding rule directly.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of backend service.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of backend service.",
+							Computed:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "Protocol of backend service.",
+							Computed:    true,
+						},
+						"load_balancing_scheme": schema.StringAttribute{
+							Description: "Load balancing scheme of backend service.",
+							Computed:    true,
+						},
+						"security_policy": schema.StringAttribute{
+							Description: "Self link of the Cloud Armor security policy of backend service.",
+							Computed:    true,
+						},
+						"enable_cdn": schema.BoolAttribute{
+							Description: "Whether Cloud CDN is enabled for backend service.",
+							Computed:    true,
+						},
+						"backends": schema.ListNestedAttribute{
+							Description: "Backends of backend service.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"group": schema.StringAttribute{
+										Description: "Self link of the instance group or network endpoint group.",
+										Computed:    true,
+									},
+									"balancing_mode": schema.StringAttribute{
+										Description: "Balancing mode of backend, e.g. UTILIZATION or RATE.",
+										Computed:    true,
+									},
+									"capacity_scaler": schema.Float64Attribute{
+										Description: "Capacity scaler of backend.",
+										Computed:    true,
+									},
+									"max_utilization": schema.Float64Attribute{
+										Description: "Maximum utilization of backend.",
+										Computed:    true,
+									},
+									"max_rate_per_instance": schema.Float64Attribute{
+										Description: "Maximum requests per second per instance of backend.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"health_checks": schema.ListNestedAttribute{
+							Description: "Health checks of backend service.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "Name of health check.",
+										Computed:    true,
+									},
+									"self_link": schema.StringAttribute{
+										Description: "Self link of health check.",
+										Computed:    true,
+									},
+									"type": schema.StringAttribute{
+										Description: "Type of health check, e.g. HTTP, HTTPS or TCP.",
+										Computed:    true,
+									},
+									"port": schema.Int64Attribute{
+										Description: "Port of health check, 0 if the port is " +
+											"taken from the named port or the serving port.",
+										Computed: true,
+									},
+									"request_path": schema.StringAttribute{
+										Description: "Request path of the HTTP, HTTPS and HTTP/2 health checks.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"backend_buckets": schema.ListNestedAttribute{
+				Description: "Backend buckets referenced by URL map.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of backend bucket.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of backend bucket.",
+							Computed:    true,
+						},
+						"bucket_name": schema.StringAttribute{
+							Description: "Name of the GCS bucket of backend bucket.",
+							Computed:    true,
+						},
+						"enable_cdn": schema.BoolAttribute{
+							Description: "Whether Cloud CDN is enabled for backend bucket.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *LbChainDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read load balancer chain data source information
+func (d *LbChainDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *LbChainDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.ValueString() == "" && plan.IPAddress.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Missing forwarding rule",
+			"Either name or ip_address must be set.",
+		)
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := lbChainForwardingRule(ctx, clients, plan)
+	if isMissingAllowed(plan.AllowMissing, err) {
+		rule, err = nil, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get forwarding rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := &LbChainDataSourceModel{
+		Name:            plan.Name,
+		IPAddress:       plan.IPAddress,
+		Region:          plan.Region,
+		AllowMissing:    plan.AllowMissing,
+		BackendServices: []*lbChainBackendServiceModel{},
+		BackendBuckets:  []*lbChainBackendBucketModel{},
+	}
+	if rule == nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+	state.ForwardingRule = newLbChainForwardingRule(rule)
+
+	// The backend services and the backend buckets referenced along the chain
+	// in order without duplicates.
+	services := []string{}
+	addService := func(service string) {
+		if service != "" && !containsString(services, service) {
+			services = append(services, service)
+		}
+	}
+
+	c := clients.computeClient
+	addService(rule.BackendService)
+	project, scope, collection, name := parseComputeSelfLink(rule.Target)
+	urlMap := ""
+	switch collection {
+	case "targetHttpProxies":
+		var proxy *googleComputeClient.TargetHttpProxy
+		if scope == globalScope {
+			proxy, err = c.TargetHttpProxies.Get(project, name).Context(ctx).Do()
+		} else {
+			proxy, err = c.RegionTargetHttpProxies.Get(project, scope, name).Context(ctx).Do()
+		}
+		if err == nil {
+			state.TargetProxy = newLbChainTargetProxy(proxy.Name, proxy.SelfLink, collection)
+			state.TargetProxy.URLMap = types.StringValue(proxy.UrlMap)
+			urlMap = proxy.UrlMap
+		}
+	case "targetHttpsProxies":
+		var proxy *googleComputeClient.TargetHttpsProxy
+		if scope == globalScope {
+			proxy, err = c.TargetHttpsProxies.Get(project, name).Context(ctx).Do()
+		} else {
+			proxy, err = c.RegionTargetHttpsProxies.Get(project, scope, name).Context(ctx).Do()
+		}
+		if err == nil {
+			state.TargetProxy = newLbChainTargetProxy(proxy.Name, proxy.SelfLink, collection)
+			state.TargetProxy.URLMap = types.StringValue(proxy.UrlMap)
+			state.TargetProxy.SslCertificates = lbChainStringsValue(proxy.SslCertificates)
+			state.TargetProxy.CertificateMap = types.StringValue(proxy.CertificateMap)
+			state.TargetProxy.SslPolicy = types.StringValue(proxy.SslPolicy)
+			urlMap = proxy.UrlMap
+		}
+	case "targetGrpcProxies":
+		var proxy *googleComputeClient.TargetGrpcProxy
+		proxy, err = c.TargetGrpcProxies.Get(project, name).Context(ctx).Do()
+		if err == nil {
+			state.TargetProxy = newLbChainTargetProxy(proxy.Name, proxy.SelfLink, collection)
+			state.TargetProxy.URLMap = types.StringValue(proxy.UrlMap)
+			urlMap = proxy.UrlMap
+		}
+	case "targetSslProxies":
+		var proxy *googleComputeClient.TargetSslProxy
+		proxy, err = c.TargetSslProxies.Get(project, name).Context(ctx).Do()
+		if err == nil {
+			state.TargetProxy = newLbChainTargetProxy(proxy.Name, proxy.SelfLink, collection)
+			state.TargetProxy.Service = types.StringValue(proxy.Service)
+			state.TargetProxy.SslCertificates = lbChainStringsValue(proxy.SslCertificates)
+			state.TargetProxy.CertificateMap = types.StringValue(proxy.CertificateMap)
+			state.TargetProxy.SslPolicy = types.StringValue(proxy.SslPolicy)
+			addService(proxy.Service)
+		}
+	case "targetTcpProxies":
+		var proxy *googleComputeClient.TargetTcpProxy
+		if scope == globalScope {
+			proxy, err = c.TargetTcpProxies.Get(project, name).Context(ctx).Do()
+		} else {
+			proxy, err = c.RegionTargetTcpProxies.Get(project, scope, name).Context(ctx).Do()
+		}
+		if err == nil {
+			state.TargetProxy = newLbChainTargetProxy(proxy.Name, proxy.SelfLink, collection)
+			state.TargetProxy.Service = types.StringValue(proxy.Service)
+			addService(proxy.Service)
+		}
+	}
+	if isMissingAllowed(plan.AllowMissing, err) {
+		err = nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get target proxy.",
+			err.Error(),
+		)
+		return
+	}
+
+	if urlMap != "" {
+		var m *googleComputeClient.UrlMap
+		project, scope, _, name = parseComputeSelfLink(urlMap)
+		if scope == globalScope {
+			m, err = c.UrlMaps.Get(project, name).Context(ctx).Do()
+		} else {
+			m, err = c.RegionUrlMaps.Get(project, scope, name).Context(ctx).Do()
+		}
+		if isMissingAllowed(plan.AllowMissing, err) {
+			m, err = nil, nil
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to get URL map.",
+				err.Error(),
+			)
+			return
+		}
+		if m != nil {
+			state.URLMap = newLbChainURLMap(m)
+			for _, service := range urlMapServices(m) {
+				addService(service)
+			}
+		}
+	}
+
+	for _, service := range services {
+		project, scope, collection, name = parseComputeSelfLink(service)
+		switch collection {
+		case "backendServices":
+			var backendService *googleComputeClient.BackendService
+			if scope == globalScope {
+				backendService, err = c.BackendServices.Get(project, name).Context(ctx).Do()
+			} else {
+				backendService, err = c.RegionBackendServices.Get(project, scope, name).Context(ctx).Do()
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to get backend service.",
+					err.Error(),
+				)
+				return
+			}
+			item, err := newLbChainBackendService(ctx, c, backendService)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to get health check.",
+					err.Error(),
+				)
+				return
+			}
+			state.BackendServices = append(state.BackendServices, item)
+		case "backendBuckets":
+			backendBucket, err := c.BackendBuckets.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to get backend bucket.",
+					err.Error(),
+				)
+				return
+			}
+			state.BackendBuckets = append(state.BackendBuckets, &lbChainBackendBucketModel{
+				Name:       types.StringValue(backendBucket.Name),
+				SelfLink:   types.StringValue(backendBucket.SelfLink),
+				BucketName: types.StringValue(backendBucket.BucketName),
+				EnableCDN:  types.BoolValue(backendBucket.EnableCdn),
+			})
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// lbChainForwardingRule returns the forwarding rule by name, or the only
+// forwarding rule with the IP address. nil is returned if no forwarding rule
+// has the IP address and allow_missing is set.
+func lbChainForwardingRule(ctx context.Context, clients *gcpClients,
+	plan *LbChainDataSourceModel) (*googleComputeClient.ForwardingRule, error) {
+	c := clients.computeClient
+	region := plan.Region.ValueString()
+	if plan.Name.ValueString() != "" {
+		if region == "" {
+			return c.GlobalForwardingRules.Get(clients.project, plan.Name.ValueString()).Context(ctx).Do()
+		}
+		return c.ForwardingRules.Get(clients.project, region, plan.Name.ValueString()).Context(ctx).Do()
+	}
+
+	rules := []*googleComputeClient.ForwardingRule{}
+	appendRules := func(page *googleComputeClient.ForwardingRuleList) error {
+		for _, rule := range page.Items {
+			if rule.IPAddress == plan.IPAddress.ValueString() {
+				rules = append(rules, rule)
+			}
+		}
+		return nil
+	}
+	var err error
+	if region == "" {
+		err = c.GlobalForwardingRules.List(clients.project).Pages(ctx, appendRules)
+	} else {
+		err = c.ForwardingRules.List(clients.project, region).Pages(ctx, appendRules)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(rules) {
+	case 0:
+		if plan.AllowMissing.ValueBool() {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("no forwarding rule has IP address %s", plan.IPAddress.ValueString())
+	case 1:
+		return rules[0], nil
+	}
+	names := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		names = append(names, rule.Name)
+	}
+	return nil, fmt.Errorf("forwarding rules %s share IP address %s, please set name instead",
+		strings.Join(names, ", "), plan.IPAddress.ValueString())
+}
+
+// parseComputeSelfLink returns the project, the scope, the collection and the
+// name of the global or regional compute resource self link. The scope is the
+// region of resource, or global.
+func parseComputeSelfLink(selfLink string) (project, scope, collection, name string) {
+	i := strings.Index(selfLink, "projects/")
+	if i < 0 {
+		return "", "", "", ""
+	}
+	parts := strings.Split(selfLink[i:], "/")
+	switch {
+	case len(parts) == 5 && parts[2] == globalScope:
+		return parts[1], globalScope, parts[3], parts[4]
+	case len(parts) == 6 && parts[2] == "regions":
+		return parts[1], parts[3], parts[4], parts[5]
+	}
+	return "", "", "", ""
+}
+
+// urlMapServices returns the backend services and the backend buckets
+// referenced by the URL map, including the weighted backend services of the
+// route actions.
+func urlMapServices(m *googleComputeClient.UrlMap) []string {
+	services := []string{}
+	addRouteAction := func(service string, routeAction *googleComputeClient.HttpRouteAction) {
+		services = append(services, service)
+		if routeAction == nil {
+			return
+		}
+		for _, weighted := range routeAction.WeightedBackendServices {
+			services = append(services, weighted.BackendService)
+		}
+	}
+
+	addRouteAction(m.DefaultService, m.DefaultRouteAction)
+	for _, pathMatcher := range m.PathMatchers {
+		addRouteAction(pathMatcher.DefaultService, pathMatcher.DefaultRouteAction)
+		for _, pathRule := range pathMatcher.PathRules {
+			addRouteAction(pathRule.Service, pathRule.RouteAction)
+		}
+		for _, routeRule := range pathMatcher.RouteRules {
+			addRouteAction(routeRule.Service, routeRule.RouteAction)
+		}
+	}
+	return services
+}
+
+// lbChainStringsValue converts the strings into list value.
+func lbChainStringsValue(values []string) types.List {
+	elements := []attr.Value{}
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// newLbChainForwardingRule converts the forwarding rule.
+func newLbChainForwardingRule(rule *googleComputeClient.ForwardingRule) *lbChainForwardingRuleModel {
+	target := rule.Target
+	if target == "" {
+		target = rule.BackendService
+	}
+	return &lbChainForwardingRuleModel{
+		Name:                types.StringValue(rule.Name),
+		SelfLink:            types.StringValue(rule.SelfLink),
+		IPAddress:           types.StringValue(rule.IPAddress),
+		IPProtocol:          types.StringValue(rule.IPProtocol),
+		PortRange:           types.StringValue(rule.PortRange),
+		Ports:               lbChainStringsValue(rule.Ports),
+		LoadBalancingScheme: types.StringValue(rule.LoadBalancingScheme),
+		Target:              types.StringValue(target),
+	}
+}
+
+// newLbChainTargetProxy returns the target proxy without the attributes
+// specific to the type of target proxy.
+func newLbChainTargetProxy(name, selfLink, collection string) *lbChainTargetProxyModel {
+	return &lbChainTargetProxyModel{
+		Name:            types.StringValue(name),
+		SelfLink:        types.StringValue(selfLink),
+		Type:            types.StringValue(collection),
+		URLMap:          types.StringValue(""),
+		Service:         types.StringValue(""),
+		SslCertificates: lbChainStringsValue(nil),
+		CertificateMap:  types.StringValue(""),
+		SslPolicy:       types.StringValue(""),
+	}
+}
+
+// newLbChainURLMap converts the URL map.
+func newLbChainURLMap(m *googleComputeClient.UrlMap) *lbChainURLMapModel {
+	hosts := []string{}
+	for _, hostRule := range m.HostRules {
+		hosts = append(hosts, hostRule.Hosts...)
+	}
+	return &lbChainURLMapModel{
+		Name:           types.StringValue(m.Name),
+		SelfLink:       types.StringValue(m.SelfLink),
+		DefaultService: types.StringValue(m.DefaultService),
+		Hosts:          lbChainStringsValue(hosts),
+	}
+}
+
+// newLbChainBackendService converts the backend service with its health checks.
+func newLbChainBackendService(ctx context.Context, c *googleComputeClient.Service,
+	backendService *googleComputeClient.BackendService) (*lbChainBackendServiceModel, error) {
+	item := &lbChainBackendServiceModel{
+		Name:                types.StringValue(backendService.Name),
+		SelfLink:            types.StringValue(backendService.SelfLink),
+		Protocol:            types.StringValue(backendService.Protocol),
+		LoadBalancingScheme: types.StringValue(backendService.LoadBalancingScheme),
+		SecurityPolicy:      types.StringValue(backendService.SecurityPolicy),
+		EnableCDN:           types.BoolValue(backendService.EnableCDN),
+		Backends:            []*lbChainBackendModel{},
+		HealthChecks:        []*lbChainHealthCheckModel{},
+	}
+	for _, backend := range backendService.Backends {
+		item.Backends = append(item.Backends, &lbChainBackendModel{
+			Group:              types.StringValue(backend.Group),
+			BalancingMode:      types.StringValue(backend.BalancingMode),
+			CapacityScaler:     types.Float64Value(backend.CapacityScaler),
+			MaxUtilization:     types.Float64Value(backend.MaxUtilization),
+			MaxRatePerInstance: types.Float64Value(backend.MaxRatePerInstance),
+		})
+	}
+
+	for _, healthCheckLink := range backendService.HealthChecks {
+		project, scope, collection, name := parseComputeSelfLink(healthCheckLink)
+		healthCheck := &lbChainHealthCheckModel{
+			Name:        types.StringValue(name),
+			SelfLink:    types.StringValue(healthCheckLink),
+			Type:        types.StringValue(""),
+			Port:        types.Int64Value(0),
+			RequestPath: types.StringValue(""),
+		}
+		// The legacy HTTP and HTTPS health checks are only reported by name.
+		if collection == "healthChecks" {
+			var h *googleComputeClient.HealthCheck
+			var err error
+			if scope == globalScope {
+				h, err = c.HealthChecks.Get(project, name).Context(ctx).Do()
+			} else {
+				h, err = c.RegionHealthChecks.Get(project, scope, name).Context(ctx).Do()
+			}
+			if err != nil {
+				return nil, err
+			}
+			healthCheck.Type = types.StringValue(h.Type)
+			switch {
+			case h.HttpHealthCheck != nil:
+				healthCheck.Port = types.Int64Value(h.HttpHealthCheck.Port)
+				healthCheck.RequestPath = types.StringValue(h.HttpHealthCheck.RequestPath)
+			case h.HttpsHealthCheck != nil:
+				healthCheck.Port = types.Int64Value(h.HttpsHealthCheck.Port)
+				healthCheck.RequestPath = types.StringValue(h.HttpsHealthCheck.RequestPath)
+			case h.Http2HealthCheck != nil:
+				healthCheck.Port = types.Int64Value(h.Http2HealthCheck.Port)
+				healthCheck.RequestPath = types.StringValue(h.Http2HealthCheck.RequestPath)
+			case h.TcpHealthCheck != nil:
+				healthCheck.Port = types.Int64Value(h.TcpHealthCheck.Port)
+			case h.SslHealthCheck != nil:
+				healthCheck.Port = types.Int64Value(h.SslHealthCheck.Port)
+			case h.GrpcHealthCheck != nil:
+				healthCheck.Port = types.Int64Value(h.GrpcHealthCheck.Port)
+			}
+		}
+		item.HealthChecks = append(item.HealthChecks, healthCheck)
+	}
+	return item, nil
+}
@@ -0,0 +1,255 @@
+package gcp
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &LbForwardingRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &LbForwardingRulesDataSource{}
+)
+
+// NewLbForwardingRulesDataSource
+func NewLbForwardingRulesDataSource() datasource.DataSource {
+	return &LbForwardingRulesDataSource{}
+}
+
+// LbForwardingRulesDataSource
+type LbForwardingRulesDataSource struct {
+	client *gcpClients
+}
+
+// LbForwardingRulesDataSourceModel
+type LbForwardingRulesDataSourceModel struct {
+	ClientConfig *clientConfig                 `tfsdk:"client_config"`
+	Name         types.String                  `tfsdk:"name"`
+	Tags         types.Map                     `tfsdk:"tags"`
+	IPAddress    types.String                  `tfsdk:"ip_address"`
+	Target       types.String                  `tfsdk:"target"`
+	Items        []*lbForwardingRulesItemModel `tfsdk:"items"`
+}
+
+type lbForwardingRulesItemModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Tags                types.Map    `tfsdk:"tags"`
+	IPAddress           types.String `tfsdk:"ip_address"`
+	IPProtocol          types.String `tfsdk:"ip_protocol"`
+	PortRange           types.String `tfsdk:"port_range"`
+	NetworkTier         types.String `tfsdk:"network_tier"`
+	LoadBalancingScheme types.String `tfsdk:"load_balancing_scheme"`
+	Target              types.String `tfsdk:"target"`
+	TargetProxy         types.String `tfsdk:"target_proxy"`
+	SelfLink            types.String `tfsdk:"self_link"`
+}
+
+// Metadata returns the data source forwarding rules type name.
+func (d *LbForwardingRulesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_forwarding_rules"
+}
+
+// Schema defines the schema for the forwarding rules data source.
+func (d *LbForwardingRulesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the global load balancer forwarding rules on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of forwarding rule to be filtered.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of forwarding rule to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "IP address of forwarding rule to be filtered.",
+				Optional:    true,
+			},
+			"target": schema.StringAttribute{
+				Description: "Target of forwarding rule to be filtered. Either the name, " +
+					"self link or the partial self link of the target proxy, e.g. " +
+					"global/targetHttpsProxies/web-prod.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried load balancer forwarding rules.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of forwarding rule.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of forwarding rule.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Tags of forwarding rule.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "IP address of forwarding rule.",
+							Computed:    true,
+						},
+						"ip_protocol": schema.StringAttribute{
+							Description: "IP protocol of forwarding rule.",
+							Computed:    true,
+						},
+						"port_range": schema.StringAttribute{
+							Description: "Port range of forwarding rule, e.g. 443-443.",
+							Computed:    true,
+						},
+						"network_tier": schema.StringAttribute{
+							Description: "Network tier of forwarding rule, PREMIUM or STANDARD.",
+							Computed:    true,
+						},
+						"load_balancing_scheme": schema.StringAttribute{
+							Description: "Load balancing scheme of forwarding rule.",
+							Computed:    true,
+						},
+						"target": schema.StringAttribute{
+							Description: "Self link of the target of forwarding rule.",
+							Computed:    true,
+						},
+						"target_proxy": schema.StringAttribute{
+							Description: "Name of the target proxy of forwarding rule.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of forwarding rule.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *LbForwardingRulesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read forwarding rules data source information
+func (d *LbForwardingRulesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *LbForwardingRulesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &LbForwardingRulesDataSourceModel{
+		Name:      plan.Name,
+		Tags:      plan.Tags,
+		IPAddress: plan.IPAddress,
+		Target:    plan.Target,
+		Items:     []*lbForwardingRulesItemModel{},
+	}
+
+	err := clients.computeClient.GlobalForwardingRules.List(clients.project).Pages(ctx,
+		func(page *googleComputeClient.ForwardingRuleList) error {
+			for _, rule := range page.Items {
+				if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != rule.Name {
+					continue
+				}
+				if !(plan.IPAddress.IsUnknown() || plan.IPAddress.IsNull()) &&
+					plan.IPAddress.ValueString() != rule.IPAddress {
+					continue
+				}
+				if !(plan.Target.IsUnknown() || plan.Target.IsNull()) &&
+					!matchResourceReference(rule.Target, plan.Target.ValueString()) {
+					continue
+				}
+
+				tags, tagsTfType, diags := descriptionTags(rule.Description)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return nil
+				}
+				if !matchTags(plan.Tags, tags) {
+					continue
+				}
+
+				state.Items = append(state.Items, &lbForwardingRulesItemModel{
+					ID:                  types.Int64Value(int64(rule.Id)),
+					Name:                types.StringValue(rule.Name),
+					Tags:                tagsTfType,
+					IPAddress:           types.StringValue(rule.IPAddress),
+					IPProtocol:          types.StringValue(rule.IPProtocol),
+					PortRange:           types.StringValue(rule.PortRange),
+					NetworkTier:         types.StringValue(rule.NetworkTier),
+					LoadBalancingScheme: types.StringValue(rule.LoadBalancingScheme),
+					Target:              types.StringValue(rule.Target),
+					TargetProxy:         types.StringValue(resourceNameFromSelfLink(rule.Target)),
+					SelfLink:            types.StringValue(rule.SelfLink),
+				})
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list load balancer forwarding rules.",
+			err.Error(),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// resourceNameFromSelfLink returns the resource name, which is the last
+// segment of the self link.
+func resourceNameFromSelfLink(selfLink string) string {
+	if selfLink == "" {
+		return ""
+	}
+	return path.Base(selfLink)
+}
+
+// matchResourceReference returns true if the reference is the self link, the
+// partial self link or the name of the resource.
+func matchResourceReference(selfLink, reference string) bool {
+	if selfLink == "" {
+		return false
+	}
+	return selfLink == reference ||
+		strings.HasSuffix(selfLink, "/"+strings.TrimPrefix(reference, "/")) ||
+		resourceNameFromSelfLink(selfLink) == reference
+}
@@ -0,0 +1,322 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &MachineTypeAvailabilityDataSource{}
+	_ datasource.DataSourceWithConfigure = &MachineTypeAvailabilityDataSource{}
+)
+
+// NewMachineTypeAvailabilityDataSource
+func NewMachineTypeAvailabilityDataSource() datasource.DataSource {
+	return &MachineTypeAvailabilityDataSource{}
+}
+
+// MachineTypeAvailabilityDataSource
+type MachineTypeAvailabilityDataSource struct {
+	client *gcpClients
+}
+
+// MachineTypeAvailabilityDataSourceModel
+type MachineTypeAvailabilityDataSourceModel struct {
+	ClientConfig   *clientConfig                       `tfsdk:"client_config"`
+	MachineTypes   types.List                          `tfsdk:"machine_types"`
+	Accelerators   []*machineTypeAcceleratorModel      `tfsdk:"accelerators"`
+	Region         types.String                        `tfsdk:"region"`
+	Zones          types.List                          `tfsdk:"zones"`
+	AvailableZones types.List                          `tfsdk:"available_zones"`
+	Items          []*machineTypeAvailabilityItemModel `tfsdk:"items"`
+}
+
+type machineTypeAcceleratorModel struct {
+	Type  types.String `tfsdk:"type"`
+	Count types.Int64  `tfsdk:"count"`
+}
+
+type machineTypeAvailabilityItemModel struct {
+	Zone                    types.String `tfsdk:"zone"`
+	Available               types.Bool   `tfsdk:"available"`
+	UnavailableMachineTypes types.List   `tfsdk:"unavailable_machine_types"`
+	UnavailableAccelerators types.List   `tfsdk:"unavailable_accelerators"`
+}
+
+// Metadata returns the data source machine type availability type name.
+func (d *MachineTypeAvailabilityDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_type_availability"
+}
+
+// Schema defines the schema for the machine type availability data source.
+func (d *MachineTypeAvailabilityDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source reports whether the machine types and accelerators " +
+			"are available in the zones on Google Cloud, and returns the zones that can host " +
+			"all of them, so the unsupported zones are discovered at plan time instead of " +
+			"apply time.",
+		Attributes: map[string]schema.Attribute{
+			"machine_types": schema.ListAttribute{
+				Description: "Machine types to be checked, e.g. n2-standard-4.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"accelerators": schema.ListNestedAttribute{
+				Description: "Accelerators to be checked.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Accelerator type, e.g. nvidia-tesla-t4.",
+							Required:    true,
+						},
+						"count": schema.Int64Attribute{
+							Description: "Number of accelerators attached to an instance. Default to 1.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of zones to be checked. Default to all regions.",
+				Optional:    true,
+			},
+			"zones": schema.ListAttribute{
+				Description: "Zones to be checked. Default to all zones of region.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"available_zones": schema.ListAttribute{
+				Description: "Zones that are UP and can host all the machine types and " +
+					"accelerators, sorted by name.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Availability of every checked zone, sorted by zone.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"zone": schema.StringAttribute{
+							Description: "Name of zone.",
+							Computed:    true,
+						},
+						"available": schema.BoolAttribute{
+							Description: "Whether zone is UP and can host all the machine " +
+								"types and accelerators.",
+							Computed: true,
+						},
+						"unavailable_machine_types": schema.ListAttribute{
+							Description: "Machine types not offered in zone.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"unavailable_accelerators": schema.ListAttribute{
+							Description: "Accelerator types not offered in zone, or offered " +
+								"with less cards per instance than count.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *MachineTypeAvailabilityDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read machine type availability data source information
+func (d *MachineTypeAvailabilityDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *MachineTypeAvailabilityDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machineTypes := []string{}
+	resp.Diagnostics.Append(plan.MachineTypes.ElementsAs(ctx, &machineTypes, false)...)
+	zoneNames := []string{}
+	if !(plan.Zones.IsUnknown() || plan.Zones.IsNull()) {
+		resp.Diagnostics.Append(plan.Zones.ElementsAs(ctx, &zoneNames, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Zones being checked and whether they are UP.
+	zonesUp := map[string]bool{}
+	err := clients.computeClient.Zones.List(clients.project).Pages(ctx,
+		func(page *googleComputeClient.ZoneList) error {
+			for _, zone := range page.Items {
+				if !(plan.Region.IsUnknown() || plan.Region.IsNull()) &&
+					plan.Region.ValueString() != resourceNameFromSelfLink(zone.Region) {
+					continue
+				}
+				if len(zoneNames) > 0 && !containsString(zoneNames, zone.Name) {
+					continue
+				}
+				zonesUp[zone.Name] = zone.Status == "UP"
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list zones.",
+			err.Error(),
+		)
+		return
+	}
+	for _, zoneName := range zoneNames {
+		if _, ok := zonesUp[zoneName]; !ok {
+			resp.Diagnostics.AddError(
+				"Zone not found",
+				fmt.Sprintf("Zone %s does not exist or is not in region %s.", zoneName, plan.Region.ValueString()),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Zones offering every machine type.
+	machineTypeZones := map[string]map[string]bool{}
+	for _, machineType := range machineTypes {
+		machineTypeZones[machineType] = map[string]bool{}
+		err = clients.computeClient.MachineTypes.AggregatedList(clients.project).
+			Filter(fmt.Sprintf("name = %q", machineType)).Pages(ctx,
+			func(page *googleComputeClient.MachineTypeAggregatedList) error {
+				for _, scopedList := range page.Items {
+					for _, m := range scopedList.MachineTypes {
+						if m.Name != machineType || !isComputeResourceActive(m.Deprecated) {
+							continue
+						}
+						machineTypeZones[machineType][resourceNameFromSelfLink(m.Zone)] = true
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to list machine types.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Maximum cards per instance of every accelerator type by zone.
+	acceleratorZones := map[string]map[string]int64{}
+	if len(plan.Accelerators) > 0 {
+		err = clients.computeClient.AcceleratorTypes.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.AcceleratorTypeAggregatedList) error {
+				for _, scopedList := range page.Items {
+					for _, a := range scopedList.AcceleratorTypes {
+						if !isComputeResourceActive(a.Deprecated) {
+							continue
+						}
+						if acceleratorZones[a.Name] == nil {
+							acceleratorZones[a.Name] = map[string]int64{}
+						}
+						acceleratorZones[a.Name][resourceNameFromSelfLink(a.Zone)] = a.MaximumCardsPerInstance
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to list accelerator types.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &MachineTypeAvailabilityDataSourceModel{
+		MachineTypes: plan.MachineTypes,
+		Accelerators: plan.Accelerators,
+		Region:       plan.Region,
+		Zones:        plan.Zones,
+		Items:        []*machineTypeAvailabilityItemModel{},
+	}
+
+	sortedZones := make([]string, 0, len(zonesUp))
+	for zone := range zonesUp {
+		sortedZones = append(sortedZones, zone)
+	}
+	sort.Strings(sortedZones)
+
+	availableZones := []attr.Value{}
+	for _, zone := range sortedZones {
+		unavailableMachineTypes := []attr.Value{}
+		for _, machineType := range machineTypes {
+			if !machineTypeZones[machineType][zone] {
+				unavailableMachineTypes = append(unavailableMachineTypes, types.StringValue(machineType))
+			}
+		}
+		unavailableAccelerators := []attr.Value{}
+		for _, accelerator := range plan.Accelerators {
+			count := int64(1)
+			if !(accelerator.Count.IsUnknown() || accelerator.Count.IsNull()) {
+				count = accelerator.Count.ValueInt64()
+			}
+			if acceleratorZones[accelerator.Type.ValueString()][zone] < count {
+				unavailableAccelerators = append(unavailableAccelerators, accelerator.Type)
+			}
+		}
+
+		available := zonesUp[zone] && len(unavailableMachineTypes) == 0 && len(unavailableAccelerators) == 0
+		if available {
+			availableZones = append(availableZones, types.StringValue(zone))
+		}
+		state.Items = append(state.Items, &machineTypeAvailabilityItemModel{
+			Zone:                    types.StringValue(zone),
+			Available:               types.BoolValue(available),
+			UnavailableMachineTypes: types.ListValueMust(types.StringType, unavailableMachineTypes),
+			UnavailableAccelerators: types.ListValueMust(types.StringType, unavailableAccelerators),
+		})
+	}
+	state.AvailableZones = types.ListValueMust(types.StringType, availableZones)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// isComputeResourceActive returns whether the compute resource with the
+// deprecation status is neither obsolete nor deleted. The deprecated
+// resources can still be used.
+func isComputeResourceActive(deprecated *googleComputeClient.DeprecationStatus) bool {
+	return deprecated == nil || (deprecated.State != "OBSOLETE" && deprecated.State != "DELETED")
+}
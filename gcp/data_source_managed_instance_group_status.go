@@ -0,0 +1,376 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const (
+	managedInstanceHealthy = "HEALTHY"
+)
+
+var (
+	_ datasource.DataSource              = &ManagedInstanceGroupStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &ManagedInstanceGroupStatusDataSource{}
+)
+
+// NewManagedInstanceGroupStatusDataSource
+func NewManagedInstanceGroupStatusDataSource() datasource.DataSource {
+	return &ManagedInstanceGroupStatusDataSource{}
+}
+
+// ManagedInstanceGroupStatusDataSource
+type ManagedInstanceGroupStatusDataSource struct {
+	client *gcpClients
+}
+
+// ManagedInstanceGroupStatusDataSourceModel
+type ManagedInstanceGroupStatusDataSourceModel struct {
+	ClientConfig         *clientConfig                       `tfsdk:"client_config"`
+	Name                 types.String                        `tfsdk:"name"`
+	Zone                 types.String                        `tfsdk:"zone"`
+	Region               types.String                        `tfsdk:"region"`
+	AllowMissing         types.Bool                          `tfsdk:"allow_missing"`
+	ID                   types.Int64                         `tfsdk:"id"`
+	TargetSize           types.Int64                         `tfsdk:"target_size"`
+	CurrentSize          types.Int64                         `tfsdk:"current_size"`
+	HealthyCount         types.Int64                         `tfsdk:"healthy_count"`
+	InstanceTemplate     types.String                        `tfsdk:"instance_template"`
+	IsStable             types.Bool                          `tfsdk:"is_stable"`
+	VersionTargetReached types.Bool                          `tfsdk:"version_target_reached"`
+	CurrentActions       types.Map                           `tfsdk:"current_actions"`
+	Versions             []*managedInstanceGroupVersionModel `tfsdk:"versions"`
+	Instances            []*managedInstanceModel             `tfsdk:"instances"`
+	SelfLink             types.String                        `tfsdk:"self_link"`
+}
+
+type managedInstanceGroupVersionModel struct {
+	Name             types.String `tfsdk:"name"`
+	InstanceTemplate types.String `tfsdk:"instance_template"`
+	TargetSize       types.Int64  `tfsdk:"target_size"`
+	CurrentSize      types.Int64  `tfsdk:"current_size"`
+}
+
+type managedInstanceModel struct {
+	Name             types.String `tfsdk:"name"`
+	Zone             types.String `tfsdk:"zone"`
+	Status           types.String `tfsdk:"status"`
+	CurrentAction    types.String `tfsdk:"current_action"`
+	HealthState      types.String `tfsdk:"health_state"`
+	Version          types.String `tfsdk:"version"`
+	InstanceTemplate types.String `tfsdk:"instance_template"`
+}
+
+// Metadata returns the data source managed instance group status type name.
+func (d *ManagedInstanceGroupStatusDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_instance_group_status"
+}
+
+// Schema defines the schema for the managed instance group status data source.
+func (d *ManagedInstanceGroupStatusDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the status of a zonal or regional managed " +
+			"instance group on Google Cloud, including the versions and the health and " +
+			"current action of every instance.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of managed instance group.",
+				Required:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of zonal managed instance group. One of zone and region must be set.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of regional managed instance group. One of zone and region must be set.",
+				Optional:    true,
+			},
+			"allow_missing": allowMissingAttribute("managed instance group"),
+			"id": schema.Int64Attribute{
+				Description: "ID of managed instance group.",
+				Computed:    true,
+			},
+			"target_size": schema.Int64Attribute{
+				Description: "Target number of instances.",
+				Computed:    true,
+			},
+			"current_size": schema.Int64Attribute{
+				Description: "Current number of managed instances.",
+				Computed:    true,
+			},
+			"healthy_count": schema.Int64Attribute{
+				Description: "Number of instances healthy in all health checks of the " +
+					"autohealing policies.",
+				Computed: true,
+			},
+			"instance_template": schema.StringAttribute{
+				Description: "Instance template of managed instance group, empty if versions are used.",
+				Computed:    true,
+			},
+			"is_stable": schema.BoolAttribute{
+				Description: "Whether all instances are running without any current action.",
+				Computed:    true,
+			},
+			"version_target_reached": schema.BoolAttribute{
+				Description: "Whether all instances are created from their target versions.",
+				Computed:    true,
+			},
+			"current_actions": schema.MapAttribute{
+				Description: "Number of instances of every current action, e.g. creating or verifying.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "Versions of managed instance group.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of version.",
+							Computed:    true,
+						},
+						"instance_template": schema.StringAttribute{
+							Description: "Instance template of version.",
+							Computed:    true,
+						},
+						"target_size": schema.Int64Attribute{
+							Description: "Calculated target number of instances of version, " +
+								"0 if the version takes the rest of the instances.",
+							Computed: true,
+						},
+						"current_size": schema.Int64Attribute{
+							Description: "Current number of instances of version.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"instances": schema.ListNestedAttribute{
+				Description: "Managed instances of managed instance group.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of instance.",
+							Computed:    true,
+						},
+						"zone": schema.StringAttribute{
+							Description: "Zone of instance.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of instance, e.g. RUNNING, empty if the instance is not created yet.",
+							Computed:    true,
+						},
+						"current_action": schema.StringAttribute{
+							Description: "Current action of instance, e.g. NONE, CREATING or VERIFYING.",
+							Computed:    true,
+						},
+						"health_state": schema.StringAttribute{
+							Description: "Health state of instance, e.g. HEALTHY or UNHEALTHY, " +
+								"empty if there is no autohealing policy. The first unhealthy " +
+								"state is returned if there are multiple health checks.",
+							Computed: true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Name of the version of instance.",
+							Computed:    true,
+						},
+						"instance_template": schema.StringAttribute{
+							Description: "Instance template of instance.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"self_link": schema.StringAttribute{
+				Description: "Self link of managed instance group.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ManagedInstanceGroupStatusDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read managed instance group status data source information
+func (d *ManagedInstanceGroupStatusDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ManagedInstanceGroupStatusDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, region := plan.Zone.ValueString(), plan.Region.ValueString()
+	if (zone == "") == (region == "") {
+		resp.Diagnostics.AddError("Invalid location", "Exactly one of zone and region must be set.")
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var manager *googleComputeClient.InstanceGroupManager
+	instances := []*googleComputeClient.ManagedInstance{}
+	var err error
+	if zone != "" {
+		manager, err = clients.computeClient.InstanceGroupManagers.Get(clients.project, zone,
+			plan.Name.ValueString()).Context(ctx).Do()
+		if err == nil {
+			err = clients.computeClient.InstanceGroupManagers.ListManagedInstances(clients.project, zone,
+				plan.Name.ValueString()).Pages(ctx,
+				func(page *googleComputeClient.InstanceGroupManagersListManagedInstancesResponse) error {
+					instances = append(instances, page.ManagedInstances...)
+					return nil
+				})
+		}
+	} else {
+		manager, err = clients.computeClient.RegionInstanceGroupManagers.Get(clients.project, region,
+			plan.Name.ValueString()).Context(ctx).Do()
+		if err == nil {
+			err = clients.computeClient.RegionInstanceGroupManagers.ListManagedInstances(clients.project, region,
+				plan.Name.ValueString()).Pages(ctx,
+				func(page *googleComputeClient.RegionInstanceGroupManagersListInstancesResponse) error {
+					instances = append(instances, page.ManagedInstances...)
+					return nil
+				})
+		}
+	}
+	if isMissingAllowed(plan.AllowMissing, err) {
+		manager, err = &googleComputeClient.InstanceGroupManager{}, nil
+		instances = []*googleComputeClient.ManagedInstance{}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get managed instance group.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = types.Int64Value(int64(manager.Id))
+	state.TargetSize = types.Int64Value(manager.TargetSize)
+	state.CurrentSize = types.Int64Value(int64(len(instances)))
+	state.InstanceTemplate = types.StringValue(manager.InstanceTemplate)
+	state.IsStable = types.BoolValue(manager.Status != nil && manager.Status.IsStable)
+	state.VersionTargetReached = types.BoolValue(manager.Status != nil &&
+		manager.Status.VersionTarget != nil && manager.Status.VersionTarget.IsReached)
+	state.CurrentActions = types.MapValueMust(types.Int64Type, newManagedInstanceGroupActions(manager.CurrentActions))
+	state.SelfLink = types.StringValue(manager.SelfLink)
+
+	versionSizes := map[string]int64{}
+	healthyCount := int64(0)
+	state.Instances = []*managedInstanceModel{}
+	for _, instance := range instances {
+		item := newManagedInstance(instance)
+		versionSizes[item.InstanceTemplate.ValueString()]++
+		if item.HealthState.ValueString() == managedInstanceHealthy {
+			healthyCount++
+		}
+		state.Instances = append(state.Instances, item)
+	}
+	state.HealthyCount = types.Int64Value(healthyCount)
+
+	state.Versions = []*managedInstanceGroupVersionModel{}
+	for _, version := range manager.Versions {
+		targetSize := int64(0)
+		if version.TargetSize != nil {
+			targetSize = version.TargetSize.Calculated
+		}
+		state.Versions = append(state.Versions, &managedInstanceGroupVersionModel{
+			Name:             types.StringValue(version.Name),
+			InstanceTemplate: types.StringValue(version.InstanceTemplate),
+			TargetSize:       types.Int64Value(targetSize),
+			CurrentSize:      types.Int64Value(versionSizes[version.InstanceTemplate]),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newManagedInstanceGroupActions converts the summary of the current actions
+// into a map of the action names to the number of instances.
+func newManagedInstanceGroupActions(actions *googleComputeClient.InstanceGroupManagerActionsSummary) map[string]attr.Value {
+	if actions == nil {
+		actions = &googleComputeClient.InstanceGroupManagerActionsSummary{}
+	}
+	return map[string]attr.Value{
+		"abandoning":               types.Int64Value(actions.Abandoning),
+		"creating":                 types.Int64Value(actions.Creating),
+		"creating_without_retries": types.Int64Value(actions.CreatingWithoutRetries),
+		"deleting":                 types.Int64Value(actions.Deleting),
+		"none":                     types.Int64Value(actions.None),
+		"recreating":               types.Int64Value(actions.Recreating),
+		"refreshing":               types.Int64Value(actions.Refreshing),
+		"restarting":               types.Int64Value(actions.Restarting),
+		"resuming":                 types.Int64Value(actions.Resuming),
+		"starting":                 types.Int64Value(actions.Starting),
+		"stopping":                 types.Int64Value(actions.Stopping),
+		"suspending":               types.Int64Value(actions.Suspending),
+		"verifying":                types.Int64Value(actions.Verifying),
+	}
+}
+
+// newManagedInstance converts the managed instance into queried item.
+func newManagedInstance(instance *googleComputeClient.ManagedInstance) *managedInstanceModel {
+	healthState := ""
+	for _, health := range instance.InstanceHealth {
+		healthState = health.DetailedHealthState
+		if healthState != managedInstanceHealthy {
+			break
+		}
+	}
+
+	version, instanceTemplate := "", ""
+	if instance.Version != nil {
+		version = instance.Version.Name
+		instanceTemplate = instance.Version.InstanceTemplate
+	}
+
+	// The zone is parsed from the instance URL, which is in the format of
+	// .../projects/<project>/zones/<zone>/instances/<name>.
+	zone := ""
+	if parts := strings.Split(instance.Instance, "/"); len(parts) >= 3 {
+		zone = parts[len(parts)-3]
+	}
+
+	return &managedInstanceModel{
+		Name:             types.StringValue(resourceNameFromSelfLink(instance.Instance)),
+		Zone:             types.StringValue(zone),
+		Status:           types.StringValue(instance.InstanceStatus),
+		CurrentAction:    types.StringValue(instance.CurrentAction),
+		HealthState:      types.StringValue(healthState),
+		Version:          types.StringValue(version),
+		InstanceTemplate: types.StringValue(instanceTemplate),
+	}
+}
@@ -0,0 +1,444 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &NameAvailabilityCheckDataSource{}
+	_ datasource.DataSourceWithConfigure = &NameAvailabilityCheckDataSource{}
+)
+
+// NewNameAvailabilityCheckDataSource
+func NewNameAvailabilityCheckDataSource() datasource.DataSource {
+	return &NameAvailabilityCheckDataSource{}
+}
+
+// NameAvailabilityCheckDataSource
+type NameAvailabilityCheckDataSource struct {
+	client *gcpClients
+}
+
+// NameAvailabilityCheckDataSourceModel
+type NameAvailabilityCheckDataSourceModel struct {
+	ClientConfig *clientConfig `tfsdk:"client_config"`
+	Name         types.String  `tfsdk:"name"`
+	ResourceType types.String  `tfsdk:"resource_type"`
+	Region       types.String  `tfsdk:"region"`
+	Zone         types.String  `tfsdk:"zone"`
+	Available    types.Bool    `tfsdk:"available"`
+	SelfLink     types.String  `tfsdk:"self_link"`
+}
+
+// computeNameLookup looks up a compute resource by name and returns its
+// self link. The location is the region or zone depending on the resource
+// type, and is empty for global resources.
+type computeNameLookup func(ctx context.Context, client *googleComputeClient.Service,
+	project, location, name string) (string, error)
+
+// computeNameLookupScope describes which location a resource type lookup
+// requires.
+type computeNameLookupScope int
+
+const (
+	scopeGlobal computeNameLookupScope = iota
+	scopeRegional
+	scopeZonal
+	scopeGlobalOrRegional
+)
+
+type computeNameLookupEntry struct {
+	scope  computeNameLookupScope
+	global computeNameLookup
+	local  computeNameLookup
+}
+
+var computeNameLookups = map[string]computeNameLookupEntry{
+	"address": {
+		scope: scopeGlobalOrRegional,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.GlobalAddresses.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, region, name string) (string, error) {
+			r, err := c.Addresses.Get(project, region, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"backend_bucket": {
+		scope: scopeGlobal,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.BackendBuckets.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"backend_service": {
+		scope: scopeGlobalOrRegional,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.BackendServices.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, region, name string) (string, error) {
+			r, err := c.RegionBackendServices.Get(project, region, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"disk": {
+		scope: scopeZonal,
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, zone, name string) (string, error) {
+			r, err := c.Disks.Get(project, zone, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"firewall": {
+		scope: scopeGlobal,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.Firewalls.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"forwarding_rule": {
+		scope: scopeGlobalOrRegional,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.GlobalForwardingRules.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, region, name string) (string, error) {
+			r, err := c.ForwardingRules.Get(project, region, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"health_check": {
+		scope: scopeGlobalOrRegional,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.HealthChecks.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, region, name string) (string, error) {
+			r, err := c.RegionHealthChecks.Get(project, region, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"image": {
+		scope: scopeGlobal,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.Images.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"instance": {
+		scope: scopeZonal,
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, zone, name string) (string, error) {
+			r, err := c.Instances.Get(project, zone, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"instance_template": {
+		scope: scopeGlobal,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.InstanceTemplates.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"network": {
+		scope: scopeGlobal,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.Networks.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"ssl_certificate": {
+		scope: scopeGlobalOrRegional,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.SslCertificates.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, region, name string) (string, error) {
+			r, err := c.RegionSslCertificates.Get(project, region, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"subnetwork": {
+		scope: scopeRegional,
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, region, name string) (string, error) {
+			r, err := c.Subnetworks.Get(project, region, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"target_http_proxy": {
+		scope: scopeGlobalOrRegional,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.TargetHttpProxies.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, region, name string) (string, error) {
+			r, err := c.RegionTargetHttpProxies.Get(project, region, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"target_https_proxy": {
+		scope: scopeGlobalOrRegional,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.TargetHttpsProxies.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, region, name string) (string, error) {
+			r, err := c.RegionTargetHttpsProxies.Get(project, region, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+	"url_map": {
+		scope: scopeGlobalOrRegional,
+		global: func(ctx context.Context, c *googleComputeClient.Service, project, _, name string) (string, error) {
+			r, err := c.UrlMaps.Get(project, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+		local: func(ctx context.Context, c *googleComputeClient.Service, project, region, name string) (string, error) {
+			r, err := c.RegionUrlMaps.Get(project, region, name).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			return r.SelfLink, nil
+		},
+	},
+}
+
+func supportedComputeNameLookupTypes() []string {
+	resourceTypes := make([]string, 0, len(computeNameLookups))
+	for resourceType := range computeNameLookups {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
+
+// Metadata returns the data source name availability check type name.
+func (d *NameAvailabilityCheckDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_name_availability_check"
+}
+
+// Schema defines the schema for the name availability check data source.
+func (d *NameAvailabilityCheckDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source checks whether a resource name is already " +
+			"taken for a compute resource type on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the resource to be checked.",
+				Required:    true,
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "Type of compute resource to be checked. Valid values are " +
+					strings.Join(supportedComputeNameLookupTypes(), ", ") + ".",
+				Required: true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of the resource to be checked. Required for " +
+					"subnetwork, optional for resource types that support both global " +
+					"and regional resources. Global resources are checked if not set.",
+				Optional: true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of the resource to be checked. Required for " +
+					"disk and instance.",
+				Optional: true,
+			},
+			"available": schema.BoolAttribute{
+				Description: "Whether the name is available to be used.",
+				Computed:    true,
+			},
+			"self_link": schema.StringAttribute{
+				Description: "Self link of the existing resource if the name is taken.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NameAvailabilityCheckDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read name availability check data source information
+func (d *NameAvailabilityCheckDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *NameAvailabilityCheckDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookup, location := d.resolveLookup(plan, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selfLink, err := lookup(ctx, clients.computeClient, clients.project, location, plan.Name.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("[API ERROR] Failed to get %s.", plan.ResourceType.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	state := &NameAvailabilityCheckDataSourceModel{
+		Name:         plan.Name,
+		ResourceType: plan.ResourceType,
+		Region:       plan.Region,
+		Zone:         plan.Zone,
+		Available:    types.BoolValue(selfLink == ""),
+		SelfLink:     types.StringValue(selfLink),
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// resolveLookup returns the lookup function and the location to look up
+// the resource, based on the resource type and the given region or zone.
+func (d *NameAvailabilityCheckDataSource) resolveLookup(
+	plan *NameAvailabilityCheckDataSourceModel,
+	resp *datasource.ReadResponse) (computeNameLookup, string) {
+	resourceType := plan.ResourceType.ValueString()
+	entry, ok := computeNameLookups[resourceType]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resource_type"),
+			"Unsupported resource type",
+			fmt.Sprintf("Resource type %q is not supported. Valid values are %s.",
+				resourceType, strings.Join(supportedComputeNameLookupTypes(), ", ")),
+		)
+		return nil, ""
+	}
+
+	region := plan.Region.ValueString()
+	zone := plan.Zone.ValueString()
+	switch entry.scope {
+	case scopeGlobal:
+		return entry.global, ""
+	case scopeGlobalOrRegional:
+		if region != "" {
+			return entry.local, region
+		}
+		return entry.global, ""
+	case scopeRegional:
+		if region == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("region"),
+				"Missing region",
+				fmt.Sprintf("Region is required for resource type %q.", resourceType),
+			)
+		}
+		return entry.local, region
+	default:
+		if zone == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("zone"),
+				"Missing zone",
+				fmt.Sprintf("Zone is required for resource type %q.", resourceType),
+			)
+		}
+		return entry.local, zone
+	}
+}
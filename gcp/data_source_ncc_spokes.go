@@ -0,0 +1,299 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleNetworkConnectivityClient "google.golang.org/api/networkconnectivity/v1"
+)
+
+var (
+	_ datasource.DataSource              = &NccSpokesDataSource{}
+	_ datasource.DataSourceWithConfigure = &NccSpokesDataSource{}
+)
+
+// NewNccSpokesDataSource
+func NewNccSpokesDataSource() datasource.DataSource {
+	return &NccSpokesDataSource{}
+}
+
+// NccSpokesDataSource
+type NccSpokesDataSource struct {
+	client *gcpClients
+}
+
+// NccSpokesDataSourceModel
+type NccSpokesDataSourceModel struct {
+	ClientConfig   *clientConfig         `tfsdk:"client_config"`
+	Hub            types.String          `tfsdk:"hub"`
+	SpokeLocations types.List            `tfsdk:"spoke_locations"`
+	SpokeType      types.String          `tfsdk:"spoke_type"`
+	State          types.String          `tfsdk:"state"`
+	AllowMissing   types.Bool            `tfsdk:"allow_missing"`
+	Items          []*nccSpokesItemModel `tfsdk:"items"`
+}
+
+type nccSpokesItemModel struct {
+	ID              types.String               `tfsdk:"id"`
+	Name            types.String               `tfsdk:"name"`
+	Location        types.String               `tfsdk:"location"`
+	Labels          types.Map                  `tfsdk:"labels"`
+	Description     types.String               `tfsdk:"description"`
+	SpokeType       types.String               `tfsdk:"spoke_type"`
+	State           types.String               `tfsdk:"state"`
+	Reasons         []*nccSpokeReasonItemModel `tfsdk:"reasons"`
+	LinkedResources types.List                 `tfsdk:"linked_resources"`
+	UniqueID        types.String               `tfsdk:"unique_id"`
+	UpdateTime      types.String               `tfsdk:"update_time"`
+}
+
+type nccSpokeReasonItemModel struct {
+	Code        types.String `tfsdk:"code"`
+	Message     types.String `tfsdk:"message"`
+	UserDetails types.String `tfsdk:"user_details"`
+}
+
+// Metadata returns the data source NCC spokes type name.
+func (d *NccSpokesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ncc_spokes"
+}
+
+// Schema defines the schema for the NCC spokes data source.
+func (d *NccSpokesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the spokes attached to a Network Connectivity " +
+			"Center hub on Google Cloud with their states.",
+		Attributes: map[string]schema.Attribute{
+			"hub": schema.StringAttribute{
+				Description: "Name or resource name of the hub.",
+				Required:    true,
+			},
+			"spoke_locations": schema.ListAttribute{
+				Description: "Locations of spoke to be filtered, e.g. global or a region.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"spoke_type": schema.StringAttribute{
+				Description: "Type of spoke to be filtered, valid values are VPN_TUNNEL, " +
+					"INTERCONNECT_ATTACHMENT, ROUTER_APPLIANCE and VPC_NETWORK.",
+				Optional: true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of spoke to be filtered, e.g. ACTIVE, INACTIVE or PENDING.",
+				Optional:    true,
+			},
+			"allow_missing": allowMissingAttribute("hub"),
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried spokes.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of spoke.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of spoke.",
+							Computed:    true,
+						},
+						"location": schema.StringAttribute{
+							Description: "Location of spoke.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of spoke.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of spoke.",
+							Computed:    true,
+						},
+						"spoke_type": schema.StringAttribute{
+							Description: "Type of spoke.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of spoke.",
+							Computed:    true,
+						},
+						"reasons": schema.ListNestedAttribute{
+							Description: "Reasons of the state of spoke, e.g. why the spoke is inactive.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"code": schema.StringAttribute{
+										Description: "Code of reason.",
+										Computed:    true,
+									},
+									"message": schema.StringAttribute{
+										Description: "Message of reason.",
+										Computed:    true,
+									},
+									"user_details": schema.StringAttribute{
+										Description: "Details provided by the user rejecting the spoke.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"linked_resources": schema.ListAttribute{
+							Description: "Self links of the VPN tunnels, Interconnect attachments, " +
+								"router appliance instances or VPC network linked by spoke.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"unique_id": schema.StringAttribute{
+							Description: "Unique ID of spoke.",
+							Computed:    true,
+						},
+						"update_time": schema.StringAttribute{
+							Description: "Last update time of spoke.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NccSpokesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read NCC spokes data source information
+func (d *NccSpokesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *NccSpokesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	state := &NccSpokesDataSourceModel{
+		Hub:            plan.Hub,
+		SpokeLocations: plan.SpokeLocations,
+		SpokeType:      plan.SpokeType,
+		State:          plan.State,
+		AllowMissing:   plan.AllowMissing,
+		Items:          []*nccSpokesItemModel{},
+	}
+
+	call := networkConnectivityClient.Projects.Locations.Global.Hubs.
+		ListSpokes(nccHubName(clients.project, plan.Hub.ValueString())).View("DETAILED")
+	if !(plan.SpokeLocations.IsUnknown() || plan.SpokeLocations.IsNull()) {
+		spokeLocations := []string{}
+		resp.Diagnostics.Append(plan.SpokeLocations.ElementsAs(ctx, &spokeLocations, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		call = call.SpokeLocations(spokeLocations...)
+	}
+	err = call.Pages(ctx, func(page *googleNetworkConnectivityClient.ListHubSpokesResponse) error {
+		for _, spoke := range page.Spokes {
+			if !(plan.SpokeType.IsUnknown() || plan.SpokeType.IsNull()) && plan.SpokeType.ValueString() != spoke.SpokeType {
+				continue
+			}
+			if !(plan.State.IsUnknown() || plan.State.IsNull()) && plan.State.ValueString() != spoke.State {
+				continue
+			}
+			state.Items = append(state.Items, newNccSpokesItem(spoke))
+		}
+		return nil
+	})
+	if isMissingAllowed(plan.AllowMissing, err) {
+		state.Items, err = []*nccSpokesItemModel{}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list spokes.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newNccSpokesItem converts the spoke into queried item.
+func newNccSpokesItem(spoke *googleNetworkConnectivityClient.Spoke) *nccSpokesItemModel {
+	linkedResources := []attr.Value{}
+	switch {
+	case spoke.LinkedVpnTunnels != nil:
+		for _, uri := range spoke.LinkedVpnTunnels.Uris {
+			linkedResources = append(linkedResources, types.StringValue(uri))
+		}
+	case spoke.LinkedInterconnectAttachments != nil:
+		for _, uri := range spoke.LinkedInterconnectAttachments.Uris {
+			linkedResources = append(linkedResources, types.StringValue(uri))
+		}
+	case spoke.LinkedRouterApplianceInstances != nil:
+		for _, instance := range spoke.LinkedRouterApplianceInstances.Instances {
+			linkedResources = append(linkedResources, types.StringValue(instance.VirtualMachine))
+		}
+	case spoke.LinkedVpcNetwork != nil:
+		linkedResources = append(linkedResources, types.StringValue(spoke.LinkedVpcNetwork.Uri))
+	}
+	reasons := []*nccSpokeReasonItemModel{}
+	for _, reason := range spoke.Reasons {
+		reasons = append(reasons, &nccSpokeReasonItemModel{
+			Code:        types.StringValue(reason.Code),
+			Message:     types.StringValue(reason.Message),
+			UserDetails: types.StringValue(reason.UserDetails),
+		})
+	}
+	// Resource name of spoke is projects/<project>/locations/<location>/spokes/<name>.
+	location := ""
+	if parts := strings.Split(spoke.Name, "/"); len(parts) == 6 {
+		location = parts[3]
+	}
+	_, labels := labelsValue(spoke.Labels)
+
+	return &nccSpokesItemModel{
+		ID:              types.StringValue(spoke.Name),
+		Name:            types.StringValue(resourceNameFromSelfLink(spoke.Name)),
+		Location:        types.StringValue(location),
+		Labels:          labels,
+		Description:     types.StringValue(spoke.Description),
+		SpokeType:       types.StringValue(spoke.SpokeType),
+		State:           types.StringValue(spoke.State),
+		Reasons:         reasons,
+		LinkedResources: types.ListValueMust(types.StringType, linkedResources),
+		UniqueID:        types.StringValue(spoke.UniqueId),
+		UpdateTime:      types.StringValue(spoke.UpdateTime),
+	}
+}
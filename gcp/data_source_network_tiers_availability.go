@@ -0,0 +1,213 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const (
+	networkTierPremium  = "PREMIUM"
+	networkTierStandard = "STANDARD"
+)
+
+// standardTierUnavailableRegions are the regions which Standard Tier is not
+// offered in according to the Network Service Tiers locations document, since
+// Compute Engine API does not expose the network tiers of region.
+var standardTierUnavailableRegions = map[string]bool{
+	"africa-south1":       true,
+	"me-central2":         true,
+	"northamerica-south1": true,
+}
+
+var (
+	_ datasource.DataSource              = &NetworkTiersAvailabilityDataSource{}
+	_ datasource.DataSourceWithConfigure = &NetworkTiersAvailabilityDataSource{}
+)
+
+// NewNetworkTiersAvailabilityDataSource
+func NewNetworkTiersAvailabilityDataSource() datasource.DataSource {
+	return &NetworkTiersAvailabilityDataSource{}
+}
+
+// NetworkTiersAvailabilityDataSource
+type NetworkTiersAvailabilityDataSource struct {
+	client *gcpClients
+}
+
+// NetworkTiersAvailabilityDataSourceModel
+type NetworkTiersAvailabilityDataSourceModel struct {
+	ClientConfig       *clientConfig                        `tfsdk:"client_config"`
+	Region             types.String                         `tfsdk:"region"`
+	NetworkTier        types.String                         `tfsdk:"network_tier"`
+	DefaultNetworkTier types.String                         `tfsdk:"default_network_tier"`
+	Items              []*networkTiersAvailabilityItemModel `tfsdk:"items"`
+}
+
+type networkTiersAvailabilityItemModel struct {
+	Region            types.String `tfsdk:"region"`
+	Status            types.String `tfsdk:"status"`
+	AvailableTiers    types.List   `tfsdk:"available_tiers"`
+	StandardAvailable types.Bool   `tfsdk:"standard_available"`
+	PreferredTier     types.String `tfsdk:"preferred_tier"`
+}
+
+// Metadata returns the data source network tiers availability type name.
+func (d *NetworkTiersAvailabilityDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_tiers_availability"
+}
+
+// Schema defines the schema for the network tiers availability data source.
+func (d *NetworkTiersAvailabilityDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the network tiers available in each region of " +
+			"Google Cloud. PREMIUM is available in all the regions, the availability of STANDARD " +
+			"follows the Network Service Tiers locations document since it is not exposed by " +
+			"Compute Engine API.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Description: "Region to be filtered.",
+				Optional:    true,
+			},
+			"network_tier": schema.StringAttribute{
+				Description: "Network tier to be filtered, only the regions offering the network " +
+					"tier are returned. Valid values are PREMIUM and STANDARD.",
+				Optional: true,
+			},
+			"default_network_tier": schema.StringAttribute{
+				Description: "Default network tier of project.",
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried regions.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Description: "Name of region.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of region, UP or DOWN.",
+							Computed:    true,
+						},
+						"available_tiers": schema.ListAttribute{
+							Description: "Network tiers available in region.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"standard_available": schema.BoolAttribute{
+							Description: "Whether STANDARD is available in region.",
+							Computed:    true,
+						},
+						"preferred_tier": schema.StringAttribute{
+							Description: "STANDARD if it is available in region, otherwise PREMIUM.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NetworkTiersAvailabilityDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read network tiers availability data source information
+func (d *NetworkTiersAvailabilityDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *NetworkTiersAvailabilityDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := clients.computeClient.Projects.Get(clients.project).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get project.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := &NetworkTiersAvailabilityDataSourceModel{
+		Region:             plan.Region,
+		NetworkTier:        plan.NetworkTier,
+		DefaultNetworkTier: types.StringValue(project.DefaultNetworkTier),
+		Items:              []*networkTiersAvailabilityItemModel{},
+	}
+
+	err = clients.computeClient.Regions.List(clients.project).Pages(ctx,
+		func(page *googleComputeClient.RegionList) error {
+			for _, region := range page.Items {
+				if !(plan.Region.IsUnknown() || plan.Region.IsNull()) && plan.Region.ValueString() != region.Name {
+					continue
+				}
+				item := newNetworkTiersAvailabilityItem(region)
+				if !(plan.NetworkTier.IsUnknown() || plan.NetworkTier.IsNull()) &&
+					plan.NetworkTier.ValueString() == networkTierStandard && !item.StandardAvailable.ValueBool() {
+					continue
+				}
+				state.Items = append(state.Items, item)
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list regions.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newNetworkTiersAvailabilityItem converts the region into queried item.
+func newNetworkTiersAvailabilityItem(region *googleComputeClient.Region) *networkTiersAvailabilityItemModel {
+	standardAvailable := !standardTierUnavailableRegions[region.Name]
+	availableTiers := []attr.Value{types.StringValue(networkTierPremium)}
+	preferredTier := networkTierPremium
+	if standardAvailable {
+		availableTiers = append(availableTiers, types.StringValue(networkTierStandard))
+		preferredTier = networkTierStandard
+	}
+
+	return &networkTiersAvailabilityItemModel{
+		Region:            types.StringValue(region.Name),
+		Status:            types.StringValue(region.Status),
+		AvailableTiers:    types.ListValueMust(types.StringType, availableTiers),
+		StandardAvailable: types.BoolValue(standardAvailable),
+		PreferredTier:     types.StringValue(preferredTier),
+	}
+}
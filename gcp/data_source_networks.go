@@ -0,0 +1,272 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &NetworksDataSource{}
+	_ datasource.DataSourceWithConfigure = &NetworksDataSource{}
+)
+
+// NewNetworksDataSource
+func NewNetworksDataSource() datasource.DataSource {
+	return &NetworksDataSource{}
+}
+
+// NetworksDataSource
+type NetworksDataSource struct {
+	client *gcpClients
+}
+
+// NetworksDataSourceModel
+type NetworksDataSourceModel struct {
+	ClientConfig *clientConfig        `tfsdk:"client_config"`
+	Name         types.String         `tfsdk:"name"`
+	Tags         types.Map            `tfsdk:"tags"`
+	Items        []*networksItemModel `tfsdk:"items"`
+}
+
+type networksItemModel struct {
+	ID                    types.Int64                `tfsdk:"id"`
+	Name                  types.String               `tfsdk:"name"`
+	Tags                  types.Map                  `tfsdk:"tags"`
+	AutoCreateSubnetworks types.Bool                 `tfsdk:"auto_create_subnetworks"`
+	RoutingMode           types.String               `tfsdk:"routing_mode"`
+	Mtu                   types.Int64                `tfsdk:"mtu"`
+	Peerings              []*networkPeeringItemModel `tfsdk:"peerings"`
+	Subnetworks           types.List                 `tfsdk:"subnetworks"`
+	SelfLink              types.String               `tfsdk:"self_link"`
+}
+
+type networkPeeringItemModel struct {
+	Name                 types.String `tfsdk:"name"`
+	Network              types.String `tfsdk:"network"`
+	State                types.String `tfsdk:"state"`
+	StateDetails         types.String `tfsdk:"state_details"`
+	ExportCustomRoutes   types.Bool   `tfsdk:"export_custom_routes"`
+	ImportCustomRoutes   types.Bool   `tfsdk:"import_custom_routes"`
+	ExchangeSubnetRoutes types.Bool   `tfsdk:"exchange_subnet_routes"`
+}
+
+// Metadata returns the data source networks type name.
+func (d *NetworksDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks"
+}
+
+// Schema defines the schema for the networks data source.
+func (d *NetworksDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the VPC networks on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of network to be filtered.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of network to be filtered. Networks do not support " +
+					"labels, so the tags are parsed from the description with the format " +
+					"TagKey1:TagValue1|TagKey2:TagValue2.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried networks.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of network.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of network.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Tags of network parsed from the description.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"auto_create_subnetworks": schema.BoolAttribute{
+							Description: "Whether network is in auto mode.",
+							Computed:    true,
+						},
+						"routing_mode": schema.StringAttribute{
+							Description: "Dynamic routing mode of network, REGIONAL or GLOBAL.",
+							Computed:    true,
+						},
+						"mtu": schema.Int64Attribute{
+							Description: "MTU of network.",
+							Computed:    true,
+						},
+						"peerings": schema.ListNestedAttribute{
+							Description: "Peerings of network.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "Name of peering.",
+										Computed:    true,
+									},
+									"network": schema.StringAttribute{
+										Description: "Self link of the peer network.",
+										Computed:    true,
+									},
+									"state": schema.StringAttribute{
+										Description: "State of peering, ACTIVE or INACTIVE.",
+										Computed:    true,
+									},
+									"state_details": schema.StringAttribute{
+										Description: "Details of the state of peering.",
+										Computed:    true,
+									},
+									"export_custom_routes": schema.BoolAttribute{
+										Description: "Whether the custom routes are exported to the peer network.",
+										Computed:    true,
+									},
+									"import_custom_routes": schema.BoolAttribute{
+										Description: "Whether the custom routes are imported from the peer network.",
+										Computed:    true,
+									},
+									"exchange_subnet_routes": schema.BoolAttribute{
+										Description: "Whether the subnet routes are exchanged with the peer network.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"subnetworks": schema.ListAttribute{
+							Description: "Self links of the subnetworks of network.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of network.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NetworksDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read networks data source information
+func (d *NetworksDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *NetworksDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &NetworksDataSourceModel{
+		Name:  plan.Name,
+		Tags:  plan.Tags,
+		Items: []*networksItemModel{},
+	}
+
+	err := clients.computeClient.Networks.List(clients.project).Pages(ctx,
+		func(page *googleComputeClient.NetworkList) error {
+			for _, network := range page.Items {
+				if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != network.Name {
+					continue
+				}
+
+				tags, tagsTfType, diags := descriptionTags(network.Description)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return nil
+				}
+				if !matchTags(plan.Tags, tags) {
+					continue
+				}
+				state.Items = append(state.Items, newNetworksItem(network, tagsTfType))
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list networks.",
+			err.Error(),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newNetworksItem converts the network into queried item.
+func newNetworksItem(network *googleComputeClient.Network, tags types.Map) *networksItemModel {
+	routingMode := ""
+	if network.RoutingConfig != nil {
+		routingMode = network.RoutingConfig.RoutingMode
+	}
+
+	subnetworks := []attr.Value{}
+	for _, subnetwork := range network.Subnetworks {
+		subnetworks = append(subnetworks, types.StringValue(subnetwork))
+	}
+
+	peerings := []*networkPeeringItemModel{}
+	for _, peering := range network.Peerings {
+		peerings = append(peerings, &networkPeeringItemModel{
+			Name:                 types.StringValue(peering.Name),
+			Network:              types.StringValue(peering.Network),
+			State:                types.StringValue(peering.State),
+			StateDetails:         types.StringValue(peering.StateDetails),
+			ExportCustomRoutes:   types.BoolValue(peering.ExportCustomRoutes),
+			ImportCustomRoutes:   types.BoolValue(peering.ImportCustomRoutes),
+			ExchangeSubnetRoutes: types.BoolValue(peering.ExchangeSubnetRoutes),
+		})
+	}
+
+	return &networksItemModel{
+		ID:                    types.Int64Value(int64(network.Id)),
+		Name:                  types.StringValue(network.Name),
+		Tags:                  tags,
+		AutoCreateSubnetworks: types.BoolValue(network.AutoCreateSubnetworks),
+		RoutingMode:           types.StringValue(routingMode),
+		Mtu:                   types.Int64Value(network.Mtu),
+		Peerings:              peerings,
+		Subnetworks:           types.ListValueMust(types.StringType, subnetworks),
+		SelfLink:              types.StringValue(network.SelfLink),
+	}
+}
@@ -0,0 +1,238 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleResourceManagerClient "google.golang.org/api/cloudresourcemanager/v1"
+)
+
+// iamPolicyVersion is the version of the IAM policy requested, version 3 is
+// required to get the conditional role bindings.
+const iamPolicyVersion = 3
+
+var (
+	_ datasource.DataSource              = &ProjectIamPolicyQueryDataSource{}
+	_ datasource.DataSourceWithConfigure = &ProjectIamPolicyQueryDataSource{}
+)
+
+// NewProjectIamPolicyQueryDataSource
+func NewProjectIamPolicyQueryDataSource() datasource.DataSource {
+	return &ProjectIamPolicyQueryDataSource{}
+}
+
+// ProjectIamPolicyQueryDataSource
+type ProjectIamPolicyQueryDataSource struct {
+	client *gcpClients
+}
+
+// ProjectIamPolicyQueryDataSourceModel
+type ProjectIamPolicyQueryDataSourceModel struct {
+	ClientConfig   *clientConfig                     `tfsdk:"client_config"`
+	Member         types.String                      `tfsdk:"member"`
+	Role           types.String                      `tfsdk:"role"`
+	ConditionTitle types.String                      `tfsdk:"condition_title"`
+	Etag           types.String                      `tfsdk:"etag"`
+	Found          types.Bool                        `tfsdk:"found"`
+	Items          []*projectIamPolicyQueryItemModel `tfsdk:"items"`
+}
+
+type projectIamPolicyQueryItemModel struct {
+	Role      types.String              `tfsdk:"role"`
+	Members   types.List                `tfsdk:"members"`
+	Condition *iamBindingConditionModel `tfsdk:"condition"`
+}
+
+type iamBindingConditionModel struct {
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Expression  types.String `tfsdk:"expression"`
+}
+
+// Metadata returns the data source project IAM policy query type name.
+func (d *ProjectIamPolicyQueryDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_iam_policy_query"
+}
+
+// Schema defines the schema for the project IAM policy query data source.
+func (d *ProjectIamPolicyQueryDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source queries the role bindings of the project IAM policy on " +
+			"Google Cloud, e.g. to check whether a group already has a role before adding a " +
+			"duplicate binding in another module.",
+		Attributes: map[string]schema.Attribute{
+			"member": schema.StringAttribute{
+				Description: "Member of role binding to be filtered, e.g. group:admins@example.com.",
+				Optional:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of role binding to be filtered, e.g. roles/viewer.",
+				Optional:    true,
+			},
+			"condition_title": schema.StringAttribute{
+				Description: "Title of the condition of role binding to be filtered.",
+				Optional:    true,
+			},
+			"etag": schema.StringAttribute{
+				Description: "Etag of IAM policy.",
+				Computed:    true,
+			},
+			"found": schema.BoolAttribute{
+				Description: "Whether any role binding matches the filters.",
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried role bindings.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: "Role of role binding.",
+							Computed:    true,
+						},
+						"members": schema.ListAttribute{
+							Description: "Members of role binding.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"condition": schema.SingleNestedAttribute{
+							Description: "Condition of role binding, null if role binding is " +
+								"unconditional.",
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"title": schema.StringAttribute{
+									Description: "Title of condition.",
+									Computed:    true,
+								},
+								"description": schema.StringAttribute{
+									Description: "Description of condition.",
+									Computed:    true,
+								},
+								"expression": schema.StringAttribute{
+									Description: "CEL expression of condition.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProjectIamPolicyQueryDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read project IAM policy query data source information
+func (d *ProjectIamPolicyQueryDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ProjectIamPolicyQueryDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceManagerClient, err := googleResourceManagerClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Resource Manager client", err.Error())
+		return
+	}
+
+	policy, err := resourceManagerClient.Projects.GetIamPolicy(clients.project,
+		&googleResourceManagerClient.GetIamPolicyRequest{
+			Options: &googleResourceManagerClient.GetPolicyOptions{
+				RequestedPolicyVersion: iamPolicyVersion,
+			},
+		}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get project IAM policy.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := &ProjectIamPolicyQueryDataSourceModel{
+		Member:         plan.Member,
+		Role:           plan.Role,
+		ConditionTitle: plan.ConditionTitle,
+		Etag:           types.StringValue(policy.Etag),
+		Items:          []*projectIamPolicyQueryItemModel{},
+	}
+
+	for _, binding := range policy.Bindings {
+		if !(plan.Role.IsUnknown() || plan.Role.IsNull()) && plan.Role.ValueString() != binding.Role {
+			continue
+		}
+		if !(plan.Member.IsUnknown() || plan.Member.IsNull()) && !containsString(binding.Members, plan.Member.ValueString()) {
+			continue
+		}
+		if !(plan.ConditionTitle.IsUnknown() || plan.ConditionTitle.IsNull()) &&
+			(binding.Condition == nil || plan.ConditionTitle.ValueString() != binding.Condition.Title) {
+			continue
+		}
+		state.Items = append(state.Items, newProjectIamPolicyQueryItem(binding))
+	}
+	state.Found = types.BoolValue(len(state.Items) > 0)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// containsString returns true if the value is in the values.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// newProjectIamPolicyQueryItem converts the role binding into queried item.
+func newProjectIamPolicyQueryItem(binding *googleResourceManagerClient.Binding) *projectIamPolicyQueryItemModel {
+	members := []attr.Value{}
+	for _, member := range binding.Members {
+		members = append(members, types.StringValue(member))
+	}
+
+	item := &projectIamPolicyQueryItemModel{
+		Role:    types.StringValue(binding.Role),
+		Members: types.ListValueMust(types.StringType, members),
+	}
+	if binding.Condition != nil {
+		item.Condition = &iamBindingConditionModel{
+			Title:       types.StringValue(binding.Condition.Title),
+			Description: types.StringValue(binding.Condition.Description),
+			Expression:  types.StringValue(binding.Condition.Expression),
+		}
+	}
+	return item
+}
@@ -0,0 +1,260 @@
+package gcp
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googlePubsubClient "google.golang.org/api/pubsub/v1"
+)
+
+var (
+	_ datasource.DataSource              = &PubsubTopicsDataSource{}
+	_ datasource.DataSourceWithConfigure = &PubsubTopicsDataSource{}
+)
+
+// NewPubsubTopicsDataSource
+func NewPubsubTopicsDataSource() datasource.DataSource {
+	return &PubsubTopicsDataSource{}
+}
+
+// PubsubTopicsDataSource
+type PubsubTopicsDataSource struct {
+	client *gcpClients
+}
+
+// PubsubTopicsDataSourceModel
+type PubsubTopicsDataSourceModel struct {
+	ClientConfig         *clientConfig            `tfsdk:"client_config"`
+	NamePattern          types.String             `tfsdk:"name_pattern"`
+	Labels               types.Map                `tfsdk:"labels"`
+	IncludeSubscriptions types.Bool               `tfsdk:"include_subscriptions"`
+	Items                []*pubsubTopicsItemModel `tfsdk:"items"`
+}
+
+type pubsubTopicsItemModel struct {
+	ID                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Labels                    types.Map    `tfsdk:"labels"`
+	MessageRetentionDuration  types.String `tfsdk:"message_retention_duration"`
+	KmsKeyName                types.String `tfsdk:"kms_key_name"`
+	AllowedPersistenceRegions types.List   `tfsdk:"allowed_persistence_regions"`
+	Schema                    types.String `tfsdk:"schema"`
+	SchemaEncoding            types.String `tfsdk:"schema_encoding"`
+	Subscriptions             types.List   `tfsdk:"subscriptions"`
+}
+
+// Metadata returns the data source Pub/Sub topics type name.
+func (d *PubsubTopicsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pubsub_topics"
+}
+
+// Schema defines the schema for the Pub/Sub topics data source.
+func (d *PubsubTopicsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Pub/Sub topics on Google Cloud with " +
+			"their retention and schema settings, and optionally their subscriptions.",
+		Attributes: map[string]schema.Attribute{
+			"name_pattern": schema.StringAttribute{
+				Description: "Regular expression of the name of topic to be filtered, e.g. ^orders-.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of topic to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"include_subscriptions": schema.BoolAttribute{
+				Description: "Whether to list the subscriptions of every queried topic. Default to false.",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried topics.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of topic.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of topic.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of topic.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"message_retention_duration": schema.StringAttribute{
+							Description: "Retention of the messages published to topic, e.g. " +
+								"604800s. Empty if messages are not retained by topic.",
+							Computed: true,
+						},
+						"kms_key_name": schema.StringAttribute{
+							Description: "Resource name of the KMS key encrypting the messages, " +
+								"empty if messages are encrypted by Google-managed key.",
+							Computed: true,
+						},
+						"allowed_persistence_regions": schema.ListAttribute{
+							Description: "Regions the messages are allowed to be stored in, " +
+								"empty if all regions are allowed.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"schema": schema.StringAttribute{
+							Description: "Resource name of the schema validating the messages, " +
+								"empty if topic has no schema.",
+							Computed: true,
+						},
+						"schema_encoding": schema.StringAttribute{
+							Description: "Encoding of the messages validated by schema, JSON or BINARY.",
+							Computed:    true,
+						},
+						"subscriptions": schema.ListAttribute{
+							Description: "Resource names of the subscriptions of topic, null " +
+								"unless include_subscriptions is true.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PubsubTopicsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Pub/Sub topics data source information
+func (d *PubsubTopicsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *PubsubTopicsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var namePattern *regexp.Regexp
+	if !(plan.NamePattern.IsUnknown() || plan.NamePattern.IsNull()) {
+		var err error
+		namePattern, err = regexp.Compile(plan.NamePattern.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid name_pattern", err.Error())
+			return
+		}
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pubsubClient, err := googlePubsubClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Pub/Sub client", err.Error())
+		return
+	}
+
+	state := &PubsubTopicsDataSourceModel{
+		NamePattern:          plan.NamePattern,
+		Labels:               plan.Labels,
+		IncludeSubscriptions: plan.IncludeSubscriptions,
+		Items:                []*pubsubTopicsItemModel{},
+	}
+
+	err = pubsubClient.Projects.Topics.List("projects/"+clients.project).Pages(ctx,
+		func(page *googlePubsubClient.ListTopicsResponse) error {
+			for _, topic := range page.Topics {
+				if namePattern != nil && !namePattern.MatchString(resourceNameFromSelfLink(topic.Name)) {
+					continue
+				}
+				labels, labelsTfType := labelsValue(topic.Labels)
+				if !matchTags(plan.Labels, labels) {
+					continue
+				}
+				state.Items = append(state.Items, newPubsubTopicsItem(topic, labelsTfType))
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list topics.",
+			err.Error(),
+		)
+		return
+	}
+
+	if plan.IncludeSubscriptions.ValueBool() {
+		for _, item := range state.Items {
+			subscriptions := []attr.Value{}
+			err = pubsubClient.Projects.Topics.Subscriptions.List(item.ID.ValueString()).Pages(ctx,
+				func(page *googlePubsubClient.ListTopicSubscriptionsResponse) error {
+					for _, subscription := range page.Subscriptions {
+						subscriptions = append(subscriptions, types.StringValue(subscription))
+					}
+					return nil
+				})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to list subscriptions of topic.",
+					err.Error(),
+				)
+				return
+			}
+			item.Subscriptions = types.ListValueMust(types.StringType, subscriptions)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newPubsubTopicsItem converts the topic into queried item.
+func newPubsubTopicsItem(topic *googlePubsubClient.Topic, labels types.Map) *pubsubTopicsItemModel {
+	regions := []attr.Value{}
+	if topic.MessageStoragePolicy != nil {
+		for _, region := range topic.MessageStoragePolicy.AllowedPersistenceRegions {
+			regions = append(regions, types.StringValue(region))
+		}
+	}
+
+	item := &pubsubTopicsItemModel{
+		ID:                        types.StringValue(topic.Name),
+		Name:                      types.StringValue(resourceNameFromSelfLink(topic.Name)),
+		Labels:                    labels,
+		MessageRetentionDuration:  types.StringValue(topic.MessageRetentionDuration),
+		KmsKeyName:                types.StringValue(topic.KmsKeyName),
+		AllowedPersistenceRegions: types.ListValueMust(types.StringType, regions),
+		Schema:                    types.StringValue(""),
+		SchemaEncoding:            types.StringValue(""),
+		Subscriptions:             types.ListNull(types.StringType),
+	}
+	if topic.SchemaSettings != nil {
+		item.Schema = types.StringValue(topic.SchemaSettings.Schema)
+		item.SchemaEncoding = types.StringValue(topic.SchemaSettings.Encoding)
+	}
+	return item
+}
@@ -0,0 +1,287 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &RegionalForwardingRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &RegionalForwardingRulesDataSource{}
+)
+
+// NewRegionalForwardingRulesDataSource
+func NewRegionalForwardingRulesDataSource() datasource.DataSource {
+	return &RegionalForwardingRulesDataSource{}
+}
+
+// RegionalForwardingRulesDataSource
+type RegionalForwardingRulesDataSource struct {
+	client *gcpClients
+}
+
+// RegionalForwardingRulesDataSourceModel
+type RegionalForwardingRulesDataSourceModel struct {
+	ClientConfig        *clientConfig                       `tfsdk:"client_config"`
+	Region              types.String                        `tfsdk:"region"`
+	Name                types.String                        `tfsdk:"name"`
+	Tags                types.Map                           `tfsdk:"tags"`
+	IPAddress           types.String                        `tfsdk:"ip_address"`
+	LoadBalancingScheme types.String                        `tfsdk:"load_balancing_scheme"`
+	BackendService      types.String                        `tfsdk:"backend_service"`
+	Items               []*regionalForwardingRulesItemModel `tfsdk:"items"`
+}
+
+type regionalForwardingRulesItemModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Tags                types.Map    `tfsdk:"tags"`
+	IPAddress           types.String `tfsdk:"ip_address"`
+	IPProtocol          types.String `tfsdk:"ip_protocol"`
+	PortRange           types.String `tfsdk:"port_range"`
+	Ports               types.List   `tfsdk:"ports"`
+	AllPorts            types.Bool   `tfsdk:"all_ports"`
+	NetworkTier         types.String `tfsdk:"network_tier"`
+	LoadBalancingScheme types.String `tfsdk:"load_balancing_scheme"`
+	Network             types.String `tfsdk:"network"`
+	Subnetwork          types.String `tfsdk:"subnetwork"`
+	BackendService      types.String `tfsdk:"backend_service"`
+	Target              types.String `tfsdk:"target"`
+	ServiceName         types.String `tfsdk:"service_name"`
+	SelfLink            types.String `tfsdk:"self_link"`
+}
+
+// Metadata returns the data source regional forwarding rules type name.
+func (d *RegionalForwardingRulesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regional_forwarding_rules"
+}
+
+// Schema defines the schema for the regional forwarding rules data source.
+func (d *RegionalForwardingRulesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the regional forwarding rules on Google " +
+			"Cloud, including the internal load balancer forwarding rules.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Description: "Region of forwarding rules to be listed.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of forwarding rule to be filtered.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of forwarding rule to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "IP address of forwarding rule to be filtered.",
+				Optional:    true,
+			},
+			"load_balancing_scheme": schema.StringAttribute{
+				Description: "Load balancing scheme of forwarding rule to be filtered, " +
+					"e.g. INTERNAL or INTERNAL_MANAGED.",
+				Optional: true,
+			},
+			"backend_service": schema.StringAttribute{
+				Description: "Backend service of forwarding rule to be filtered. Either " +
+					"the name, self link or the partial self link of the backend service.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried regional forwarding rules.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of forwarding rule.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of forwarding rule.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Tags of forwarding rule.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "IP address of forwarding rule.",
+							Computed:    true,
+						},
+						"ip_protocol": schema.StringAttribute{
+							Description: "IP protocol of forwarding rule.",
+							Computed:    true,
+						},
+						"port_range": schema.StringAttribute{
+							Description: "Port range of forwarding rule, e.g. 443-443.",
+							Computed:    true,
+						},
+						"ports": schema.ListAttribute{
+							Description: "Ports of forwarding rule, used by internal passthrough load balancers.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"all_ports": schema.BoolAttribute{
+							Description: "Whether all ports are forwarded.",
+							Computed:    true,
+						},
+						"network_tier": schema.StringAttribute{
+							Description: "Network tier of forwarding rule, PREMIUM or STANDARD.",
+							Computed:    true,
+						},
+						"load_balancing_scheme": schema.StringAttribute{
+							Description: "Load balancing scheme of forwarding rule.",
+							Computed:    true,
+						},
+						"network": schema.StringAttribute{
+							Description: "Self link of the network of internal forwarding rule.",
+							Computed:    true,
+						},
+						"subnetwork": schema.StringAttribute{
+							Description: "Self link of the subnetwork of internal forwarding rule.",
+							Computed:    true,
+						},
+						"backend_service": schema.StringAttribute{
+							Description: "Self link of the backend service of forwarding rule.",
+							Computed:    true,
+						},
+						"target": schema.StringAttribute{
+							Description: "Self link of the target of forwarding rule.",
+							Computed:    true,
+						},
+						"service_name": schema.StringAttribute{
+							Description: "Internal DNS name of forwarding rule if service label is set.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of forwarding rule.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RegionalForwardingRulesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read regional forwarding rules data source information
+func (d *RegionalForwardingRulesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *RegionalForwardingRulesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &RegionalForwardingRulesDataSourceModel{
+		Region:              plan.Region,
+		Name:                plan.Name,
+		Tags:                plan.Tags,
+		IPAddress:           plan.IPAddress,
+		LoadBalancingScheme: plan.LoadBalancingScheme,
+		BackendService:      plan.BackendService,
+		Items:               []*regionalForwardingRulesItemModel{},
+	}
+
+	err := clients.computeClient.ForwardingRules.List(clients.project, plan.Region.ValueString()).Pages(ctx,
+		func(page *googleComputeClient.ForwardingRuleList) error {
+			for _, rule := range page.Items {
+				if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != rule.Name {
+					continue
+				}
+				if !(plan.IPAddress.IsUnknown() || plan.IPAddress.IsNull()) &&
+					plan.IPAddress.ValueString() != rule.IPAddress {
+					continue
+				}
+				if !(plan.LoadBalancingScheme.IsUnknown() || plan.LoadBalancingScheme.IsNull()) &&
+					plan.LoadBalancingScheme.ValueString() != rule.LoadBalancingScheme {
+					continue
+				}
+				if !(plan.BackendService.IsUnknown() || plan.BackendService.IsNull()) &&
+					!matchResourceReference(rule.BackendService, plan.BackendService.ValueString()) {
+					continue
+				}
+
+				tags, tagsTfType, diags := descriptionTags(rule.Description)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return nil
+				}
+				if !matchTags(plan.Tags, tags) {
+					continue
+				}
+
+				ports := []attr.Value{}
+				for _, port := range rule.Ports {
+					ports = append(ports, types.StringValue(port))
+				}
+
+				state.Items = append(state.Items, &regionalForwardingRulesItemModel{
+					ID:                  types.Int64Value(int64(rule.Id)),
+					Name:                types.StringValue(rule.Name),
+					Tags:                tagsTfType,
+					IPAddress:           types.StringValue(rule.IPAddress),
+					IPProtocol:          types.StringValue(rule.IPProtocol),
+					PortRange:           types.StringValue(rule.PortRange),
+					Ports:               types.ListValueMust(types.StringType, ports),
+					AllPorts:            types.BoolValue(rule.AllPorts),
+					NetworkTier:         types.StringValue(rule.NetworkTier),
+					LoadBalancingScheme: types.StringValue(rule.LoadBalancingScheme),
+					Network:             types.StringValue(rule.Network),
+					Subnetwork:          types.StringValue(rule.Subnetwork),
+					BackendService:      types.StringValue(rule.BackendService),
+					Target:              types.StringValue(rule.Target),
+					ServiceName:         types.StringValue(rule.ServiceName),
+					SelfLink:            types.StringValue(rule.SelfLink),
+				})
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list regional forwarding rules.",
+			err.Error(),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
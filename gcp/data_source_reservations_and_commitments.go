@@ -0,0 +1,450 @@
+package gcp
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &ReservationsAndCommitmentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ReservationsAndCommitmentsDataSource{}
+)
+
+// NewReservationsAndCommitmentsDataSource
+func NewReservationsAndCommitmentsDataSource() datasource.DataSource {
+	return &ReservationsAndCommitmentsDataSource{}
+}
+
+// ReservationsAndCommitmentsDataSource
+type ReservationsAndCommitmentsDataSource struct {
+	client *gcpClients
+}
+
+// ReservationsAndCommitmentsDataSourceModel
+type ReservationsAndCommitmentsDataSourceModel struct {
+	ClientConfig *clientConfig           `tfsdk:"client_config"`
+	Region       types.String            `tfsdk:"region"`
+	Zone         types.String            `tfsdk:"zone"`
+	MachineType  types.String            `tfsdk:"machine_type"`
+	Reservations []*reservationItemModel `tfsdk:"reservations"`
+	Commitments  []*commitmentItemModel  `tfsdk:"commitments"`
+}
+
+type reservationItemModel struct {
+	ID                          types.Int64  `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	SelfLink                    types.String `tfsdk:"self_link"`
+	Zone                        types.String `tfsdk:"zone"`
+	Status                      types.String `tfsdk:"status"`
+	MachineType                 types.String `tfsdk:"machine_type"`
+	MinCpuPlatform              types.String `tfsdk:"min_cpu_platform"`
+	GuestAccelerators           types.List   `tfsdk:"guest_accelerators"`
+	Count                       types.Int64  `tfsdk:"count"`
+	InUseCount                  types.Int64  `tfsdk:"in_use_count"`
+	AvailableCount              types.Int64  `tfsdk:"available_count"`
+	SpecificReservationRequired types.Bool   `tfsdk:"specific_reservation_required"`
+	ShareType                   types.String `tfsdk:"share_type"`
+	Commitment                  types.String `tfsdk:"commitment"`
+}
+
+type commitmentItemModel struct {
+	ID             types.Int64                    `tfsdk:"id"`
+	Name           types.String                   `tfsdk:"name"`
+	SelfLink       types.String                   `tfsdk:"self_link"`
+	Region         types.String                   `tfsdk:"region"`
+	Status         types.String                   `tfsdk:"status"`
+	Plan           types.String                   `tfsdk:"plan"`
+	Type           types.String                   `tfsdk:"type"`
+	Category       types.String                   `tfsdk:"category"`
+	AutoRenew      types.Bool                     `tfsdk:"auto_renew"`
+	StartTimestamp types.String                   `tfsdk:"start_timestamp"`
+	EndTimestamp   types.String                   `tfsdk:"end_timestamp"`
+	Resources      []*commitmentResourceItemModel `tfsdk:"resources"`
+	Reservations   types.List                     `tfsdk:"reservations"`
+}
+
+type commitmentResourceItemModel struct {
+	Type            types.String `tfsdk:"type"`
+	Amount          types.Int64  `tfsdk:"amount"`
+	AcceleratorType types.String `tfsdk:"accelerator_type"`
+}
+
+// Metadata returns the data source reservations and commitments type name.
+func (d *ReservationsAndCommitmentsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reservations_and_commitments"
+}
+
+// Schema defines the schema for the reservations and commitments data source.
+func (d *ReservationsAndCommitmentsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the compute reservations with their " +
+			"utilization and the committed use discounts on Google Cloud, so capacity " +
+			"planners can target the reserved capacity in instance templates.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Description: "Region of the commitments and the reservations to be " +
+					"queried, including the reservations in the zones of region.",
+				Optional: true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of the reservations to be queried. The commitments " +
+					"of the region of zone are queried.",
+				Optional: true,
+			},
+			"machine_type": schema.StringAttribute{
+				Description: "Machine type of reservation to be filtered, e.g. n2-standard-8.",
+				Optional:    true,
+			},
+			"reservations": schema.ListNestedAttribute{
+				Description: "List of queried reservations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of reservation.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of reservation.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of reservation.",
+							Computed:    true,
+						},
+						"zone": schema.StringAttribute{
+							Description: "Zone of reservation.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of reservation, e.g. READY or CREATING.",
+							Computed:    true,
+						},
+						"machine_type": schema.StringAttribute{
+							Description: "Machine type of the reserved instances.",
+							Computed:    true,
+						},
+						"min_cpu_platform": schema.StringAttribute{
+							Description: "Minimum CPU platform of the reserved instances.",
+							Computed:    true,
+						},
+						"guest_accelerators": schema.ListAttribute{
+							Description: "Accelerators of the reserved instances with the " +
+								"format type:count, e.g. nvidia-tesla-t4:1.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"count": schema.Int64Attribute{
+							Description: "Number of the reserved instances.",
+							Computed:    true,
+						},
+						"in_use_count": schema.Int64Attribute{
+							Description: "Number of the reserved instances in use.",
+							Computed:    true,
+						},
+						"available_count": schema.Int64Attribute{
+							Description: "Number of the reserved instances not in use.",
+							Computed:    true,
+						},
+						"specific_reservation_required": schema.BoolAttribute{
+							Description: "Whether reservation can only be consumed by the " +
+								"instances targeting it by name.",
+							Computed: true,
+						},
+						"share_type": schema.StringAttribute{
+							Description: "Share type of reservation, LOCAL or SPECIFIC_PROJECTS.",
+							Computed:    true,
+						},
+						"commitment": schema.StringAttribute{
+							Description: "Self link of the commitment reservation is attached " +
+								"to, empty if reservation is not attached to a commitment.",
+							Computed: true,
+						},
+					},
+				},
+			},
+			"commitments": schema.ListNestedAttribute{
+				Description: "List of queried commitments. The utilization of commitments " +
+					"is not provided by the Compute Engine API.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of commitment.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of commitment.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of commitment.",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of commitment.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of commitment, e.g. ACTIVE or EXPIRED.",
+							Computed:    true,
+						},
+						"plan": schema.StringAttribute{
+							Description: "Plan of commitment, TWELVE_MONTH or THIRTY_SIX_MONTH.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of commitment, e.g. GENERAL_PURPOSE_N2.",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "Category of commitment, MACHINE or LICENSE.",
+							Computed:    true,
+						},
+						"auto_renew": schema.BoolAttribute{
+							Description: "Whether commitment is renewed automatically.",
+							Computed:    true,
+						},
+						"start_timestamp": schema.StringAttribute{
+							Description: "Start time of commitment.",
+							Computed:    true,
+						},
+						"end_timestamp": schema.StringAttribute{
+							Description: "End time of commitment.",
+							Computed:    true,
+						},
+						"resources": schema.ListNestedAttribute{
+							Description: "Resources committed.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "Type of resource, e.g. VCPU, MEMORY or ACCELERATOR.",
+										Computed:    true,
+									},
+									"amount": schema.Int64Attribute{
+										Description: "Amount of resource, in MB for MEMORY.",
+										Computed:    true,
+									},
+									"accelerator_type": schema.StringAttribute{
+										Description: "Type of the committed accelerator, empty if " +
+											"resource is not ACCELERATOR.",
+										Computed: true,
+									},
+								},
+							},
+						},
+						"reservations": schema.ListAttribute{
+							Description: "Names of the reservations attached to commitment.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ReservationsAndCommitmentsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read reservations and commitments data source information
+func (d *ReservationsAndCommitmentsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ReservationsAndCommitmentsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Zones are named after their region, e.g. asia-east1-a.
+	zone, region := plan.Zone.ValueString(), plan.Region.ValueString()
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		region = zone[:i]
+	}
+
+	state := &ReservationsAndCommitmentsDataSourceModel{
+		Region:       plan.Region,
+		Zone:         plan.Zone,
+		MachineType:  plan.MachineType,
+		Reservations: []*reservationItemModel{},
+		Commitments:  []*commitmentItemModel{},
+	}
+
+	appendReservations := func(reservations []*googleComputeClient.Reservation) {
+		for _, reservation := range reservations {
+			item := newReservationItem(reservation)
+			if !(plan.MachineType.IsUnknown() || plan.MachineType.IsNull()) &&
+				plan.MachineType.ValueString() != item.MachineType.ValueString() {
+				continue
+			}
+			state.Reservations = append(state.Reservations, item)
+		}
+	}
+
+	var err error
+	if zone != "" {
+		err = clients.computeClient.Reservations.List(clients.project, zone).Pages(ctx,
+			func(page *googleComputeClient.ReservationList) error {
+				appendReservations(page.Items)
+				return nil
+			})
+	} else {
+		zonePrefix := "zones/"
+		if region != "" {
+			zonePrefix += region + "-"
+		}
+		err = clients.computeClient.Reservations.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.ReservationAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					if strings.HasPrefix(scope, zonePrefix) {
+						appendReservations(page.Items[scope].Reservations)
+					}
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list reservations.",
+			err.Error(),
+		)
+		return
+	}
+
+	appendCommitments := func(commitments []*googleComputeClient.Commitment) {
+		for _, commitment := range commitments {
+			state.Commitments = append(state.Commitments, newCommitmentItem(commitment))
+		}
+	}
+	if region != "" {
+		err = clients.computeClient.RegionCommitments.List(clients.project, region).Pages(ctx,
+			func(page *googleComputeClient.CommitmentList) error {
+				appendCommitments(page.Items)
+				return nil
+			})
+	} else {
+		err = clients.computeClient.RegionCommitments.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.CommitmentAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendCommitments(page.Items[scope].Commitments)
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list commitments.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newReservationItem converts the reservation into queried item.
+func newReservationItem(reservation *googleComputeClient.Reservation) *reservationItemModel {
+	item := &reservationItemModel{
+		ID:                          types.Int64Value(int64(reservation.Id)),
+		Name:                        types.StringValue(reservation.Name),
+		SelfLink:                    types.StringValue(reservation.SelfLink),
+		Zone:                        types.StringValue(resourceNameFromSelfLink(reservation.Zone)),
+		Status:                      types.StringValue(reservation.Status),
+		MachineType:                 types.StringValue(""),
+		MinCpuPlatform:              types.StringValue(""),
+		GuestAccelerators:           types.ListValueMust(types.StringType, []attr.Value{}),
+		Count:                       types.Int64Value(0),
+		InUseCount:                  types.Int64Value(0),
+		AvailableCount:              types.Int64Value(0),
+		SpecificReservationRequired: types.BoolValue(reservation.SpecificReservationRequired),
+		ShareType:                   types.StringValue(""),
+		Commitment:                  types.StringValue(reservation.Commitment),
+	}
+	if reservation.ShareSettings != nil {
+		item.ShareType = types.StringValue(reservation.ShareSettings.ShareType)
+	}
+
+	specific := reservation.SpecificReservation
+	if specific == nil {
+		return item
+	}
+	item.Count = types.Int64Value(specific.Count)
+	item.InUseCount = types.Int64Value(specific.InUseCount)
+	item.AvailableCount = types.Int64Value(specific.Count - specific.InUseCount)
+	if properties := specific.InstanceProperties; properties != nil {
+		accelerators := []attr.Value{}
+		for _, accelerator := range properties.GuestAccelerators {
+			accelerators = append(accelerators, types.StringValue(
+				accelerator.AcceleratorType+":"+strconv.FormatInt(accelerator.AcceleratorCount, 10)))
+		}
+		item.MachineType = types.StringValue(properties.MachineType)
+		item.MinCpuPlatform = types.StringValue(properties.MinCpuPlatform)
+		item.GuestAccelerators = types.ListValueMust(types.StringType, accelerators)
+	}
+	return item
+}
+
+// newCommitmentItem converts the commitment into queried item.
+func newCommitmentItem(commitment *googleComputeClient.Commitment) *commitmentItemModel {
+	resources := []*commitmentResourceItemModel{}
+	for _, resource := range commitment.Resources {
+		resources = append(resources, &commitmentResourceItemModel{
+			Type:            types.StringValue(resource.Type),
+			Amount:          types.Int64Value(resource.Amount),
+			AcceleratorType: types.StringValue(resource.AcceleratorType),
+		})
+	}
+	reservations := []attr.Value{}
+	for _, reservation := range commitment.Reservations {
+		reservations = append(reservations, types.StringValue(reservation.Name))
+	}
+
+	return &commitmentItemModel{
+		ID:             types.Int64Value(int64(commitment.Id)),
+		Name:           types.StringValue(commitment.Name),
+		SelfLink:       types.StringValue(commitment.SelfLink),
+		Region:         types.StringValue(resourceNameFromSelfLink(commitment.Region)),
+		Status:         types.StringValue(commitment.Status),
+		Plan:           types.StringValue(commitment.Plan),
+		Type:           types.StringValue(commitment.Type),
+		Category:       types.StringValue(commitment.Category),
+		AutoRenew:      types.BoolValue(commitment.AutoRenew),
+		StartTimestamp: types.StringValue(commitment.StartTimestamp),
+		EndTimestamp:   types.StringValue(commitment.EndTimestamp),
+		Resources:      resources,
+		Reservations:   types.ListValueMust(types.StringType, reservations),
+	}
+}
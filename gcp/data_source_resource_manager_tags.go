@@ -0,0 +1,287 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleResourceManagerClient "google.golang.org/api/cloudresourcemanager/v1"
+	googleResourceManagerV3Client "google.golang.org/api/cloudresourcemanager/v3"
+)
+
+var (
+	_ datasource.DataSource              = &ResourceManagerTagsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ResourceManagerTagsDataSource{}
+)
+
+// NewResourceManagerTagsDataSource
+func NewResourceManagerTagsDataSource() datasource.DataSource {
+	return &ResourceManagerTagsDataSource{}
+}
+
+// ResourceManagerTagsDataSource
+type ResourceManagerTagsDataSource struct {
+	client *gcpClients
+}
+
+// ResourceManagerTagsDataSourceModel
+type ResourceManagerTagsDataSourceModel struct {
+	ClientConfig *clientConfig                   `tfsdk:"client_config"`
+	Parents      types.List                      `tfsdk:"parents"`
+	ShortName    types.String                    `tfsdk:"short_name"`
+	Values       types.Map                       `tfsdk:"values"`
+	Items        []*resourceManagerTagsItemModel `tfsdk:"items"`
+}
+
+type resourceManagerTagsItemModel struct {
+	ID             types.String                    `tfsdk:"id"`
+	ShortName      types.String                    `tfsdk:"short_name"`
+	NamespacedName types.String                    `tfsdk:"namespaced_name"`
+	Parent         types.String                    `tfsdk:"parent"`
+	Description    types.String                    `tfsdk:"description"`
+	Purpose        types.String                    `tfsdk:"purpose"`
+	Values         []*resourceManagerTagValueModel `tfsdk:"values"`
+}
+
+type resourceManagerTagValueModel struct {
+	ID             types.String `tfsdk:"id"`
+	ShortName      types.String `tfsdk:"short_name"`
+	NamespacedName types.String `tfsdk:"namespaced_name"`
+	Description    types.String `tfsdk:"description"`
+}
+
+// Metadata returns the data source resource manager tags type name.
+func (d *ResourceManagerTagsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_manager_tags"
+}
+
+// Schema defines the schema for the resource manager tags data source.
+func (d *ResourceManagerTagsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Resource Manager tag keys and their " +
+			"values on Google Cloud, so the tag bindings can look up the IDs of tag " +
+			"values by their human-readable names.",
+		Attributes: map[string]schema.Attribute{
+			"parents": schema.ListAttribute{
+				Description: "Parents of the tag keys to be queried, e.g. " +
+					"organizations/123456789 or projects/my-project. Default to the " +
+					"project and its organization.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"short_name": schema.StringAttribute{
+				Description: "Short name of tag key to be filtered, e.g. env.",
+				Optional:    true,
+			},
+			"values": schema.MapAttribute{
+				Description: "IDs of the queried tag values by their namespaced names, " +
+					"e.g. {\"123456789/env/prod\" = \"tagValues/987654321\"}.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried tag keys.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of tag key, e.g. tagKeys/123456789.",
+							Computed:    true,
+						},
+						"short_name": schema.StringAttribute{
+							Description: "Short name of tag key, e.g. env.",
+							Computed:    true,
+						},
+						"namespaced_name": schema.StringAttribute{
+							Description: "Namespaced name of tag key, e.g. 123456789/env.",
+							Computed:    true,
+						},
+						"parent": schema.StringAttribute{
+							Description: "Parent of tag key, e.g. organizations/123456789.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of tag key.",
+							Computed:    true,
+						},
+						"purpose": schema.StringAttribute{
+							Description: "Purpose of tag key, e.g. GCE_FIREWALL, empty if " +
+								"tag key has no purpose.",
+							Computed: true,
+						},
+						"values": schema.ListNestedAttribute{
+							Description: "Values of tag key.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "ID of tag value, e.g. tagValues/987654321.",
+										Computed:    true,
+									},
+									"short_name": schema.StringAttribute{
+										Description: "Short name of tag value, e.g. prod.",
+										Computed:    true,
+									},
+									"namespaced_name": schema.StringAttribute{
+										Description: "Namespaced name of tag value, e.g. " +
+											"123456789/env/prod.",
+										Computed: true,
+									},
+									"description": schema.StringAttribute{
+										Description: "Description of tag value.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ResourceManagerTagsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read resource manager tags data source information
+func (d *ResourceManagerTagsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ResourceManagerTagsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parents := []string{}
+	if !(plan.Parents.IsUnknown() || plan.Parents.IsNull()) {
+		resp.Diagnostics.Append(plan.Parents.ElementsAs(ctx, &parents, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		resourceManagerClient, err := googleResourceManagerClient.NewService(ctx, clients.clientOptions...)
+		if err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Resource Manager client", err.Error())
+			return
+		}
+		ancestry, err := resourceManagerClient.Projects.GetAncestry(clients.project,
+			&googleResourceManagerClient.GetAncestryRequest{}).Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to get project ancestry.",
+				err.Error(),
+			)
+			return
+		}
+		parents = append(parents, "projects/"+clients.project)
+		for _, ancestor := range ancestry.Ancestor {
+			if ancestor.ResourceId != nil && ancestor.ResourceId.Type == "organization" {
+				parents = append(parents, "organizations/"+ancestor.ResourceId.Id)
+			}
+		}
+	}
+
+	tagsClient, err := googleResourceManagerV3Client.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Resource Manager client", err.Error())
+		return
+	}
+
+	state := &ResourceManagerTagsDataSourceModel{
+		Parents:   plan.Parents,
+		ShortName: plan.ShortName,
+		Items:     []*resourceManagerTagsItemModel{},
+	}
+
+	values := map[string]attr.Value{}
+	for _, parent := range parents {
+		tagKeys := []*googleResourceManagerV3Client.TagKey{}
+		err = tagsClient.TagKeys.List().Parent(parent).Pages(ctx,
+			func(page *googleResourceManagerV3Client.ListTagKeysResponse) error {
+				for _, tagKey := range page.TagKeys {
+					if !(plan.ShortName.IsUnknown() || plan.ShortName.IsNull()) &&
+						plan.ShortName.ValueString() != tagKey.ShortName {
+						continue
+					}
+					tagKeys = append(tagKeys, tagKey)
+				}
+				return nil
+			})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to list tag keys of "+parent+".",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, tagKey := range tagKeys {
+			item := newResourceManagerTagsItem(tagKey)
+			err = tagsClient.TagValues.List().Parent(tagKey.Name).Pages(ctx,
+				func(page *googleResourceManagerV3Client.ListTagValuesResponse) error {
+					for _, tagValue := range page.TagValues {
+						item.Values = append(item.Values, &resourceManagerTagValueModel{
+							ID:             types.StringValue(tagValue.Name),
+							ShortName:      types.StringValue(tagValue.ShortName),
+							NamespacedName: types.StringValue(tagValue.NamespacedName),
+							Description:    types.StringValue(tagValue.Description),
+						})
+						values[tagValue.NamespacedName] = types.StringValue(tagValue.Name)
+					}
+					return nil
+				})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to list tag values of "+tagKey.NamespacedName+".",
+					err.Error(),
+				)
+				return
+			}
+			state.Items = append(state.Items, item)
+		}
+	}
+	state.Values = types.MapValueMust(types.StringType, values)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newResourceManagerTagsItem converts the tag key into queried item without
+// its values.
+func newResourceManagerTagsItem(tagKey *googleResourceManagerV3Client.TagKey) *resourceManagerTagsItemModel {
+	return &resourceManagerTagsItemModel{
+		ID:             types.StringValue(tagKey.Name),
+		ShortName:      types.StringValue(tagKey.ShortName),
+		NamespacedName: types.StringValue(tagKey.NamespacedName),
+		Parent:         types.StringValue(tagKey.Parent),
+		Description:    types.StringValue(tagKey.Description),
+		Purpose:        types.StringValue(tagKey.Purpose),
+		Values:         []*resourceManagerTagValueModel{},
+	}
+}
@@ -0,0 +1,429 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const (
+	resourcePolicyTypeSnapshotSchedule     = "SNAPSHOT_SCHEDULE"
+	resourcePolicyTypeInstanceSchedule     = "INSTANCE_SCHEDULE"
+	resourcePolicyTypeGroupPlacement       = "GROUP_PLACEMENT"
+	resourcePolicyTypeDiskConsistencyGroup = "DISK_CONSISTENCY_GROUP"
+)
+
+var (
+	_ datasource.DataSource              = &ResourcePoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ResourcePoliciesDataSource{}
+)
+
+// NewResourcePoliciesDataSource
+func NewResourcePoliciesDataSource() datasource.DataSource {
+	return &ResourcePoliciesDataSource{}
+}
+
+// ResourcePoliciesDataSource
+type ResourcePoliciesDataSource struct {
+	client *gcpClients
+}
+
+// ResourcePoliciesDataSourceModel
+type ResourcePoliciesDataSourceModel struct {
+	ClientConfig *clientConfig                `tfsdk:"client_config"`
+	Region       types.String                 `tfsdk:"region"`
+	Name         types.String                 `tfsdk:"name"`
+	Type         types.String                 `tfsdk:"type"`
+	Tags         types.Map                    `tfsdk:"tags"`
+	Items        []*resourcePoliciesItemModel `tfsdk:"items"`
+}
+
+type resourcePoliciesItemModel struct {
+	ID                types.Int64                          `tfsdk:"id"`
+	Name              types.String                         `tfsdk:"name"`
+	SelfLink          types.String                         `tfsdk:"self_link"`
+	Tags              types.Map                            `tfsdk:"tags"`
+	Status            types.String                         `tfsdk:"status"`
+	Type              types.String                         `tfsdk:"type"`
+	SnapshotSchedule  *resourcePolicySnapshotScheduleModel `tfsdk:"snapshot_schedule"`
+	InstanceSchedule  *resourcePolicyInstanceScheduleModel `tfsdk:"instance_schedule"`
+	GroupPlacement    *resourcePolicyGroupPlacementModel   `tfsdk:"group_placement"`
+	CreationTimestamp types.String                         `tfsdk:"creation_timestamp"`
+}
+
+type resourcePolicySnapshotScheduleModel struct {
+	Frequency          types.String `tfsdk:"frequency"`
+	Interval           types.Int64  `tfsdk:"interval"`
+	StartTime          types.String `tfsdk:"start_time"`
+	DaysOfWeek         types.List   `tfsdk:"days_of_week"`
+	MaxRetentionDays   types.Int64  `tfsdk:"max_retention_days"`
+	OnSourceDiskDelete types.String `tfsdk:"on_source_disk_delete"`
+	SnapshotLabels     types.Map    `tfsdk:"snapshot_labels"`
+	StorageLocations   types.List   `tfsdk:"storage_locations"`
+	GuestFlush         types.Bool   `tfsdk:"guest_flush"`
+}
+
+type resourcePolicyInstanceScheduleModel struct {
+	VMStartSchedule types.String `tfsdk:"vm_start_schedule"`
+	VMStopSchedule  types.String `tfsdk:"vm_stop_schedule"`
+	TimeZone        types.String `tfsdk:"time_zone"`
+	StartTime       types.String `tfsdk:"start_time"`
+	ExpirationTime  types.String `tfsdk:"expiration_time"`
+}
+
+type resourcePolicyGroupPlacementModel struct {
+	VMCount                 types.Int64  `tfsdk:"vm_count"`
+	AvailabilityDomainCount types.Int64  `tfsdk:"availability_domain_count"`
+	Collocation             types.String `tfsdk:"collocation"`
+}
+
+// Metadata returns the data source resource policies type name.
+func (d *ResourcePoliciesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_policies"
+}
+
+// Schema defines the schema for the resource policies data source.
+func (d *ResourcePoliciesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the compute resource policies of a region " +
+			"on Google Cloud with their schedules, so disks and instances can attach to the " +
+			"shared policies discovered by tags instead of the self link literals.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Description: "Region of resource policies.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of resource policy to be filtered.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of resource policy to be filtered, valid values are " +
+					resourcePolicyTypeSnapshotSchedule + ", " + resourcePolicyTypeInstanceSchedule + ", " +
+					resourcePolicyTypeGroupPlacement + " and " + resourcePolicyTypeDiskConsistencyGroup + ".",
+				Optional: true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of resource policy to be filtered. Resource policies do not " +
+					"support labels, so the tags are parsed from the description with the " +
+					"format TagKey1:TagValue1|TagKey2:TagValue2.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried resource policies.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of resource policy.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of resource policy.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of resource policy, used to attach the " +
+								"resource policy to disks and instances.",
+							Computed: true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Tags of resource policy parsed from the description.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of resource policy, e.g. READY or CREATING.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of resource policy.",
+							Computed:    true,
+						},
+						"snapshot_schedule": schema.SingleNestedAttribute{
+							Description: "Snapshot schedule of resource policy, null unless type is " +
+								resourcePolicyTypeSnapshotSchedule + ".",
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"frequency": schema.StringAttribute{
+									Description: "Frequency of snapshots, HOURLY, DAILY or WEEKLY.",
+									Computed:    true,
+								},
+								"interval": schema.Int64Attribute{
+									Description: "Number of hours between hourly snapshots or " +
+										"number of days between daily snapshots, 0 for weekly snapshots.",
+									Computed: true,
+								},
+								"start_time": schema.StringAttribute{
+									Description: "Start time of hourly and daily snapshots in UTC, e.g. 04:00.",
+									Computed:    true,
+								},
+								"days_of_week": schema.ListAttribute{
+									Description: "Days and start times of weekly snapshots in UTC, " +
+										"e.g. MONDAY@04:00.",
+									ElementType: types.StringType,
+									Computed:    true,
+								},
+								"max_retention_days": schema.Int64Attribute{
+									Description: "Days the snapshots are retained.",
+									Computed:    true,
+								},
+								"on_source_disk_delete": schema.StringAttribute{
+									Description: "Whether the snapshots are kept when source disk is " +
+										"deleted, KEEP_AUTO_SNAPSHOTS or APPLY_RETENTION_POLICY.",
+									Computed: true,
+								},
+								"snapshot_labels": schema.MapAttribute{
+									Description: "Labels applied to the snapshots.",
+									ElementType: types.StringType,
+									Computed:    true,
+								},
+								"storage_locations": schema.ListAttribute{
+									Description: "Storage locations of the snapshots.",
+									ElementType: types.StringType,
+									Computed:    true,
+								},
+								"guest_flush": schema.BoolAttribute{
+									Description: "Whether the snapshots are application consistent.",
+									Computed:    true,
+								},
+							},
+						},
+						"instance_schedule": schema.SingleNestedAttribute{
+							Description: "Instance schedule of resource policy, null unless type is " +
+								resourcePolicyTypeInstanceSchedule + ".",
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"vm_start_schedule": schema.StringAttribute{
+									Description: "Cron expression of starting instances.",
+									Computed:    true,
+								},
+								"vm_stop_schedule": schema.StringAttribute{
+									Description: "Cron expression of stopping instances.",
+									Computed:    true,
+								},
+								"time_zone": schema.StringAttribute{
+									Description: "IANA time zone of the schedules.",
+									Computed:    true,
+								},
+								"start_time": schema.StringAttribute{
+									Description: "Time the schedules take effect in RFC3339 format.",
+									Computed:    true,
+								},
+								"expiration_time": schema.StringAttribute{
+									Description: "Time the schedules expire in RFC3339 format.",
+									Computed:    true,
+								},
+							},
+						},
+						"group_placement": schema.SingleNestedAttribute{
+							Description: "Group placement of resource policy, null unless type is " +
+								resourcePolicyTypeGroupPlacement + ".",
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"vm_count": schema.Int64Attribute{
+									Description: "Number of instances in the placement group.",
+									Computed:    true,
+								},
+								"availability_domain_count": schema.Int64Attribute{
+									Description: "Number of availability domains instances are spread across.",
+									Computed:    true,
+								},
+								"collocation": schema.StringAttribute{
+									Description: "Collocation of instances, COLLOCATED or UNSPECIFIED_COLLOCATION.",
+									Computed:    true,
+								},
+							},
+						},
+						"creation_timestamp": schema.StringAttribute{
+							Description: "Creation time of resource policy.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ResourcePoliciesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read resource policies data source information
+func (d *ResourcePoliciesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ResourcePoliciesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &ResourcePoliciesDataSourceModel{
+		Region: plan.Region,
+		Name:   plan.Name,
+		Type:   plan.Type,
+		Tags:   plan.Tags,
+		Items:  []*resourcePoliciesItemModel{},
+	}
+
+	err := clients.computeClient.ResourcePolicies.List(clients.project, plan.Region.ValueString()).Pages(ctx,
+		func(page *googleComputeClient.ResourcePolicyList) error {
+			for _, policy := range page.Items {
+				if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != policy.Name {
+					continue
+				}
+				item := newResourcePoliciesItem(policy)
+				if !(plan.Type.IsUnknown() || plan.Type.IsNull()) && plan.Type.ValueString() != item.Type.ValueString() {
+					continue
+				}
+
+				tags, tagsTfType, diags := descriptionTags(policy.Description)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return nil
+				}
+				if !matchTags(plan.Tags, tags) {
+					continue
+				}
+				item.Tags = tagsTfType
+				state.Items = append(state.Items, item)
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list resource policies.",
+			err.Error(),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newResourcePoliciesItem converts the resource policy into queried item
+// without tags.
+func newResourcePoliciesItem(policy *googleComputeClient.ResourcePolicy) *resourcePoliciesItemModel {
+	item := &resourcePoliciesItemModel{
+		ID:                types.Int64Value(int64(policy.Id)),
+		Name:              types.StringValue(policy.Name),
+		SelfLink:          types.StringValue(policy.SelfLink),
+		Status:            types.StringValue(policy.Status),
+		Type:              types.StringValue(""),
+		CreationTimestamp: types.StringValue(policy.CreationTimestamp),
+	}
+
+	switch {
+	case policy.SnapshotSchedulePolicy != nil:
+		item.Type = types.StringValue(resourcePolicyTypeSnapshotSchedule)
+		item.SnapshotSchedule = newResourcePolicySnapshotSchedule(policy.SnapshotSchedulePolicy)
+	case policy.InstanceSchedulePolicy != nil:
+		item.Type = types.StringValue(resourcePolicyTypeInstanceSchedule)
+		item.InstanceSchedule = &resourcePolicyInstanceScheduleModel{
+			VMStartSchedule: types.StringValue(""),
+			VMStopSchedule:  types.StringValue(""),
+			TimeZone:        types.StringValue(policy.InstanceSchedulePolicy.TimeZone),
+			StartTime:       types.StringValue(policy.InstanceSchedulePolicy.StartTime),
+			ExpirationTime:  types.StringValue(policy.InstanceSchedulePolicy.ExpirationTime),
+		}
+		if policy.InstanceSchedulePolicy.VmStartSchedule != nil {
+			item.InstanceSchedule.VMStartSchedule = types.StringValue(policy.InstanceSchedulePolicy.VmStartSchedule.Schedule)
+		}
+		if policy.InstanceSchedulePolicy.VmStopSchedule != nil {
+			item.InstanceSchedule.VMStopSchedule = types.StringValue(policy.InstanceSchedulePolicy.VmStopSchedule.Schedule)
+		}
+	case policy.GroupPlacementPolicy != nil:
+		item.Type = types.StringValue(resourcePolicyTypeGroupPlacement)
+		item.GroupPlacement = &resourcePolicyGroupPlacementModel{
+			VMCount:                 types.Int64Value(policy.GroupPlacementPolicy.VmCount),
+			AvailabilityDomainCount: types.Int64Value(policy.GroupPlacementPolicy.AvailabilityDomainCount),
+			Collocation:             types.StringValue(policy.GroupPlacementPolicy.Collocation),
+		}
+	case policy.DiskConsistencyGroupPolicy != nil:
+		item.Type = types.StringValue(resourcePolicyTypeDiskConsistencyGroup)
+	}
+	return item
+}
+
+// newResourcePolicySnapshotSchedule converts the snapshot schedule policy.
+func newResourcePolicySnapshotSchedule(policy *googleComputeClient.ResourcePolicySnapshotSchedulePolicy) *resourcePolicySnapshotScheduleModel {
+	snapshotSchedule := &resourcePolicySnapshotScheduleModel{
+		Frequency:          types.StringValue(""),
+		Interval:           types.Int64Value(0),
+		StartTime:          types.StringValue(""),
+		DaysOfWeek:         types.ListValueMust(types.StringType, []attr.Value{}),
+		MaxRetentionDays:   types.Int64Value(0),
+		OnSourceDiskDelete: types.StringValue(""),
+		SnapshotLabels:     types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		StorageLocations:   types.ListValueMust(types.StringType, []attr.Value{}),
+		GuestFlush:         types.BoolValue(false),
+	}
+
+	if schedule := policy.Schedule; schedule != nil {
+		switch {
+		case schedule.HourlySchedule != nil:
+			snapshotSchedule.Frequency = types.StringValue("HOURLY")
+			snapshotSchedule.Interval = types.Int64Value(schedule.HourlySchedule.HoursInCycle)
+			snapshotSchedule.StartTime = types.StringValue(schedule.HourlySchedule.StartTime)
+		case schedule.DailySchedule != nil:
+			snapshotSchedule.Frequency = types.StringValue("DAILY")
+			snapshotSchedule.Interval = types.Int64Value(schedule.DailySchedule.DaysInCycle)
+			snapshotSchedule.StartTime = types.StringValue(schedule.DailySchedule.StartTime)
+		case schedule.WeeklySchedule != nil:
+			snapshotSchedule.Frequency = types.StringValue("WEEKLY")
+			daysOfWeek := []attr.Value{}
+			for _, day := range schedule.WeeklySchedule.DayOfWeeks {
+				daysOfWeek = append(daysOfWeek, types.StringValue(fmt.Sprintf("%s@%s", day.Day, day.StartTime)))
+			}
+			snapshotSchedule.DaysOfWeek = types.ListValueMust(types.StringType, daysOfWeek)
+		}
+	}
+	if policy.RetentionPolicy != nil {
+		snapshotSchedule.MaxRetentionDays = types.Int64Value(policy.RetentionPolicy.MaxRetentionDays)
+		snapshotSchedule.OnSourceDiskDelete = types.StringValue(policy.RetentionPolicy.OnSourceDiskDelete)
+	}
+	if policy.SnapshotProperties != nil {
+		_, labels := labelsValue(policy.SnapshotProperties.Labels)
+		storageLocations := []attr.Value{}
+		for _, location := range policy.SnapshotProperties.StorageLocations {
+			storageLocations = append(storageLocations, types.StringValue(location))
+		}
+		snapshotSchedule.SnapshotLabels = labels
+		snapshotSchedule.StorageLocations = types.ListValueMust(types.StringType, storageLocations)
+		snapshotSchedule.GuestFlush = types.BoolValue(policy.SnapshotProperties.GuestFlush)
+	}
+	return snapshotSchedule
+}
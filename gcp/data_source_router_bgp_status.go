@@ -0,0 +1,260 @@
+package gcp
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// bgpPeerStatusUp is the status of the BGP session which is established.
+const bgpPeerStatusUp = "UP"
+
+var (
+	_ datasource.DataSource              = &RouterBgpStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &RouterBgpStatusDataSource{}
+)
+
+// NewRouterBgpStatusDataSource
+func NewRouterBgpStatusDataSource() datasource.DataSource {
+	return &RouterBgpStatusDataSource{}
+}
+
+// RouterBgpStatusDataSource
+type RouterBgpStatusDataSource struct {
+	client *gcpClients
+}
+
+// RouterBgpStatusDataSourceModel
+type RouterBgpStatusDataSourceModel struct {
+	ClientConfig *clientConfig               `tfsdk:"client_config"`
+	Router       types.String                `tfsdk:"router"`
+	Region       types.String                `tfsdk:"region"`
+	Name         types.String                `tfsdk:"name"`
+	Status       types.String                `tfsdk:"status"`
+	AllowMissing types.Bool                  `tfsdk:"allow_missing"`
+	Network      types.String                `tfsdk:"network"`
+	AllPeersUp   types.Bool                  `tfsdk:"all_peers_up"`
+	Items        []*routerBgpStatusItemModel `tfsdk:"items"`
+}
+
+type routerBgpStatusItemModel struct {
+	Name                    types.String `tfsdk:"name"`
+	IPAddress               types.String `tfsdk:"ip_address"`
+	PeerIPAddress           types.String `tfsdk:"peer_ip_address"`
+	Status                  types.String `tfsdk:"status"`
+	StatusReason            types.String `tfsdk:"status_reason"`
+	State                   types.String `tfsdk:"state"`
+	UptimeSeconds           types.Int64  `tfsdk:"uptime_seconds"`
+	NumLearnedRoutes        types.Int64  `tfsdk:"num_learned_routes"`
+	NumAdvertisedRoutes     types.Int64  `tfsdk:"num_advertised_routes"`
+	LinkedVpnTunnel         types.String `tfsdk:"linked_vpn_tunnel"`
+	RouterApplianceInstance types.String `tfsdk:"router_appliance_instance"`
+	BfdState                types.String `tfsdk:"bfd_state"`
+}
+
+// Metadata returns the data source router BGP status type name.
+func (d *RouterBgpStatusDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_router_bgp_status"
+}
+
+// Schema defines the schema for the router BGP status data source.
+func (d *RouterBgpStatusDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the status of the BGP sessions of a Cloud Router " +
+			"on Google Cloud, e.g. to check the BGP sessions are established before changing " +
+			"the hybrid connectivity.",
+		Attributes: map[string]schema.Attribute{
+			"router": schema.StringAttribute{
+				Description: "Name of Cloud Router.",
+				Required:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of Cloud Router.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of BGP peer to be filtered.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of BGP session to be filtered, valid values are UP, DOWN and UNKNOWN.",
+				Optional:    true,
+			},
+			"allow_missing": allowMissingAttribute("Cloud Router"),
+			"network": schema.StringAttribute{
+				Description: "Network of Cloud Router.",
+				Computed:    true,
+			},
+			"all_peers_up": schema.BoolAttribute{
+				Description: "Whether the BGP sessions of all the queried BGP peers are UP, " +
+					"false if Cloud Router is not found.",
+				Computed: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried BGP peers.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of BGP peer.",
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "IP address of the local BGP interface.",
+							Computed:    true,
+						},
+						"peer_ip_address": schema.StringAttribute{
+							Description: "IP address of the remote BGP interface.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of BGP session, UP, DOWN or UNKNOWN.",
+							Computed:    true,
+						},
+						"status_reason": schema.StringAttribute{
+							Description: "Reason of the status of BGP session.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "BGP state as specified in RFC 1771, e.g. Established.",
+							Computed:    true,
+						},
+						"uptime_seconds": schema.Int64Attribute{
+							Description: "Seconds the BGP session has been up.",
+							Computed:    true,
+						},
+						"num_learned_routes": schema.Int64Attribute{
+							Description: "Number of routes learned from the BGP peer.",
+							Computed:    true,
+						},
+						"num_advertised_routes": schema.Int64Attribute{
+							Description: "Number of routes advertised to the BGP peer.",
+							Computed:    true,
+						},
+						"linked_vpn_tunnel": schema.StringAttribute{
+							Description: "VPN tunnel of BGP peer.",
+							Computed:    true,
+						},
+						"router_appliance_instance": schema.StringAttribute{
+							Description: "Router appliance instance of BGP peer.",
+							Computed:    true,
+						},
+						"bfd_state": schema.StringAttribute{
+							Description: "Local BFD state of BGP session, empty if BFD is not enabled.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RouterBgpStatusDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read router BGP status data source information
+func (d *RouterBgpStatusDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *RouterBgpStatusDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routerStatus, err := clients.computeClient.Routers.GetRouterStatus(clients.project,
+		plan.Region.ValueString(), plan.Router.ValueString()).Context(ctx).Do()
+	missing := isMissingAllowed(plan.AllowMissing, err)
+	if missing {
+		routerStatus, err = &googleComputeClient.RouterStatusResponse{}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get router status.",
+			err.Error(),
+		)
+		return
+	}
+	result := routerStatus.Result
+	if result == nil {
+		result = &googleComputeClient.RouterStatus{}
+	}
+
+	state := &RouterBgpStatusDataSourceModel{
+		Router:       plan.Router,
+		Region:       plan.Region,
+		Name:         plan.Name,
+		Status:       plan.Status,
+		AllowMissing: plan.AllowMissing,
+		Network:      types.StringValue(result.Network),
+		AllPeersUp:   types.BoolValue(!missing),
+		Items:        []*routerBgpStatusItemModel{},
+	}
+	for _, peerStatus := range result.BgpPeerStatus {
+		if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != peerStatus.Name {
+			continue
+		}
+		if !(plan.Status.IsUnknown() || plan.Status.IsNull()) && plan.Status.ValueString() != peerStatus.Status {
+			continue
+		}
+		if peerStatus.Status != bgpPeerStatusUp {
+			state.AllPeersUp = types.BoolValue(false)
+		}
+		state.Items = append(state.Items, newRouterBgpStatusItem(peerStatus))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newRouterBgpStatusItem converts the BGP peer status into queried item.
+func newRouterBgpStatusItem(peerStatus *googleComputeClient.RouterStatusBgpPeerStatus) *routerBgpStatusItemModel {
+	// The uptime is 0 if the BGP session is not up.
+	uptimeSeconds, _ := strconv.ParseInt(peerStatus.UptimeSeconds, 10, 64)
+	bfdState := ""
+	if peerStatus.BfdStatus != nil {
+		bfdState = peerStatus.BfdStatus.LocalState
+	}
+
+	return &routerBgpStatusItemModel{
+		Name:                    types.StringValue(peerStatus.Name),
+		IPAddress:               types.StringValue(peerStatus.IpAddress),
+		PeerIPAddress:           types.StringValue(peerStatus.PeerIpAddress),
+		Status:                  types.StringValue(peerStatus.Status),
+		StatusReason:            types.StringValue(peerStatus.StatusReason),
+		State:                   types.StringValue(peerStatus.State),
+		UptimeSeconds:           types.Int64Value(uptimeSeconds),
+		NumLearnedRoutes:        types.Int64Value(peerStatus.NumLearnedRoutes),
+		NumAdvertisedRoutes:     types.Int64Value(int64(len(peerStatus.AdvertisedRoutes))),
+		LinkedVpnTunnel:         types.StringValue(peerStatus.LinkedVpnTunnel),
+		RouterApplianceInstance: types.StringValue(peerStatus.RouterApplianceInstance),
+		BfdState:                types.StringValue(bfdState),
+	}
+}
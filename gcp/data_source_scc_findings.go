@@ -0,0 +1,291 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleSecurityCenterClient "google.golang.org/api/securitycenter/v1"
+)
+
+var (
+	_ datasource.DataSource              = &SccFindingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SccFindingsDataSource{}
+)
+
+// NewSccFindingsDataSource
+func NewSccFindingsDataSource() datasource.DataSource {
+	return &SccFindingsDataSource{}
+}
+
+// SccFindingsDataSource
+type SccFindingsDataSource struct {
+	client *gcpClients
+}
+
+// SccFindingsDataSourceModel
+type SccFindingsDataSourceModel struct {
+	ClientConfig  *clientConfig           `tfsdk:"client_config"`
+	Severities    types.List              `tfsdk:"severities"`
+	Categories    types.List              `tfsdk:"categories"`
+	State         types.String            `tfsdk:"state"`
+	ResourceNames types.List              `tfsdk:"resource_names"`
+	IncludeMuted  types.Bool              `tfsdk:"include_muted"`
+	Items         []*sccFindingsItemModel `tfsdk:"items"`
+}
+
+type sccFindingsItemModel struct {
+	ID           types.String `tfsdk:"id"`
+	Category     types.String `tfsdk:"category"`
+	Severity     types.String `tfsdk:"severity"`
+	State        types.String `tfsdk:"state"`
+	Mute         types.String `tfsdk:"mute"`
+	FindingClass types.String `tfsdk:"finding_class"`
+	ResourceName types.String `tfsdk:"resource_name"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Description  types.String `tfsdk:"description"`
+	ExternalURI  types.String `tfsdk:"external_uri"`
+	EventTime    types.String `tfsdk:"event_time"`
+	CreateTime   types.String `tfsdk:"create_time"`
+}
+
+// Metadata returns the data source Security Command Center findings type name.
+func (d *SccFindingsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scc_findings"
+}
+
+// Schema defines the schema for the Security Command Center findings data source.
+func (d *SccFindingsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Security Command Center findings of " +
+			"the project on Google Cloud, so compliance gates can fail the applies when " +
+			"open critical findings exist against the targeted resources, e.g. with a " +
+			"postcondition.",
+		Attributes: map[string]schema.Attribute{
+			"severities": schema.ListAttribute{
+				Description: "Severities of finding to be filtered, e.g. CRITICAL, HIGH, " +
+					"MEDIUM or LOW. Default to all severities.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"categories": schema.ListAttribute{
+				Description: "Categories of finding to be filtered, e.g. PUBLIC_BUCKET_ACL " +
+					"or OPEN_FIREWALL. Default to all categories.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of finding to be filtered, ACTIVE or INACTIVE. Default to ACTIVE.",
+				Optional:    true,
+			},
+			"resource_names": schema.ListAttribute{
+				Description: "Full resource names of the resources the findings are " +
+					"against to be filtered, e.g. " +
+					"//storage.googleapis.com/my-bucket. Default to all resources.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"include_muted": schema.BoolAttribute{
+				Description: "Whether to include the muted findings. Default to false.",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried findings.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of finding.",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "Category of finding.",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "Severity of finding.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of finding.",
+							Computed:    true,
+						},
+						"mute": schema.StringAttribute{
+							Description: "Mute state of finding, e.g. MUTED, UNMUTED or UNDEFINED.",
+							Computed:    true,
+						},
+						"finding_class": schema.StringAttribute{
+							Description: "Class of finding, e.g. VULNERABILITY, MISCONFIGURATION or THREAT.",
+							Computed:    true,
+						},
+						"resource_name": schema.StringAttribute{
+							Description: "Full resource name of the resource finding is against.",
+							Computed:    true,
+						},
+						"resource_type": schema.StringAttribute{
+							Description: "Type of the resource finding is against, e.g. " +
+								"google.cloud.storage.Bucket.",
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of finding.",
+							Computed:    true,
+						},
+						"external_uri": schema.StringAttribute{
+							Description: "URI to the details of finding outside Security Command Center.",
+							Computed:    true,
+						},
+						"event_time": schema.StringAttribute{
+							Description: "Time finding was last detected.",
+							Computed:    true,
+						},
+						"create_time": schema.StringAttribute{
+							Description: "Creation time of finding.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SccFindingsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Security Command Center findings data source information
+func (d *SccFindingsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *SccFindingsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	findingState := "ACTIVE"
+	if !(plan.State.IsUnknown() || plan.State.IsNull()) {
+		findingState = plan.State.ValueString()
+	}
+	filters := []string{fmt.Sprintf("state=%q", findingState)}
+	if !plan.IncludeMuted.ValueBool() {
+		filters = append(filters, `-mute="MUTED"`)
+	}
+	for _, restriction := range []struct {
+		field  string
+		values types.List
+	}{
+		{"severity", plan.Severities},
+		{"category", plan.Categories},
+		{"resource_name", plan.ResourceNames},
+	} {
+		if restriction.values.IsUnknown() || restriction.values.IsNull() {
+			continue
+		}
+		values := []string{}
+		resp.Diagnostics.Append(restriction.values.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(values) > 0 {
+			filters = append(filters, sccFindingsFilter(restriction.field, values))
+		}
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityCenterClient, err := googleSecurityCenterClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Security Command Center client", err.Error())
+		return
+	}
+
+	state := &SccFindingsDataSourceModel{
+		Severities:    plan.Severities,
+		Categories:    plan.Categories,
+		State:         plan.State,
+		ResourceNames: plan.ResourceNames,
+		IncludeMuted:  plan.IncludeMuted,
+		Items:         []*sccFindingsItemModel{},
+	}
+
+	// The source "-" lists the findings of all sources, e.g. Security Health
+	// Analytics, Event Threat Detection and the third-party sources.
+	err = securityCenterClient.Projects.Sources.Findings.List("projects/"+clients.project+"/sources/-").
+		Filter(strings.Join(filters, " AND ")).Pages(ctx,
+		func(page *googleSecurityCenterClient.ListFindingsResponse) error {
+			for _, result := range page.ListFindingsResults {
+				if result.Finding == nil {
+					continue
+				}
+				state.Items = append(state.Items, newSccFindingsItem(result))
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list findings.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// sccFindingsFilter returns the filter matching any of the values of field,
+// e.g. (severity="CRITICAL" OR severity="HIGH").
+func sccFindingsFilter(field string, values []string) string {
+	restrictions := make([]string, 0, len(values))
+	for _, value := range values {
+		restrictions = append(restrictions, fmt.Sprintf("%s=%q", field, value))
+	}
+	return "(" + strings.Join(restrictions, " OR ") + ")"
+}
+
+// newSccFindingsItem converts the finding into queried item.
+func newSccFindingsItem(result *googleSecurityCenterClient.ListFindingsResult) *sccFindingsItemModel {
+	finding := result.Finding
+	item := &sccFindingsItemModel{
+		ID:           types.StringValue(finding.Name),
+		Category:     types.StringValue(finding.Category),
+		Severity:     types.StringValue(finding.Severity),
+		State:        types.StringValue(finding.State),
+		Mute:         types.StringValue(finding.Mute),
+		FindingClass: types.StringValue(finding.FindingClass),
+		ResourceName: types.StringValue(finding.ResourceName),
+		ResourceType: types.StringValue(""),
+		Description:  types.StringValue(finding.Description),
+		ExternalURI:  types.StringValue(finding.ExternalUri),
+		EventTime:    types.StringValue(finding.EventTime),
+		CreateTime:   types.StringValue(finding.CreateTime),
+	}
+	if result.Resource != nil {
+		item.ResourceType = types.StringValue(result.Resource.Type)
+	}
+	return item
+}
@@ -0,0 +1,249 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleSecretManagerClient "google.golang.org/api/secretmanager/v1"
+)
+
+const (
+	secretReplicationAutomatic   = "automatic"
+	secretReplicationUserManaged = "user_managed"
+)
+
+var (
+	_ datasource.DataSource              = &SecretManagerSecretsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SecretManagerSecretsDataSource{}
+)
+
+// NewSecretManagerSecretsDataSource
+func NewSecretManagerSecretsDataSource() datasource.DataSource {
+	return &SecretManagerSecretsDataSource{}
+}
+
+// SecretManagerSecretsDataSource
+type SecretManagerSecretsDataSource struct {
+	client *gcpClients
+}
+
+// SecretManagerSecretsDataSourceModel
+type SecretManagerSecretsDataSourceModel struct {
+	ClientConfig *clientConfig                    `tfsdk:"client_config"`
+	Labels       types.Map                        `tfsdk:"labels"`
+	Items        []*secretManagerSecretsItemModel `tfsdk:"items"`
+}
+
+type secretManagerSecretsItemModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Labels             types.Map    `tfsdk:"labels"`
+	ReplicationPolicy  types.String `tfsdk:"replication_policy"`
+	ReplicaLocations   types.List   `tfsdk:"replica_locations"`
+	CreateTime         types.String `tfsdk:"create_time"`
+	ExpireTime         types.String `tfsdk:"expire_time"`
+	RotationPeriod     types.String `tfsdk:"rotation_period"`
+	NextRotationTime   types.String `tfsdk:"next_rotation_time"`
+	LatestVersion      types.String `tfsdk:"latest_version"`
+	LatestVersionState types.String `tfsdk:"latest_version_state"`
+}
+
+// Metadata returns the data source Secret Manager secrets type name.
+func (d *SecretManagerSecretsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_manager_secrets"
+}
+
+// Schema defines the schema for the Secret Manager secrets data source.
+func (d *SecretManagerSecretsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Secret Manager secrets on Google Cloud " +
+			"with their replication, rotation and latest version, e.g. for rotation " +
+			"tooling to enumerate the secrets owned by a team.",
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.MapAttribute{
+				Description: "Labels of secret to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried secrets.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Resource name of secret.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of secret.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of secret.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"replication_policy": schema.StringAttribute{
+							Description: "Replication policy of secret, " + secretReplicationAutomatic +
+								" or " + secretReplicationUserManaged + ".",
+							Computed: true,
+						},
+						"replica_locations": schema.ListAttribute{
+							Description: "Locations of replicas, empty if replication policy is " +
+								secretReplicationAutomatic + ".",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"create_time": schema.StringAttribute{
+							Description: "Creation time of secret.",
+							Computed:    true,
+						},
+						"expire_time": schema.StringAttribute{
+							Description: "Time secret is deleted, empty if secret never expires.",
+							Computed:    true,
+						},
+						"rotation_period": schema.StringAttribute{
+							Description: "Rotation period of secret, e.g. 2592000s. Empty if " +
+								"rotation is not configured.",
+							Computed: true,
+						},
+						"next_rotation_time": schema.StringAttribute{
+							Description: "Time the next rotation notification is sent.",
+							Computed:    true,
+						},
+						"latest_version": schema.StringAttribute{
+							Description: "Resource name of the latest version, empty if secret has no version.",
+							Computed:    true,
+						},
+						"latest_version_state": schema.StringAttribute{
+							Description: "State of the latest version, e.g. ENABLED, DISABLED or " +
+								"DESTROYED. Empty if secret has no version.",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SecretManagerSecretsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Secret Manager secrets data source information
+func (d *SecretManagerSecretsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *SecretManagerSecretsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretManagerClient, err := googleSecretManagerClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Secret Manager client", err.Error())
+		return
+	}
+
+	state := &SecretManagerSecretsDataSourceModel{
+		Labels: plan.Labels,
+		Items:  []*secretManagerSecretsItemModel{},
+	}
+
+	secrets := []*googleSecretManagerClient.Secret{}
+	err = secretManagerClient.Projects.Secrets.List("projects/"+clients.project).Pages(ctx,
+		func(page *googleSecretManagerClient.ListSecretsResponse) error {
+			secrets = append(secrets, page.Secrets...)
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list secrets.",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, secret := range secrets {
+		labels, labelsTfType := labelsValue(secret.Labels)
+		if !matchTags(plan.Labels, labels) {
+			continue
+		}
+
+		// The latest version is not found if secret has no version.
+		latestVersion, err := secretManagerClient.Projects.Secrets.Versions.Get(secret.Name + "/versions/latest").
+			Context(ctx).Do()
+		if isNotFoundError(err) {
+			latestVersion, err = &googleSecretManagerClient.SecretVersion{}, nil
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to get latest secret version.",
+				err.Error(),
+			)
+			return
+		}
+		state.Items = append(state.Items, newSecretManagerSecretsItem(secret, latestVersion, labelsTfType))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newSecretManagerSecretsItem converts the secret into queried item.
+func newSecretManagerSecretsItem(secret *googleSecretManagerClient.Secret,
+	latestVersion *googleSecretManagerClient.SecretVersion, labels types.Map) *secretManagerSecretsItemModel {
+	replicationPolicy := secretReplicationAutomatic
+	replicaLocations := []attr.Value{}
+	if secret.Replication != nil && secret.Replication.UserManaged != nil {
+		replicationPolicy = secretReplicationUserManaged
+		for _, replica := range secret.Replication.UserManaged.Replicas {
+			replicaLocations = append(replicaLocations, types.StringValue(replica.Location))
+		}
+	}
+
+	item := &secretManagerSecretsItemModel{
+		ID:                 types.StringValue(secret.Name),
+		Name:               types.StringValue(resourceNameFromSelfLink(secret.Name)),
+		Labels:             labels,
+		ReplicationPolicy:  types.StringValue(replicationPolicy),
+		ReplicaLocations:   types.ListValueMust(types.StringType, replicaLocations),
+		CreateTime:         types.StringValue(secret.CreateTime),
+		ExpireTime:         types.StringValue(secret.ExpireTime),
+		RotationPeriod:     types.StringValue(""),
+		NextRotationTime:   types.StringValue(""),
+		LatestVersion:      types.StringValue(latestVersion.Name),
+		LatestVersionState: types.StringValue(latestVersion.State),
+	}
+	if secret.Rotation != nil {
+		item.RotationPeriod = types.StringValue(secret.Rotation.RotationPeriod)
+		item.NextRotationTime = types.StringValue(secret.Rotation.NextRotationTime)
+	}
+	return item
+}
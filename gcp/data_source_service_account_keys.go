@@ -0,0 +1,256 @@
+package gcp
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleIamClient "google.golang.org/api/iam/v1"
+)
+
+const (
+	serviceAccountKeyTypeUserManaged = "USER_MANAGED"
+	// serviceAccountKeyNeverExpires is the expiry time of the keys which
+	// never expire.
+	serviceAccountKeyNeverExpires = "9999-12-31T23:59:59Z"
+)
+
+var (
+	_ datasource.DataSource              = &ServiceAccountKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &ServiceAccountKeysDataSource{}
+)
+
+// NewServiceAccountKeysDataSource
+func NewServiceAccountKeysDataSource() datasource.DataSource {
+	return &ServiceAccountKeysDataSource{}
+}
+
+// ServiceAccountKeysDataSource
+type ServiceAccountKeysDataSource struct {
+	client *gcpClients
+}
+
+// ServiceAccountKeysDataSourceModel
+type ServiceAccountKeysDataSourceModel struct {
+	ClientConfig   *clientConfig                  `tfsdk:"client_config"`
+	ServiceAccount types.String                   `tfsdk:"service_account"`
+	KeyType        types.String                   `tfsdk:"key_type"`
+	MinAgeDays     types.Int64                    `tfsdk:"min_age_days"`
+	AllowMissing   types.Bool                     `tfsdk:"allow_missing"`
+	MaxAgeDays     types.Int64                    `tfsdk:"max_age_days"`
+	Items          []*serviceAccountKeysItemModel `tfsdk:"items"`
+}
+
+type serviceAccountKeysItemModel struct {
+	KeyID           types.String `tfsdk:"key_id"`
+	Name            types.String `tfsdk:"name"`
+	KeyType         types.String `tfsdk:"key_type"`
+	KeyAlgorithm    types.String `tfsdk:"key_algorithm"`
+	KeyOrigin       types.String `tfsdk:"key_origin"`
+	Disabled        types.Bool   `tfsdk:"disabled"`
+	CreateTime      types.String `tfsdk:"create_time"`
+	ExpireTime      types.String `tfsdk:"expire_time"`
+	AgeDays         types.Int64  `tfsdk:"age_days"`
+	DaysUntilExpiry types.Int64  `tfsdk:"days_until_expiry"`
+}
+
+// Metadata returns the data source service account keys type name.
+func (d *ServiceAccountKeysDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account_keys"
+}
+
+// Schema defines the schema for the service account keys data source.
+func (d *ServiceAccountKeysDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the keys of a service account on Google Cloud " +
+			"with their ages, e.g. to fail the plan when keys older than the rotation period exist.",
+		Attributes: map[string]schema.Attribute{
+			"service_account": schema.StringAttribute{
+				Description: "Email of service account.",
+				Required:    true,
+			},
+			"key_type": schema.StringAttribute{
+				Description: "Type of key to be filtered, valid values are USER_MANAGED, " +
+					"SYSTEM_MANAGED and ALL. Default to " + serviceAccountKeyTypeUserManaged + ".",
+				Optional: true,
+			},
+			"min_age_days": schema.Int64Attribute{
+				Description: "Minimum age of key in days to be filtered, e.g. the rotation " +
+					"period to list the keys to be rotated.",
+				Optional: true,
+			},
+			"allow_missing": allowMissingAttribute("service account"),
+			"max_age_days": schema.Int64Attribute{
+				Description: "Age in days of the oldest queried key, 0 if there is no key.",
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried keys.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key_id": schema.StringAttribute{
+							Description: "ID of key.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Resource name of key.",
+							Computed:    true,
+						},
+						"key_type": schema.StringAttribute{
+							Description: "Type of key, USER_MANAGED or SYSTEM_MANAGED.",
+							Computed:    true,
+						},
+						"key_algorithm": schema.StringAttribute{
+							Description: "Algorithm of key, e.g. KEY_ALG_RSA_2048.",
+							Computed:    true,
+						},
+						"key_origin": schema.StringAttribute{
+							Description: "Origin of key, GOOGLE_PROVIDED or USER_PROVIDED.",
+							Computed:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Whether key is disabled.",
+							Computed:    true,
+						},
+						"create_time": schema.StringAttribute{
+							Description: "Creation time of key, i.e. the time key becomes valid.",
+							Computed:    true,
+						},
+						"expire_time": schema.StringAttribute{
+							Description: "Expiry time of key, empty if key never expires.",
+							Computed:    true,
+						},
+						"age_days": schema.Int64Attribute{
+							Description: "Age of key in days.",
+							Computed:    true,
+						},
+						"days_until_expiry": schema.Int64Attribute{
+							Description: "Days until key expires, null if key never expires.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ServiceAccountKeysDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read service account keys data source information
+func (d *ServiceAccountKeysDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ServiceAccountKeysDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iamClient, err := googleIamClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google IAM client", err.Error())
+		return
+	}
+
+	// "-" infers the project from the service account, so that the keys of
+	// the service accounts in other projects can be listed.
+	call := iamClient.Projects.ServiceAccounts.Keys.List("projects/-/serviceAccounts/" +
+		plan.ServiceAccount.ValueString())
+	switch keyType := plan.KeyType.ValueString(); keyType {
+	case "":
+		call = call.KeyTypes(serviceAccountKeyTypeUserManaged)
+	case "ALL":
+	default:
+		call = call.KeyTypes(keyType)
+	}
+	keys, err := call.Context(ctx).Do()
+	if isMissingAllowed(plan.AllowMissing, err) {
+		keys, err = &googleIamClient.ListServiceAccountKeysResponse{}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list service account keys.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := &ServiceAccountKeysDataSourceModel{
+		ServiceAccount: plan.ServiceAccount,
+		KeyType:        plan.KeyType,
+		MinAgeDays:     plan.MinAgeDays,
+		AllowMissing:   plan.AllowMissing,
+		MaxAgeDays:     types.Int64Value(0),
+		Items:          []*serviceAccountKeysItemModel{},
+	}
+
+	now := time.Now()
+	for _, key := range keys.Keys {
+		item := newServiceAccountKeysItem(key, now)
+		if !(plan.MinAgeDays.IsUnknown() || plan.MinAgeDays.IsNull()) &&
+			item.AgeDays.ValueInt64() < plan.MinAgeDays.ValueInt64() {
+			continue
+		}
+		if item.AgeDays.ValueInt64() > state.MaxAgeDays.ValueInt64() {
+			state.MaxAgeDays = item.AgeDays
+		}
+		state.Items = append(state.Items, item)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newServiceAccountKeysItem converts the service account key into queried
+// item.
+func newServiceAccountKeysItem(key *googleIamClient.ServiceAccountKey, now time.Time) *serviceAccountKeysItemModel {
+	item := &serviceAccountKeysItemModel{
+		KeyID:           types.StringValue(resourceNameFromSelfLink(key.Name)),
+		Name:            types.StringValue(key.Name),
+		KeyType:         types.StringValue(key.KeyType),
+		KeyAlgorithm:    types.StringValue(key.KeyAlgorithm),
+		KeyOrigin:       types.StringValue(key.KeyOrigin),
+		Disabled:        types.BoolValue(key.Disabled),
+		CreateTime:      types.StringValue(key.ValidAfterTime),
+		ExpireTime:      types.StringValue(""),
+		AgeDays:         types.Int64Value(0),
+		DaysUntilExpiry: types.Int64Null(),
+	}
+	if createTime, err := time.Parse(time.RFC3339, key.ValidAfterTime); err == nil {
+		item.AgeDays = types.Int64Value(int64(now.Sub(createTime).Hours() / 24))
+	}
+	if key.ValidBeforeTime != "" && key.ValidBeforeTime != serviceAccountKeyNeverExpires {
+		item.ExpireTime = types.StringValue(key.ValidBeforeTime)
+		if expireTime, err := time.Parse(time.RFC3339, key.ValidBeforeTime); err == nil {
+			item.DaysUntilExpiry = types.Int64Value(int64(expireTime.Sub(now).Hours() / 24))
+		}
+	}
+	return item
+}
@@ -0,0 +1,231 @@
+package gcp
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &SslHandshakeInspectionDataSource{}
+	_ datasource.DataSourceWithConfigure = &SslHandshakeInspectionDataSource{}
+)
+
+var tlsVersionNames = map[uint16]string{
+	tls.VersionTLS10: "TLS_1_0",
+	tls.VersionTLS11: "TLS_1_1",
+	tls.VersionTLS12: "TLS_1_2",
+	tls.VersionTLS13: "TLS_1_3",
+}
+
+// NewSslHandshakeInspectionDataSource
+func NewSslHandshakeInspectionDataSource() datasource.DataSource {
+	return &SslHandshakeInspectionDataSource{}
+}
+
+// SslHandshakeInspectionDataSource
+type SslHandshakeInspectionDataSource struct {
+	client *gcpClients
+}
+
+// SslHandshakeInspectionDataSourceModel
+type SslHandshakeInspectionDataSourceModel struct {
+	Host              types.String                              `tfsdk:"host"`
+	Port              types.Int64                               `tfsdk:"port"`
+	ServerName        types.String                              `tfsdk:"server_name"`
+	TimeoutSeconds    types.Int64                               `tfsdk:"timeout_seconds"`
+	TLSVersion        types.String                              `tfsdk:"tls_version"`
+	Verified          types.Bool                                `tfsdk:"verified"`
+	VerificationError types.String                              `tfsdk:"verification_error"`
+	Certificates      []*sslHandshakeInspectionCertificateModel `tfsdk:"certificates"`
+}
+
+type sslHandshakeInspectionCertificateModel struct {
+	Subject           types.String `tfsdk:"subject"`
+	Issuer            types.String `tfsdk:"issuer"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	DNSNames          types.List   `tfsdk:"dns_names"`
+	IPAddresses       types.List   `tfsdk:"ip_addresses"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	NotAfter          types.String `tfsdk:"not_after"`
+	SHA256Fingerprint types.String `tfsdk:"sha256_fingerprint"`
+}
+
+// Metadata returns the data source SSL handshake inspection type name.
+func (d *SslHandshakeInspectionDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_handshake_inspection"
+}
+
+// Schema defines the schema for the SSL handshake inspection data source.
+func (d *SslHandshakeInspectionDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source connects to a host and port from the provider " +
+			"host and provides the certificate chain presented in the TLS handshake.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "Hostname or IP address to connect to.",
+				Required:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port to connect to. Default to " + strconv.Itoa(defaultTLSPort) + ".",
+				Optional:    true,
+			},
+			"server_name": schema.StringAttribute{
+				Description: "Server name indication of the handshake, used to inspect " +
+					"an endpoint by IP. Default to the host.",
+				Optional: true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Timeout in seconds of the handshake. Default to " +
+					strconv.Itoa(defaultProbeTimeoutSecs) + ".",
+				Optional: true,
+			},
+			"tls_version": schema.StringAttribute{
+				Description: "TLS version negotiated, e.g. TLS_1_3.",
+				Computed:    true,
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the chain is trusted by the system roots and " +
+					"valid for the server name.",
+				Computed: true,
+			},
+			"verification_error": schema.StringAttribute{
+				Description: "Error of the chain verification, empty if verified.",
+				Computed:    true,
+			},
+			"certificates": schema.ListNestedAttribute{
+				Description: "Certificate chain presented, starting from the leaf certificate.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subject": schema.StringAttribute{
+							Description: "Subject of certificate.",
+							Computed:    true,
+						},
+						"issuer": schema.StringAttribute{
+							Description: "Issuer of certificate.",
+							Computed:    true,
+						},
+						"serial_number": schema.StringAttribute{
+							Description: "Serial number of certificate in decimal.",
+							Computed:    true,
+						},
+						"dns_names": schema.ListAttribute{
+							Description: "DNS subject alternative names of certificate.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"ip_addresses": schema.ListAttribute{
+							Description: "IP address subject alternative names of certificate.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"not_before": schema.StringAttribute{
+							Description: "Start time of the validity of certificate in RFC3339 format.",
+							Computed:    true,
+						},
+						"not_after": schema.StringAttribute{
+							Description: "End time of the validity of certificate in RFC3339 format.",
+							Computed:    true,
+						},
+						"sha256_fingerprint": schema.StringAttribute{
+							Description: "Hex encoded SHA-256 fingerprint of certificate.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SslHandshakeInspectionDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read SSL handshake inspection data source information
+func (d *SslHandshakeInspectionDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *SslHandshakeInspectionDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port := int64(defaultTLSPort)
+	if !plan.Port.IsNull() {
+		port = plan.Port.ValueInt64()
+	}
+	serverName := plan.Host.ValueString()
+	if plan.ServerName.ValueString() != "" {
+		serverName = plan.ServerName.ValueString()
+	}
+	timeout := time.Duration(defaultProbeTimeoutSecs) * time.Second
+	if !plan.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(plan.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+
+	connState, err := tlsHandshake(ctx, &tlsHandshakeRequest{
+		address:    net.JoinHostPort(plan.Host.ValueString(), strconv.FormatInt(port, 10)),
+		serverName: serverName,
+		timeout:    timeout,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to complete TLS handshake.", err.Error())
+		return
+	}
+
+	state := plan
+	state.TLSVersion = types.StringValue(tlsVersionNames[connState.Version])
+	state.Verified = types.BoolValue(true)
+	state.VerificationError = types.StringValue("")
+	if err := verifyCertificateChain(serverName, connState.PeerCertificates); err != nil {
+		state.Verified = types.BoolValue(false)
+		state.VerificationError = types.StringValue(err.Error())
+	}
+
+	state.Certificates = []*sslHandshakeInspectionCertificateModel{}
+	for _, certificate := range connState.PeerCertificates {
+		dnsNames := []attr.Value{}
+		for _, dnsName := range certificate.DNSNames {
+			dnsNames = append(dnsNames, types.StringValue(dnsName))
+		}
+		ipAddresses := []attr.Value{}
+		for _, ipAddress := range certificate.IPAddresses {
+			ipAddresses = append(ipAddresses, types.StringValue(ipAddress.String()))
+		}
+
+		state.Certificates = append(state.Certificates, &sslHandshakeInspectionCertificateModel{
+			Subject:           types.StringValue(certificate.Subject.String()),
+			Issuer:            types.StringValue(certificate.Issuer.String()),
+			SerialNumber:      types.StringValue(certificate.SerialNumber.String()),
+			DNSNames:          types.ListValueMust(types.StringType, dnsNames),
+			IPAddresses:       types.ListValueMust(types.StringType, ipAddresses),
+			NotBefore:         types.StringValue(certificate.NotBefore.UTC().Format(time.RFC3339)),
+			NotAfter:          types.StringValue(certificate.NotAfter.UTC().Format(time.RFC3339)),
+			SHA256Fingerprint: types.StringValue(certificateFingerprint(certificate)),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,248 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &SslPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &SslPoliciesDataSource{}
+)
+
+// NewSslPoliciesDataSource
+func NewSslPoliciesDataSource() datasource.DataSource {
+	return &SslPoliciesDataSource{}
+}
+
+// SslPoliciesDataSource
+type SslPoliciesDataSource struct {
+	client *gcpClients
+}
+
+// SslPoliciesDataSourceModel
+type SslPoliciesDataSourceModel struct {
+	ClientConfig *clientConfig           `tfsdk:"client_config"`
+	Name         types.String            `tfsdk:"name"`
+	Tags         types.Map               `tfsdk:"tags"`
+	Region       types.String            `tfsdk:"region"`
+	Items        []*sslPoliciesItemModel `tfsdk:"items"`
+}
+
+type sslPoliciesItemModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Tags            types.Map    `tfsdk:"tags"`
+	Region          types.String `tfsdk:"region"`
+	MinTlsVersion   types.String `tfsdk:"min_tls_version"`
+	Profile         types.String `tfsdk:"profile"`
+	CustomFeatures  types.List   `tfsdk:"custom_features"`
+	EnabledFeatures types.List   `tfsdk:"enabled_features"`
+	SelfLink        types.String `tfsdk:"self_link"`
+}
+
+// Metadata returns the data source SSL policies type name.
+func (d *SslPoliciesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_policies"
+}
+
+// Schema defines the schema for the SSL policies data source.
+func (d *SslPoliciesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the SSL policies of the load balancers " +
+			"on Google Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of SSL policy to be filtered.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of SSL policy to be filtered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of SSL policies to be filtered, or global for " +
+					"the global SSL policies. Default to list the SSL policies " +
+					"in all scopes.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried SSL policies.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of SSL policy.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of SSL policy.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Tags of SSL policy.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of SSL policy, empty for global SSL policy.",
+							Computed:    true,
+						},
+						"min_tls_version": schema.StringAttribute{
+							Description: "Minimum TLS version of SSL policy, e.g. TLS_1_2.",
+							Computed:    true,
+						},
+						"profile": schema.StringAttribute{
+							Description: "Profile of SSL policy, COMPATIBLE, MODERN, " +
+								"RESTRICTED or CUSTOM.",
+							Computed: true,
+						},
+						"custom_features": schema.ListAttribute{
+							Description: "Features selected for CUSTOM profile.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"enabled_features": schema.ListAttribute{
+							Description: "Features enabled by SSL policy.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of SSL policy.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SslPoliciesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read SSL policies data source information
+func (d *SslPoliciesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *SslPoliciesDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &SslPoliciesDataSourceModel{
+		Name:   plan.Name,
+		Tags:   plan.Tags,
+		Region: plan.Region,
+		Items:  []*sslPoliciesItemModel{},
+	}
+
+	appendItems := func(policies []*googleComputeClient.SslPolicy) {
+		for _, policy := range policies {
+			if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != policy.Name {
+				continue
+			}
+
+			tags, tagsTfType, diags := descriptionTags(policy.Description)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if !matchTags(plan.Tags, tags) {
+				continue
+			}
+			state.Items = append(state.Items, newSslPoliciesItem(policy, tagsTfType))
+		}
+	}
+
+	var err error
+	switch region := plan.Region.ValueString(); region {
+	case "":
+		err = clients.computeClient.SslPolicies.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.SslPoliciesAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendItems(page.Items[scope].SslPolicies)
+				}
+				return nil
+			})
+	case globalScope:
+		err = clients.computeClient.SslPolicies.List(clients.project).Pages(ctx,
+			func(page *googleComputeClient.SslPoliciesList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	default:
+		err = clients.computeClient.RegionSslPolicies.List(clients.project, region).Pages(ctx,
+			func(page *googleComputeClient.SslPoliciesList) error {
+				appendItems(page.Items)
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list SSL policies.",
+			err.Error(),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newSslPoliciesItem converts the SSL policy into queried item.
+func newSslPoliciesItem(policy *googleComputeClient.SslPolicy,
+	tags types.Map) *sslPoliciesItemModel {
+	customFeatures := []attr.Value{}
+	for _, feature := range policy.CustomFeatures {
+		customFeatures = append(customFeatures, types.StringValue(feature))
+	}
+	enabledFeatures := []attr.Value{}
+	for _, feature := range policy.EnabledFeatures {
+		enabledFeatures = append(enabledFeatures, types.StringValue(feature))
+	}
+
+	return &sslPoliciesItemModel{
+		ID:              types.Int64Value(int64(policy.Id)),
+		Name:            types.StringValue(policy.Name),
+		Tags:            tags,
+		Region:          types.StringValue(resourceNameFromSelfLink(policy.Region)),
+		MinTlsVersion:   types.StringValue(policy.MinTlsVersion),
+		Profile:         types.StringValue(policy.Profile),
+		CustomFeatures:  types.ListValueMust(types.StringType, customFeatures),
+		EnabledFeatures: types.ListValueMust(types.StringType, enabledFeatures),
+		SelfLink:        types.StringValue(policy.SelfLink),
+	}
+}
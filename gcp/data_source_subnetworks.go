@@ -0,0 +1,424 @@
+package gcp
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// subnetworkReservedIPs is the number of addresses reserved by Google Cloud
+// in every primary range: network, gateway, second-to-last and broadcast.
+const subnetworkReservedIPs = 4
+
+var (
+	_ datasource.DataSource              = &SubnetworksDataSource{}
+	_ datasource.DataSourceWithConfigure = &SubnetworksDataSource{}
+)
+
+// NewSubnetworksDataSource
+func NewSubnetworksDataSource() datasource.DataSource {
+	return &SubnetworksDataSource{}
+}
+
+// SubnetworksDataSource
+type SubnetworksDataSource struct {
+	client *gcpClients
+}
+
+// SubnetworksDataSourceModel
+type SubnetworksDataSourceModel struct {
+	ClientConfig *clientConfig           `tfsdk:"client_config"`
+	Name         types.String            `tfsdk:"name"`
+	Network      types.String            `tfsdk:"network"`
+	Purpose      types.String            `tfsdk:"purpose"`
+	Region       types.String            `tfsdk:"region"`
+	Items        []*subnetworksItemModel `tfsdk:"items"`
+}
+
+type subnetworksItemModel struct {
+	ID                types.Int64                     `tfsdk:"id"`
+	Name              types.String                    `tfsdk:"name"`
+	Region            types.String                    `tfsdk:"region"`
+	Network           types.String                    `tfsdk:"network"`
+	Purpose           types.String                    `tfsdk:"purpose"`
+	Role              types.String                    `tfsdk:"role"`
+	IPCidrRange       types.String                    `tfsdk:"ip_cidr_range"`
+	GatewayAddress    types.String                    `tfsdk:"gateway_address"`
+	TotalIPs          types.Int64                     `tfsdk:"total_ips"`
+	AllocatedIPs      types.Int64                     `tfsdk:"allocated_ips"`
+	FreeIPs           types.Int64                     `tfsdk:"free_ips"`
+	SecondaryIPRanges []*subnetworkSecondaryRangeItem `tfsdk:"secondary_ip_ranges"`
+	SelfLink          types.String                    `tfsdk:"self_link"`
+}
+
+type subnetworkSecondaryRangeItem struct {
+	RangeName    types.String `tfsdk:"range_name"`
+	IPCidrRange  types.String `tfsdk:"ip_cidr_range"`
+	TotalIPs     types.Int64  `tfsdk:"total_ips"`
+	AllocatedIPs types.Int64  `tfsdk:"allocated_ips"`
+	FreeIPs      types.Int64  `tfsdk:"free_ips"`
+}
+
+// subnetworkUsage is the IPs allocated from a subnetwork. The single IPs are
+// deduplicated as an address may be reserved and used by a resource at the
+// same time.
+type subnetworkUsage struct {
+	ips            map[string]bool
+	aliasIPs       int64
+	secondaryAlias map[string]int64
+}
+
+// Metadata returns the data source subnetworks type name.
+func (d *SubnetworksDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnetworks"
+}
+
+// Schema defines the schema for the subnetworks data source.
+func (d *SubnetworksDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the subnetworks on Google Cloud with an " +
+			"estimate of the allocated and free IPv4 addresses. The allocated addresses are " +
+			"counted from the network interfaces and alias IP ranges of the instances, the " +
+			"internal forwarding rules and the reserved internal addresses.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of subnetwork to be filtered.",
+				Optional:    true,
+			},
+			"network": schema.StringAttribute{
+				Description: "Self link or name of the network of subnetwork to be filtered.",
+				Optional:    true,
+			},
+			"purpose": schema.StringAttribute{
+				Description: "Purpose of subnetwork to be filtered, e.g. PRIVATE or " +
+					"REGIONAL_MANAGED_PROXY.",
+				Optional: true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of subnetworks to be filtered. Default to list the " +
+					"subnetworks in all regions.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of queried subnetworks.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of subnetwork.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of subnetwork.",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of subnetwork.",
+							Computed:    true,
+						},
+						"network": schema.StringAttribute{
+							Description: "Self link of the network of subnetwork.",
+							Computed:    true,
+						},
+						"purpose": schema.StringAttribute{
+							Description: "Purpose of subnetwork.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the proxy-only subnetwork, ACTIVE or BACKUP.",
+							Computed:    true,
+						},
+						"ip_cidr_range": schema.StringAttribute{
+							Description: "Primary IP CIDR range of subnetwork.",
+							Computed:    true,
+						},
+						"gateway_address": schema.StringAttribute{
+							Description: "Gateway address of subnetwork.",
+							Computed:    true,
+						},
+						"total_ips": schema.Int64Attribute{
+							Description: "Number of usable IPv4 addresses of the primary range.",
+							Computed:    true,
+						},
+						"allocated_ips": schema.Int64Attribute{
+							Description: "Estimated number of IPv4 addresses of the primary range allocated.",
+							Computed:    true,
+						},
+						"free_ips": schema.Int64Attribute{
+							Description: "Estimated number of free IPv4 addresses of the primary range.",
+							Computed:    true,
+						},
+						"secondary_ip_ranges": schema.ListNestedAttribute{
+							Description: "Secondary IP ranges of subnetwork.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"range_name": schema.StringAttribute{
+										Description: "Name of secondary range.",
+										Computed:    true,
+									},
+									"ip_cidr_range": schema.StringAttribute{
+										Description: "IP CIDR range of secondary range.",
+										Computed:    true,
+									},
+									"total_ips": schema.Int64Attribute{
+										Description: "Number of usable IPv4 addresses of secondary range.",
+										Computed:    true,
+									},
+									"allocated_ips": schema.Int64Attribute{
+										Description: "Estimated number of IPv4 addresses of secondary range allocated.",
+										Computed:    true,
+									},
+									"free_ips": schema.Int64Attribute{
+										Description: "Estimated number of free IPv4 addresses of secondary range.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of subnetwork.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SubnetworksDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read subnetworks data source information
+func (d *SubnetworksDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *SubnetworksDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnetworks := []*googleComputeClient.Subnetwork{}
+	appendSubnetworks := func(items []*googleComputeClient.Subnetwork) {
+		for _, subnetwork := range items {
+			if !(plan.Name.IsUnknown() || plan.Name.IsNull()) && plan.Name.ValueString() != subnetwork.Name {
+				continue
+			}
+			if !(plan.Network.IsUnknown() || plan.Network.IsNull()) &&
+				!matchResourceReference(subnetwork.Network, plan.Network.ValueString()) {
+				continue
+			}
+			if !(plan.Purpose.IsUnknown() || plan.Purpose.IsNull()) && plan.Purpose.ValueString() != subnetwork.Purpose {
+				continue
+			}
+			subnetworks = append(subnetworks, subnetwork)
+		}
+	}
+
+	var err error
+	if region := plan.Region.ValueString(); region != "" {
+		err = clients.computeClient.Subnetworks.List(clients.project, region).Pages(ctx,
+			func(page *googleComputeClient.SubnetworkList) error {
+				appendSubnetworks(page.Items)
+				return nil
+			})
+	} else {
+		err = clients.computeClient.Subnetworks.AggregatedList(clients.project).Pages(ctx,
+			func(page *googleComputeClient.SubnetworkAggregatedList) error {
+				for _, scope := range sortedKeys(page.Items) {
+					appendSubnetworks(page.Items[scope].Subnetworks)
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list subnetworks.",
+			err.Error(),
+		)
+		return
+	}
+
+	usages := make(map[string]*subnetworkUsage, len(subnetworks))
+	for _, subnetwork := range subnetworks {
+		usages[subnetwork.SelfLink] = &subnetworkUsage{
+			ips:            map[string]bool{},
+			secondaryAlias: map[string]int64{},
+		}
+	}
+	if len(subnetworks) > 0 {
+		if err := collectSubnetworkUsages(ctx, clients.computeClient, clients.project, usages); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to list the resources allocating IPs from subnetworks.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &SubnetworksDataSourceModel{
+		Name:    plan.Name,
+		Network: plan.Network,
+		Purpose: plan.Purpose,
+		Region:  plan.Region,
+		Items:   []*subnetworksItemModel{},
+	}
+	for _, subnetwork := range subnetworks {
+		state.Items = append(state.Items, newSubnetworksItem(subnetwork, usages[subnetwork.SelfLink]))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// collectSubnetworkUsages counts the IPs allocated from the subnetworks by
+// the instances, the forwarding rules and the reserved addresses.
+func collectSubnetworkUsages(ctx context.Context, client *googleComputeClient.Service,
+	project string, usages map[string]*subnetworkUsage) error {
+	err := client.Instances.AggregatedList(project).Pages(ctx,
+		func(page *googleComputeClient.InstanceAggregatedList) error {
+			for _, scope := range sortedKeys(page.Items) {
+				for _, instance := range page.Items[scope].Instances {
+					for _, networkInterface := range instance.NetworkInterfaces {
+						usage, ok := usages[networkInterface.Subnetwork]
+						if !ok {
+							continue
+						}
+						if networkInterface.NetworkIP != "" {
+							usage.ips[networkInterface.NetworkIP] = true
+						}
+						for _, aliasIPRange := range networkInterface.AliasIpRanges {
+							if aliasIPRange.SubnetworkRangeName == "" {
+								usage.aliasIPs += cidrRangeSize(aliasIPRange.IpCidrRange)
+							} else {
+								usage.secondaryAlias[aliasIPRange.SubnetworkRangeName] +=
+									cidrRangeSize(aliasIPRange.IpCidrRange)
+							}
+						}
+					}
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	err = client.ForwardingRules.AggregatedList(project).Pages(ctx,
+		func(page *googleComputeClient.ForwardingRuleAggregatedList) error {
+			for _, scope := range sortedKeys(page.Items) {
+				for _, rule := range page.Items[scope].ForwardingRules {
+					if usage, ok := usages[rule.Subnetwork]; ok && rule.IPAddress != "" {
+						usage.ips[rule.IPAddress] = true
+					}
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	return client.Addresses.AggregatedList(project).Pages(ctx,
+		func(page *googleComputeClient.AddressAggregatedList) error {
+			for _, scope := range sortedKeys(page.Items) {
+				for _, address := range page.Items[scope].Addresses {
+					if usage, ok := usages[address.Subnetwork]; ok && address.Address != "" {
+						usage.ips[address.Address] = true
+					}
+				}
+			}
+			return nil
+		})
+}
+
+// newSubnetworksItem converts the subnetwork and its usage into queried item.
+func newSubnetworksItem(subnetwork *googleComputeClient.Subnetwork,
+	usage *subnetworkUsage) *subnetworksItemModel {
+	totalIPs := cidrRangeSize(subnetwork.IpCidrRange) - subnetworkReservedIPs
+	if totalIPs < 0 {
+		totalIPs = 0
+	}
+	allocatedIPs := int64(len(usage.ips)) + usage.aliasIPs
+
+	secondaryRanges := []*subnetworkSecondaryRangeItem{}
+	for _, secondaryRange := range subnetwork.SecondaryIpRanges {
+		secondaryTotalIPs := cidrRangeSize(secondaryRange.IpCidrRange)
+		secondaryAllocatedIPs := usage.secondaryAlias[secondaryRange.RangeName]
+		secondaryRanges = append(secondaryRanges, &subnetworkSecondaryRangeItem{
+			RangeName:    types.StringValue(secondaryRange.RangeName),
+			IPCidrRange:  types.StringValue(secondaryRange.IpCidrRange),
+			TotalIPs:     types.Int64Value(secondaryTotalIPs),
+			AllocatedIPs: types.Int64Value(secondaryAllocatedIPs),
+			FreeIPs:      types.Int64Value(freeIPs(secondaryTotalIPs, secondaryAllocatedIPs)),
+		})
+	}
+
+	return &subnetworksItemModel{
+		ID:                types.Int64Value(int64(subnetwork.Id)),
+		Name:              types.StringValue(subnetwork.Name),
+		Region:            types.StringValue(resourceNameFromSelfLink(subnetwork.Region)),
+		Network:           types.StringValue(subnetwork.Network),
+		Purpose:           types.StringValue(subnetwork.Purpose),
+		Role:              types.StringValue(subnetwork.Role),
+		IPCidrRange:       types.StringValue(subnetwork.IpCidrRange),
+		GatewayAddress:    types.StringValue(subnetwork.GatewayAddress),
+		TotalIPs:          types.Int64Value(totalIPs),
+		AllocatedIPs:      types.Int64Value(allocatedIPs),
+		FreeIPs:           types.Int64Value(freeIPs(totalIPs, allocatedIPs)),
+		SecondaryIPRanges: secondaryRanges,
+		SelfLink:          types.StringValue(subnetwork.SelfLink),
+	}
+}
+
+// cidrRangeSize returns the number of IPv4 addresses of the CIDR range. The
+// alias IP ranges may be given as a single IP or a netmask only, e.g. /24.
+func cidrRangeSize(cidrRange string) int64 {
+	bits := 32
+	if i := strings.Index(cidrRange, "/"); i >= 0 {
+		prefix, err := netip.ParsePrefix("0.0.0.0" + cidrRange[i:])
+		if err != nil {
+			return 0
+		}
+		bits = prefix.Bits()
+	}
+	return int64(1) << (32 - bits)
+}
+
+// freeIPs returns the IPs not allocated, which is never negative as the
+// allocated IPs are an estimate.
+func freeIPs(total, allocated int64) int64 {
+	if allocated > total {
+		return 0
+	}
+	return total - allocated
+}
@@ -0,0 +1,208 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleResourceManagerV3Client "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/option"
+)
+
+var (
+	_ datasource.DataSource              = &TagBindingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &TagBindingsDataSource{}
+)
+
+// NewTagBindingsDataSource
+func NewTagBindingsDataSource() datasource.DataSource {
+	return &TagBindingsDataSource{}
+}
+
+// TagBindingsDataSource
+type TagBindingsDataSource struct {
+	client *gcpClients
+}
+
+// TagBindingsDataSourceModel
+type TagBindingsDataSourceModel struct {
+	ClientConfig     *clientConfig           `tfsdk:"client_config"`
+	Resource         types.String            `tfsdk:"resource"`
+	Location         types.String            `tfsdk:"location"`
+	IncludeInherited types.Bool              `tfsdk:"include_inherited"`
+	Tags             types.Map               `tfsdk:"tags"`
+	Items            []*tagBindingsItemModel `tfsdk:"items"`
+}
+
+type tagBindingsItemModel struct {
+	TagKey                 types.String `tfsdk:"tag_key"`
+	TagKeyNamespacedName   types.String `tfsdk:"tag_key_namespaced_name"`
+	TagValue               types.String `tfsdk:"tag_value"`
+	TagValueNamespacedName types.String `tfsdk:"tag_value_namespaced_name"`
+	TagKeyParent           types.String `tfsdk:"tag_key_parent"`
+	Inherited              types.Bool   `tfsdk:"inherited"`
+}
+
+// Metadata returns the data source tag bindings type name.
+func (d *TagBindingsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_bindings"
+}
+
+// Schema defines the schema for the tag bindings data source.
+func (d *TagBindingsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Resource Manager tag values bound to " +
+			"a resource on Google Cloud, e.g. a project, an instance or a bucket, so " +
+			"the governance checks can assert the mandatory tags like cost-center are " +
+			"present.",
+		Attributes: map[string]schema.Attribute{
+			"resource": schema.StringAttribute{
+				Description: "Full resource name of resource, e.g. " +
+					"//cloudresourcemanager.googleapis.com/projects/123456789, " +
+					"//compute.googleapis.com/projects/my-project/zones/asia-east1-a/instances/1234567890 " +
+					"or //storage.googleapis.com/projects/_/buckets/my-bucket.",
+				Required: true,
+			},
+			"location": schema.StringAttribute{
+				Description: "Location of the zonal or regional resource, e.g. asia-east1-a " +
+					"for an instance, whose tag bindings are only available in the " +
+					"location endpoint. Leave it unset for the global resources.",
+				Optional: true,
+			},
+			"include_inherited": schema.BoolAttribute{
+				Description: "Whether to include the tags inherited from the ancestors " +
+					"of resource. Default to false.",
+				Optional: true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Namespaced names of the queried tag values by the namespaced " +
+					"names of their tag keys, e.g. " +
+					"{\"123456789/cost-center\" = \"123456789/cost-center/payments\"}.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of the queried tags of resource.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_key": schema.StringAttribute{
+							Description: "ID of tag key, e.g. tagKeys/123456789.",
+							Computed:    true,
+						},
+						"tag_key_namespaced_name": schema.StringAttribute{
+							Description: "Namespaced name of tag key, e.g. 123456789/cost-center.",
+							Computed:    true,
+						},
+						"tag_value": schema.StringAttribute{
+							Description: "ID of tag value, e.g. tagValues/987654321.",
+							Computed:    true,
+						},
+						"tag_value_namespaced_name": schema.StringAttribute{
+							Description: "Namespaced name of tag value, e.g. " +
+								"123456789/cost-center/payments.",
+							Computed: true,
+						},
+						"tag_key_parent": schema.StringAttribute{
+							Description: "Parent of tag key, e.g. organizations/123456789.",
+							Computed:    true,
+						},
+						"inherited": schema.BoolAttribute{
+							Description: "Whether tag is inherited from the ancestors of resource.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TagBindingsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read tag bindings data source information
+func (d *TagBindingsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *TagBindingsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The tag bindings of the zonal and regional resources are served by the
+	// location endpoint only.
+	clientOptions := append([]option.ClientOption{}, clients.clientOptions...)
+	if location := plan.Location.ValueString(); location != "" {
+		clientOptions = append(clientOptions,
+			option.WithEndpoint("https://"+location+"-cloudresourcemanager.googleapis.com/"))
+	}
+	tagsClient, err := googleResourceManagerV3Client.NewService(ctx, clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Resource Manager client", err.Error())
+		return
+	}
+
+	state := &TagBindingsDataSourceModel{
+		Resource:         plan.Resource,
+		Location:         plan.Location,
+		IncludeInherited: plan.IncludeInherited,
+		Items:            []*tagBindingsItemModel{},
+	}
+
+	tags := map[string]attr.Value{}
+	err = tagsClient.EffectiveTags.List().Parent(plan.Resource.ValueString()).Pages(ctx,
+		func(page *googleResourceManagerV3Client.ListEffectiveTagsResponse) error {
+			for _, tag := range page.EffectiveTags {
+				if tag.Inherited && !plan.IncludeInherited.ValueBool() {
+					continue
+				}
+				state.Items = append(state.Items, &tagBindingsItemModel{
+					TagKey:                 types.StringValue(tag.TagKey),
+					TagKeyNamespacedName:   types.StringValue(tag.NamespacedTagKey),
+					TagValue:               types.StringValue(tag.TagValue),
+					TagValueNamespacedName: types.StringValue(tag.NamespacedTagValue),
+					TagKeyParent:           types.StringValue(tag.TagKeyParentName),
+					Inherited:              types.BoolValue(tag.Inherited),
+				})
+				tags[tag.NamespacedTagKey] = types.StringValue(tag.NamespacedTagValue)
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list tag bindings.",
+			err.Error(),
+		)
+		return
+	}
+	state.Tags = types.MapValueMust(types.StringType, tags)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
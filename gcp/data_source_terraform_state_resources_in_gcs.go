@@ -0,0 +1,295 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleStorageClient "google.golang.org/api/storage/v1"
+)
+
+const terraformStateObjectSuffix = ".tfstate"
+
+var (
+	_ datasource.DataSource              = &TerraformStateResourcesInGcsDataSource{}
+	_ datasource.DataSourceWithConfigure = &TerraformStateResourcesInGcsDataSource{}
+)
+
+// NewTerraformStateResourcesInGcsDataSource
+func NewTerraformStateResourcesInGcsDataSource() datasource.DataSource {
+	return &TerraformStateResourcesInGcsDataSource{}
+}
+
+// TerraformStateResourcesInGcsDataSource
+type TerraformStateResourcesInGcsDataSource struct {
+	client *gcpClients
+}
+
+// TerraformStateResourcesInGcsDataSourceModel
+type TerraformStateResourcesInGcsDataSourceModel struct {
+	ClientConfig *clientConfig                            `tfsdk:"client_config"`
+	Bucket       types.String                             `tfsdk:"bucket"`
+	Prefix       types.String                             `tfsdk:"prefix"`
+	ResourceID   types.String                             `tfsdk:"resource_id"`
+	ResourceType types.String                             `tfsdk:"resource_type"`
+	AllowMissing types.Bool                               `tfsdk:"allow_missing"`
+	Items        []*terraformStateResourcesInGcsItemModel `tfsdk:"items"`
+}
+
+type terraformStateResourcesInGcsItemModel struct {
+	StateObject types.String `tfsdk:"state_object"`
+	Workspace   types.String `tfsdk:"workspace"`
+	Address     types.String `tfsdk:"address"`
+	Type        types.String `tfsdk:"type"`
+	ID          types.String `tfsdk:"id"`
+	SelfLink    types.String `tfsdk:"self_link"`
+}
+
+// terraformState is the subset of Terraform state file format version 4
+// used to extract the managed resources.
+type terraformState struct {
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   interface{} `json:"index_key"`
+			Attributes struct {
+				ID       string `json:"id"`
+				SelfLink string `json:"self_link"`
+			} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// Metadata returns the data source Terraform state resources type name.
+func (d *TerraformStateResourcesInGcsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terraform_state_resources_in_gcs"
+}
+
+// Schema defines the schema for the Terraform state resources data source.
+func (d *TerraformStateResourcesInGcsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the resources managed by Terraform " +
+			"states stored in a GCS backend bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "GCS bucket of the Terraform backend.",
+				Required:    true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "Object prefix of the Terraform states to be listed.",
+				Optional:    true,
+			},
+			"resource_id": schema.StringAttribute{
+				Description: "ID or self link of resource to be filtered. Self links " +
+					"are also matched by suffix, e.g. global/backendServices/web-prod.",
+				Optional: true,
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "Terraform resource type to be filtered, e.g. google_compute_backend_service.",
+				Optional:    true,
+			},
+			"allow_missing": allowMissingAttribute("bucket"),
+			"items": schema.ListNestedAttribute{
+				Description: "List of resources managed by the Terraform states.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"state_object": schema.StringAttribute{
+							Description: "GCS object name of the Terraform state.",
+							Computed:    true,
+						},
+						"workspace": schema.StringAttribute{
+							Description: "Terraform workspace of the state.",
+							Computed:    true,
+						},
+						"address": schema.StringAttribute{
+							Description: "Terraform resource address.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Terraform resource type.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "ID of resource.",
+							Computed:    true,
+						},
+						"self_link": schema.StringAttribute{
+							Description: "Self link of resource, empty if the resource has no self link.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TerraformStateResourcesInGcsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read Terraform state resources data source information
+func (d *TerraformStateResourcesInGcsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *TerraformStateResourcesInGcsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, clients.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	state := &TerraformStateResourcesInGcsDataSourceModel{
+		Bucket:       plan.Bucket,
+		Prefix:       plan.Prefix,
+		ResourceID:   plan.ResourceID,
+		ResourceType: plan.ResourceType,
+		AllowMissing: plan.AllowMissing,
+		Items:        []*terraformStateResourcesInGcsItemModel{},
+	}
+
+	bucket := plan.Bucket.ValueString()
+	err = storageClient.Objects.List(bucket).Prefix(plan.Prefix.ValueString()).Pages(ctx,
+		func(page *googleStorageClient.Objects) error {
+			for _, object := range page.Items {
+				if !strings.HasSuffix(object.Name, terraformStateObjectSuffix) {
+					continue
+				}
+
+				tfState, err := readTerraformState(ctx, storageClient, bucket, object.Name)
+				// The state may be deleted after it is listed.
+				if isMissingAllowed(plan.AllowMissing, err) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				state.Items = append(state.Items, filterTerraformStateResources(plan, object.Name, tfState)...)
+			}
+			return nil
+		})
+	if isMissingAllowed(plan.AllowMissing, err) {
+		state.Items, err = []*terraformStateResourcesInGcsItemModel{}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list Terraform state resources.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// readTerraformState downloads and parses the Terraform state object.
+func readTerraformState(ctx context.Context, client *googleStorageClient.Service,
+	bucket, objectName string) (*terraformState, error) {
+	httpResp, err := client.Objects.Get(bucket, objectName).Context(ctx).Download()
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", objectName, err)
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", objectName, err)
+	}
+
+	tfState := &terraformState{}
+	if err := json.Unmarshal(body, tfState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %v", objectName, err)
+	}
+	return tfState, nil
+}
+
+// filterTerraformStateResources returns the managed resources of the state
+// matching the resource ID and type filters.
+func filterTerraformStateResources(plan *TerraformStateResourcesInGcsDataSourceModel,
+	objectName string, tfState *terraformState) []*terraformStateResourcesInGcsItemModel {
+	resourceID := plan.ResourceID.ValueString()
+	resourceType := plan.ResourceType.ValueString()
+	workspace := strings.TrimSuffix(path.Base(objectName), terraformStateObjectSuffix)
+
+	items := []*terraformStateResourcesInGcsItemModel{}
+	for _, res := range tfState.Resources {
+		if res.Mode != "managed" {
+			continue
+		}
+		if resourceType != "" && res.Type != resourceType {
+			continue
+		}
+
+		for _, instance := range res.Instances {
+			id := instance.Attributes.ID
+			selfLink := instance.Attributes.SelfLink
+			if resourceID != "" && id != resourceID && selfLink != resourceID &&
+				!strings.HasSuffix(selfLink, "/"+strings.TrimPrefix(resourceID, "/")) {
+				continue
+			}
+
+			items = append(items, &terraformStateResourcesInGcsItemModel{
+				StateObject: types.StringValue(objectName),
+				Workspace:   types.StringValue(workspace),
+				Address:     types.StringValue(terraformResourceAddress(res.Module, res.Type, res.Name, instance.IndexKey)),
+				Type:        types.StringValue(res.Type),
+				ID:          types.StringValue(id),
+				SelfLink:    types.StringValue(selfLink),
+			})
+		}
+	}
+	return items
+}
+
+// terraformResourceAddress returns the address of a resource instance, e.g.
+// module.lb.google_compute_backend_service.web["prod"].
+func terraformResourceAddress(module, resourceType, name string, indexKey interface{}) string {
+	address := resourceType + "." + name
+	if module != "" {
+		address = module + "." + address
+	}
+	switch key := indexKey.(type) {
+	case string:
+		address += fmt.Sprintf("[%q]", key)
+	case float64:
+		address += fmt.Sprintf("[%d]", int64(key))
+	}
+	return address
+}
@@ -0,0 +1,242 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+var (
+	_ datasource.DataSource              = &ZonesRegionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ZonesRegionsDataSource{}
+)
+
+// NewZonesRegionsDataSource
+func NewZonesRegionsDataSource() datasource.DataSource {
+	return &ZonesRegionsDataSource{}
+}
+
+// ZonesRegionsDataSource
+type ZonesRegionsDataSource struct {
+	client *gcpClients
+}
+
+// ZonesRegionsDataSourceModel
+type ZonesRegionsDataSourceModel struct {
+	ClientConfig *clientConfig       `tfsdk:"client_config"`
+	RegionPrefix types.String        `tfsdk:"region_prefix"`
+	Status       types.String        `tfsdk:"status"`
+	Regions      []*regionsItemModel `tfsdk:"regions"`
+	Zones        []*zonesItemModel   `tfsdk:"zones"`
+}
+
+type regionsItemModel struct {
+	Name        types.String `tfsdk:"name"`
+	Status      types.String `tfsdk:"status"`
+	Zones       types.List   `tfsdk:"zones"`
+	SupportsPzs types.Bool   `tfsdk:"supports_pzs"`
+}
+
+type zonesItemModel struct {
+	Name                  types.String `tfsdk:"name"`
+	Region                types.String `tfsdk:"region"`
+	Status                types.String `tfsdk:"status"`
+	AvailableCpuPlatforms types.List   `tfsdk:"available_cpu_platforms"`
+	SupportsPzs           types.Bool   `tfsdk:"supports_pzs"`
+}
+
+// Metadata returns the data source zones and regions type name.
+func (d *ZonesRegionsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zones_regions"
+}
+
+// Schema defines the schema for the zones and regions data source.
+func (d *ZonesRegionsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the regions and zones available to the " +
+			"project on Google Cloud with their status and supported features, so " +
+			"multi-region modules can compute the placements instead of hard-coding them.",
+		Attributes: map[string]schema.Attribute{
+			"region_prefix": schema.StringAttribute{
+				Description: "Prefix of the name of region to be filtered, e.g. asia- or us-east.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of region and zone to be filtered, UP or DOWN.",
+				Optional:    true,
+			},
+			"regions": schema.ListNestedAttribute{
+				Description: "List of queried regions.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of region.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of region, UP or DOWN.",
+							Computed:    true,
+						},
+						"zones": schema.ListAttribute{
+							Description: "Names of the queried zones in region.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"supports_pzs": schema.BoolAttribute{
+							Description: "Whether region supports physical zone separation.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"zones": schema.ListNestedAttribute{
+				Description: "List of queried zones.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of zone.",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Name of the region of zone.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of zone, UP or DOWN.",
+							Computed:    true,
+						},
+						"available_cpu_platforms": schema.ListAttribute{
+							Description: "CPU platforms available in zone, e.g. Intel Ice Lake " +
+								"or AMD Milan.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"supports_pzs": schema.BoolAttribute{
+							Description: "Whether zone supports physical zone separation.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigBlock(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ZonesRegionsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*gcpClients)
+}
+
+// Read zones and regions data source information
+func (d *ZonesRegionsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ZonesRegionsDataSourceModel
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, diags := d.client.withClientConfig(ctx, plan.ClientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &ZonesRegionsDataSourceModel{
+		RegionPrefix: plan.RegionPrefix,
+		Status:       plan.Status,
+		Regions:      []*regionsItemModel{},
+		Zones:        []*zonesItemModel{},
+	}
+
+	matchRegion := func(region string) bool {
+		return strings.HasPrefix(region, plan.RegionPrefix.ValueString())
+	}
+	matchStatus := func(status string) bool {
+		return plan.Status.IsUnknown() || plan.Status.IsNull() || plan.Status.ValueString() == status
+	}
+
+	// Queried zones by region.
+	regionZones := map[string][]attr.Value{}
+	err := clients.computeClient.Zones.List(clients.project).Pages(ctx,
+		func(page *googleComputeClient.ZoneList) error {
+			for _, zone := range page.Items {
+				region := resourceNameFromSelfLink(zone.Region)
+				if !matchRegion(region) || !matchStatus(zone.Status) {
+					continue
+				}
+				cpuPlatforms := []attr.Value{}
+				for _, cpuPlatform := range zone.AvailableCpuPlatforms {
+					cpuPlatforms = append(cpuPlatforms, types.StringValue(cpuPlatform))
+				}
+				state.Zones = append(state.Zones, &zonesItemModel{
+					Name:                  types.StringValue(zone.Name),
+					Region:                types.StringValue(region),
+					Status:                types.StringValue(zone.Status),
+					AvailableCpuPlatforms: types.ListValueMust(types.StringType, cpuPlatforms),
+					SupportsPzs:           types.BoolValue(zone.SupportsPzs),
+				})
+				regionZones[region] = append(regionZones[region], types.StringValue(zone.Name))
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list zones.",
+			err.Error(),
+		)
+		return
+	}
+
+	err = clients.computeClient.Regions.List(clients.project).Pages(ctx,
+		func(page *googleComputeClient.RegionList) error {
+			for _, region := range page.Items {
+				if !matchRegion(region.Name) || !matchStatus(region.Status) {
+					continue
+				}
+				zones := regionZones[region.Name]
+				if zones == nil {
+					zones = []attr.Value{}
+				}
+				state.Regions = append(state.Regions, &regionsItemModel{
+					Name:        types.StringValue(region.Name),
+					Status:      types.StringValue(region.Status),
+					Zones:       types.ListValueMust(types.StringType, zones),
+					SupportsPzs: types.BoolValue(region.SupportsPzs),
+				})
+			}
+			return nil
+		})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to list regions.",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
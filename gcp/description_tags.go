@@ -0,0 +1,56 @@
+package gcp
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// descriptionTags converts the description of resources without tagging
+// support into tags, with the format TagKey1:TagValue1|TagKey2:TagValue2.
+// A null map is returned if the description is empty.
+func descriptionTags(description string) (map[string]attr.Value, types.Map, diag.Diagnostics) {
+	tags := make(map[string]attr.Value)
+	if description == "" {
+		return tags, types.MapNull(types.StringType), nil
+	}
+
+	for _, tag := range strings.Split(description, "|") {
+		t := strings.SplitN(tag, ":", 2)
+		if len(t) != 2 {
+			continue
+		}
+		tags[t[0]] = types.StringValue(t[1])
+	}
+
+	tagsTfType, diags := types.MapValue(types.StringType, tags)
+	return tags, tagsTfType, diags
+}
+
+// matchTags returns true if all the input tags are found in tags with the
+// same values. Null or unknown input tags match everything.
+func matchTags(input types.Map, tags map[string]attr.Value) bool {
+	if input.IsUnknown() || input.IsNull() {
+		return true
+	}
+
+	for inputKey, inputValue := range input.Elements() {
+		value, ok := tags[inputKey]
+		if !ok || !value.Equal(inputValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// labelsValue converts the labels of resources with tagging support into the
+// same form as descriptionTags, so they can be filtered by matchTags.
+func labelsValue(labels map[string]string) (map[string]attr.Value, types.Map) {
+	values := make(map[string]attr.Value, len(labels))
+	for key, value := range labels {
+		values[key] = types.StringValue(value)
+	}
+	return values, types.MapValueMust(types.StringType, values)
+}
@@ -0,0 +1,48 @@
+package gcp
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"google.golang.org/api/googleapi"
+)
+
+// isNotFoundError reports whether the error is a HTTP 404 returned by the
+// Google Cloud API.
+func isNotFoundError(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+// isPreconditionFailedError reports whether the error is a HTTP 412 returned
+// by the Google Cloud API.
+func isPreconditionFailedError(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusPreconditionFailed
+}
+
+// isConflictError reports whether the error is a HTTP 409 returned by the
+// Google Cloud API.
+func isConflictError(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict
+}
+
+// allowMissingAttribute returns the schema of allow_missing attribute for the
+// data sources looking up a remote object which may be deleted out of band.
+func allowMissingAttribute(object string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Return empty results instead of failing if the " + object +
+			" is not found. Default to false.",
+		Optional: true,
+	}
+}
+
+// isMissingAllowed reports whether the error is a HTTP 404 ignored by the
+// allow_missing attribute of data sources.
+func isMissingAllowed(allowMissing types.Bool, err error) bool {
+	return allowMissing.ValueBool() && isNotFoundError(err)
+}
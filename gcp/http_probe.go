@@ -0,0 +1,83 @@
+package gcp
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultProbeTimeoutSecs     = 5
+	defaultProbeExpectedStatus  = http.StatusOK
+	defaultProbePath            = "/"
+	maxProbeResponseBodyInBytes = 1 << 20
+)
+
+// httpProbeRequest describes a HTTP(S) request sent from the provider host.
+type httpProbeRequest struct {
+	url                string
+	host               string
+	timeout            time.Duration
+	insecureSkipVerify bool
+}
+
+// httpProbeResult is the result of a HTTP(S) probe. The error is set if the
+// request failed without a response.
+type httpProbeResult struct {
+	statusCode int
+	latency    time.Duration
+	body       string
+	err        error
+}
+
+// probeHTTP sends a GET request and measures the latency until the response
+// headers are received. The Host header and the TLS server name are
+// overridden by the host if it is set, so that endpoints can be probed by IP.
+func probeHTTP(ctx context.Context, probe *httpProbeRequest) *httpProbeResult {
+	result := &httpProbeResult{}
+
+	ctx, cancel := context.WithTimeout(ctx, probe.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.url, nil)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: probe.insecureSkipVerify} // nolint:gosec
+	if probe.host != "" {
+		req.Host = probe.host
+		tlsConfig.ServerName = probe.host
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:   tlsConfig,
+			DisableKeepAlives: true,
+		},
+		// The redirect responses are the results to be checked.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	result.latency = time.Since(start)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	defer resp.Body.Close()
+
+	result.statusCode = resp.StatusCode
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxProbeResponseBodyInBytes))
+	if err != nil {
+		result.err = err
+		return result
+	}
+	result.body = string(body)
+	return result
+}
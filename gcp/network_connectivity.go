@@ -0,0 +1,49 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	googleNetworkConnectivityClient "google.golang.org/api/networkconnectivity/v1"
+)
+
+// waitNetworkConnectivityOperation waits until the Network Connectivity
+// operation is done, and returns the error of the operation if it failed.
+func waitNetworkConnectivityOperation(ctx context.Context, client *googleNetworkConnectivityClient.Service,
+	op *googleNetworkConnectivityClient.GoogleLongrunningOperation) error {
+	var err error
+	for !op.Done {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+		op, err = client.Projects.Locations.Operations.Get(op.Name).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+
+	if op.Error != nil {
+		return fmt.Errorf("operation %s failed: %d: %s", op.Name, op.Error.Code, op.Error.Message)
+	}
+	return nil
+}
+
+// networkConnectivityParent returns the parent of the Network Connectivity
+// resources in the location, which is global for the hubs, the internal
+// ranges and the VPC spokes.
+func networkConnectivityParent(project, location string) string {
+	return fmt.Sprintf("projects/%s/locations/%s", project, location)
+}
+
+// nccHubName returns the resource name of hub, hub can be either the name
+// or the resource name.
+func nccHubName(project, hub string) string {
+	if strings.Contains(hub, "/") {
+		return hub
+	}
+	return networkConnectivityParent(project, globalScope) + "/hubs/" + hub
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,9 +20,30 @@ import (
 type gcpClients struct {
 	project         string
 	credentialsJSON []byte
+	clientOptions   []option.ClientOption
 	computeClient   *googleComputeClient.Service
+	readOnly        bool
+	changeReference string
+	metrics         *apiMetrics
 }
 
+// checkReadOnly adds an error to the diagnostics and returns true if the
+// provider is in read-only mode, in which resources must not be mutated.
+func (c *gcpClients) checkReadOnly(diags *diag.Diagnostics, operation string) bool {
+	if !c.readOnly {
+		return false
+	}
+	diags.AddError(
+		"[READ ONLY] Resource mutation is not allowed",
+		"The provider is configured with read_only = true, unable to "+operation+
+			" the resource. Data sources and refresh are still allowed. Unset "+
+			"read_only in the provider configuration to apply changes.",
+	)
+	return true
+}
+
+const providerTypeName = "st-gcp"
+
 // Ensure the implementation satisfies the expected interfaces
 var (
 	_ provider.Provider = &googleCloudProvider{}
@@ -35,13 +57,16 @@ func New() provider.Provider {
 type googleCloudProvider struct{}
 
 type googleCloudProviderModel struct {
-	Project     types.String `tfsdk:"project"`
-	Credentials types.String `tfsdk:"credentials"`
+	Project         types.String `tfsdk:"project"`
+	Credentials     types.String `tfsdk:"credentials"`
+	ReadOnly        types.Bool   `tfsdk:"read_only"`
+	ChangeReference types.String `tfsdk:"change_reference"`
+	MetricsFile     types.String `tfsdk:"metrics_file"`
 }
 
 // Metadata returns the provider type name.
 func (p *googleCloudProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
-	resp.TypeName = "st-gcp"
+	resp.TypeName = providerTypeName
 }
 
 // Schema defines the provider-level schema for configuration data.
@@ -66,6 +91,32 @@ func (p *googleCloudProvider) Schema(_ context.Context, _ provider.SchemaRequest
 				Optional:  true,
 				Sensitive: true,
 			},
+			"read_only": schema.BoolAttribute{
+				Description: "Turn every resource mutation into an error while data " +
+					"sources keep working, e.g. for audit workspaces and production " +
+					"freeze windows. Default to false.",
+				Optional: true,
+			},
+			"change_reference": schema.StringAttribute{
+				Description: "Change ticket ID to be traced in the GCP audit logs. It " +
+					"is sent as the request reason of every API call, and recorded in " +
+					"the labels or metadata of resources created or updated by this " +
+					"provider where possible. It is not recorded in the descriptions of " +
+					"log-based metrics, firewall rules and Cloud Armor rules, which are " +
+					"managed by the configuration and read back for drift detection. " +
+					"May also be provided via GOOGLE_CHANGE_REFERENCE environment variable.",
+				Optional: true,
+			},
+			"metrics_file": schema.StringAttribute{
+				Description: "Path of the file to write the metrics of the Google Cloud " +
+					"API calls in the Prometheus text format, i.e. the request counts, " +
+					"latencies, retries and rate limit hits of each service. The file is " +
+					"replaced atomically when the provider process exits, and can be " +
+					"collected by the textfile collector of node_exporter. Metrics are " +
+					"disabled if not set. May also be provided via " +
+					"GOOGLE_PROVIDER_METRICS_FILE environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -73,6 +124,9 @@ func (p *googleCloudProvider) Schema(_ context.Context, _ provider.SchemaRequest
 // Configure prepares a GCP API client for data sources and resources.
 // nolint:lll
 func (p *googleCloudProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	ctx, span := startSpan(ctx, "provider.Configure")
+	defer func() { endSpan(span, resp.Diagnostics) }()
+
 	var config googleCloudProviderModel
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
@@ -115,25 +169,35 @@ func (p *googleCloudProvider) Configure(ctx context.Context, req provider.Config
 	if credentialsContent == nil {
 		return
 	}
-	googleClientOption := option.WithCredentialsJSON(credentialsContent)
-	computeService, err := googleComputeClient.NewService(ctx, googleClientOption)
-	if err != nil {
+	changeReference := config.ChangeReference.ValueString()
+	if config.ChangeReference.IsNull() {
+		changeReference = os.Getenv("GOOGLE_CHANGE_REFERENCE")
+	}
+
+	metricsFile := config.MetricsFile.ValueString()
+	if config.MetricsFile.IsNull() {
+		metricsFile = os.Getenv("GOOGLE_PROVIDER_METRICS_FILE")
+	}
+
+	clients := &gcpClients{
+		project:         project,
+		credentialsJSON: credentialsContent,
+		readOnly:        config.ReadOnly.ValueBool(),
+		changeReference: changeReference,
+	}
+	if metricsFile != "" {
+		clients.metrics = getAPIMetrics(metricsFile)
+	}
+	if err := clients.initClients(ctx, "", ""); err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to initialize Google Cloud client",
 			"Please make sure the credentials is valid.\n"+
 				"Additional error message: "+err.Error(),
 		)
-	}
-	if resp.Diagnostics.HasError() {
 		return
 	}
-	clients := gcpClients{
-		project:         project,
-		credentialsJSON: credentialsContent,
-		computeClient:   computeService,
-	}
-	resp.DataSourceData = &clients
-	resp.ResourceData = &clients
+	resp.DataSourceData = clients
+	resp.ResourceData = clients
 }
 
 // nolint:lll
@@ -227,14 +291,104 @@ func (*googleCloudProvider) checkConfig(config *googleCloudProviderModel, resp *
 
 // DataSources
 func (p *googleCloudProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
+	return tracedDataSources([]func() datasource.DataSource{
+		NewAddressesDataSource,
+		NewAnycastIPHealthDataSource,
+		NewArmorPolicyRuleHitCountsDataSource,
+		NewArtifactRegistryRepositoriesDataSource,
+		NewAuditLogConfigDataSource,
+		NewBackendLatencyPercentilesDataSource,
+		NewBillingAccountDataSource,
+		NewCertificateManagerCertificatesDataSource,
+		NewCertificateMapEntriesDataSource,
+		NewChangeWindowDataSource,
+		NewCloudFunctionsDataSource,
+		NewCloudArmorPoliciesDataSource,
+		NewCloudNatDataSource,
+		NewComputeFutureReservationsDataSource,
+		NewComputeImageDataSource,
+		NewComputeInstanceTemplatesDataSource,
+		NewComputeInstancesDataSource,
+		NewComputeOperationDataSource,
+		NewComputeSslCertificatesDataSource,
+		NewCustomIamRolesDataSource,
+		NewDiskSnapshotsDataSource,
+		NewDisksDataSource,
+		NewDNSManagedZonesDataSource,
+		NewEffectiveOrgPoliciesDataSource,
+		NewErrorReportingGroupsDataSource,
+		NewGkeClustersDataSource,
+		NewGkeNodePoolsDataSource,
+		NewHealthChecksDataSource,
+		NewInstanceMaintenanceEventsDataSource,
+		NewInstanceSerialOutputDataSource,
+		NewInternalRangesDataSource,
+		NewIPLookupDataSource,
+		NewKmsKeysDataSource,
+		NewLbBackendServiceDataSource,
 		NewLbBackendServicesDataSource,
-	}
+		NewLbChainDataSource,
+		NewLbForwardingRulesDataSource,
+		NewMachineTypeAvailabilityDataSource,
+		NewManagedInstanceGroupStatusDataSource,
+		NewNameAvailabilityCheckDataSource,
+		NewNccSpokesDataSource,
+		NewNetworkTiersAvailabilityDataSource,
+		NewNetworksDataSource,
+		NewProjectIamPolicyQueryDataSource,
+		NewPubsubTopicsDataSource,
+		NewRegionalForwardingRulesDataSource,
+		NewReservationsAndCommitmentsDataSource,
+		NewResourceManagerTagsDataSource,
+		NewResourcePoliciesDataSource,
+		NewRouterBgpStatusDataSource,
+		NewSccFindingsDataSource,
+		NewSecretManagerSecretsDataSource,
+		NewServiceAccountKeysDataSource,
+		NewSslHandshakeInspectionDataSource,
+		NewSslPoliciesDataSource,
+		NewSubnetworksDataSource,
+		NewTagBindingsDataSource,
+		NewTerraformStateResourcesInGcsDataSource,
+		NewZonesRegionsDataSource,
+	})
 }
 
 // Resources
 func (p *googleCloudProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return tracedResources([]func() resource.Resource{
 		NewAcmeEabResource,
-	}
+		NewUniqueNameClaimResource,
+		NewApplyLockResource,
+		NewAlertPolicyForLbResource,
+		NewBackendBucketWithBucketResource,
+		NewCertRotationOrchestratorResource,
+		NewGcsStateBucketBootstrapResource,
+		NewHTTPProbeResource,
+		NewIdleResourceCleanupResource,
+		NewLogBasedMetricResource,
+		NewSyntheticMonitorResource,
+		NewDNSHealthCheckedRoutingPolicyResource,
+		NewInternalRangeResource,
+		NewNccHubResource,
+		NewNccSpokeResource,
+		NewInstanceTemplateCloneWithOverridesResource,
+		NewComputeProjectInfoResource,
+		NewConsentGateResource,
+		NewBackendServiceBackendResource,
+		NewCloudArmorRuleResource,
+		NewBackendServiceSecurityPolicyAttachmentResource,
+		NewFirewallRulesBatchResource,
+		NewDNSRecordSetsBatchResource,
+		NewServiceAccountKeyRotatingResource,
+		NewIamMemberSafeResource,
+		NewProjectLabelsResource,
+		NewInstanceNetworkTagsResource,
+		NewInstanceLabelsResource,
+		NewMigRollingActionResource,
+		NewImageCopyResource,
+		NewDiskSnapshotOnDemandResource,
+		NewCertificateMapEntrySwapResource,
+		NewURLMapPathRuleResource,
+	})
 }
@@ -1,9 +1,12 @@
 package gcp
 
 import (
-	"bytes"
+	"crypto"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,13 +14,16 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/acme"
 	"golang.org/x/net/context"
-	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
 )
 
 // acmeEabResource Present st-gcp_acme_eab resource
@@ -26,12 +32,25 @@ type acmeEabResource struct {
 }
 
 type acmeEabState struct {
-	KeyID      types.String `tfsdk:"key_id"`
-	Name       types.String `tfsdk:"name"`
-	HmacBase64 types.String `tfsdk:"hmac_base64"`
-	CreateAt   types.Int64  `tfsdk:"create_at"` // the unix timestamp of create EAB credential
+	ClientConfig *clientConfig `tfsdk:"client_config"`
+	KeyID        types.String  `tfsdk:"key_id"`
+	Name         types.String  `tfsdk:"name"`
+	HmacBase64   types.String  `tfsdk:"hmac_base64"`
+	CreateAt     types.Int64   `tfsdk:"create_at"` // the unix timestamp of create EAB credential
+	ExpiryDays   types.Int64   `tfsdk:"expiry_days"`
+
+	DeactivateAcmeAccountOnDestroy types.Bool   `tfsdk:"deactivate_acme_account_on_destroy"`
+	AcmeDirectoryURL               types.String `tfsdk:"acme_directory_url"`
+	AcmeAccountKeyPem              types.String `tfsdk:"acme_account_key_pem"`
 }
 
+// defaultAcmeEabExpiryDays is the validity of the EAB credential, GCP expires
+// the EAB credential not bound to an ACME account in 7 days.
+const defaultAcmeEabExpiryDays = 7
+
+// defaultAcmeDirectoryURL is the ACME directory of Google Public CA.
+const defaultAcmeDirectoryURL = "https://dv.acme-v02.api.pki.goog/directory"
+
 type externalAccountKeyResp struct {
 	KeyID     string `json:"keyId"`
 	Name      string `json:"name"`
@@ -69,6 +88,31 @@ func (r *acmeEabResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "EAB create timestamp.",
 				Computed:    true,
 			},
+			"expiry_days": &schema.Int64Attribute{
+				Description: fmt.Sprintf("Days after create_at the EAB credential is expired "+
+					"and replaced, 0 to never expire. Default to %d.", defaultAcmeEabExpiryDays),
+				Optional: true,
+			},
+			"deactivate_acme_account_on_destroy": &schema.BoolAttribute{
+				Description: "Deactivate the ACME account bound to the EAB credential on destroy, " +
+					"so the account can no longer issue certificates. acme_account_key_pem " +
+					"is required if enabled.",
+				Optional: true,
+			},
+			"acme_directory_url": &schema.StringAttribute{
+				Description: "ACME directory URL of the CA the ACME account is registered with. " +
+					"Default to " + defaultAcmeDirectoryURL + ".",
+				Optional: true,
+			},
+			"acme_account_key_pem": &schema.StringAttribute{
+				Description: "Private key of the ACME account in PEM format, e.g. the " +
+					"account_key_pem of the acme_registration resource.",
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
 		},
 	}
 }
@@ -90,6 +134,10 @@ func (r *acmeEabResource) Configure(_ context.Context,
 
 // Create
 func (r *acmeEabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	var state acmeEabState
 	d := req.Plan.Get(ctx, &state)
 	resp.Diagnostics.Append(d...)
@@ -98,46 +146,123 @@ func (r *acmeEabResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	if err := createEabCred(ctx, &state, r.client.credentialsJSON, nil); err != nil {
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := createEabCred(ctx, &state, r.client); err != nil {
 		resp.Diagnostics.AddError("createEabCred error", err.Error())
 		return
 	}
 	resp.State.Set(ctx, &state)
 }
 
-// Read
-func (r *acmeEabResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
-	// Since GCP does not provide an API to get EAB credential, the Read function will not be implemented.
+// ModifyPlan
+func (r *acmeEabResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan acmeEabState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ExpiryDays.IsUnknown() {
+		return
+	}
+
+	// Terraform only replaces the resource when the attribute requiring
+	// replacement is changed, so the credential is planned to be unknown.
+	state.ExpiryDays = plan.ExpiryDays
+	if acmeEabExpired(&state) {
+		plan.KeyID = types.StringUnknown()
+		plan.Name = types.StringUnknown()
+		plan.HmacBase64 = types.StringUnknown()
+		plan.CreateAt = types.Int64Unknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("create_at"))
+	}
 }
 
-// Update
-func (r *acmeEabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+// Read
+func (r *acmeEabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Since GCP does not provide an API to get EAB credential, the EAB
+	// credential in state is validated locally instead.
 	var state acmeEabState
 	d := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
-		tflog.Error(ctx, "Update req.State.Get error")
+		tflog.Error(ctx, "Read req.State.Get error")
 		return
 	}
 
-	eabData := externalAccountKeyResp{
-		KeyID:     state.KeyID.String(),
-		Name:      state.Name.String(),
-		B64MacKey: state.HmacBase64.String(),
+	if err := validateEabCred(&state); err != nil {
+		resp.Diagnostics.AddWarning(
+			"[Warning] Invalid EAB credential",
+			fmt.Sprintf("The EAB credential in state is invalid and will be recreated: %v", err),
+		)
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	if err := createEabCred(ctx, &state, r.client.credentialsJSON, &eabData); err != nil {
-		resp.Diagnostics.AddError("createEabCred error", err.Error())
+	if acmeEabExpired(&state) {
+		resp.Diagnostics.AddWarning(
+			"[Warning] EAB credential expired",
+			fmt.Sprintf("The EAB credential %s was created at %s and will be replaced.",
+				state.KeyID.ValueString(), time.Unix(state.CreateAt.ValueInt64(), 0).UTC().Format(time.RFC3339)),
+		)
+	}
+}
+
+// Update
+func (r *acmeEabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
 		return
 	}
-	resp.State.Set(ctx, &state)
+
+	var plan, state acmeEabState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	// Only the arguments can be updated, the expired EAB credential is
+	// replaced instead of updated.
+	plan.KeyID = state.KeyID
+	plan.Name = state.Name
+	plan.HmacBase64 = state.HmacBase64
+	plan.CreateAt = state.CreateAt
+	resp.State.Set(ctx, &plan)
 }
 
 // Delete
-func (r *acmeEabResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
-	resp.Diagnostics.AddWarning(
-		"[Warning] Delete function will do nothing",
-		"Since GCP does not provide an API to delete EAB credential, the Delete function will not be implemented.",
-	)
+func (r *acmeEabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state acmeEabState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if !state.DeactivateAcmeAccountOnDestroy.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"[Warning] Delete function will do nothing",
+			"Since GCP does not provide an API to delete EAB credential, the Delete function will not be implemented.",
+		)
+		return
+	}
+
+	if err := deactivateAcmeAccount(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("deactivateAcmeAccount error", err.Error())
+		return
+	}
 }
 
 const (
@@ -145,52 +270,29 @@ const (
 	retrySleepMs  = 500
 )
 
-type credentialsGcp struct {
-	Type                    string `json:"type"`
-	ProjectID               string `json:"project_id"`
-	PrivateKeyID            string `json:"private_key_id"`
-	PrivateKey              string `json:"private_key"`
-	ClientEmail             string `json:"client_email"`
-	ClientID                string `json:"client_id"`
-	AuthURI                 string `json:"auth_uri"`
-	TokenURI                string `json:"token_uri"`
-	AuthProviderX509CertURL string `json:"auth_provider_x509_cert_url"`
-	ClientX509CertURL       string `json:"client_x509_cert_url"`
-}
-
 // createEabCred Create a EAB credential.
 // nolint:lll
 // see: https://cloud.google.com/certificate-manager/docs/reference/public-ca/rest/v1/projects.locations.externalAccountKeys/create
-func createEabCred(ctx context.Context, s *acmeEabState, credentialsJSON []byte, old *externalAccountKeyResp) error {
-	cred := &credentialsGcp{}
-	if err := json.Unmarshal(credentialsJSON, &cred); err != nil {
-		return fmt.Errorf("failed to unmarshal GCP credential JSON: %v", err)
-	}
-
-	url := "https://www.googleapis.com/auth/cloud-platform"
-	conf, err := google.JWTConfigFromJSON(credentialsJSON, url)
+func createEabCred(ctx context.Context, s *acmeEabState, clients *gcpClients) error {
+	httpClient, _, err := htransport.NewClient(ctx,
+		append(clients.clientOptions, option.WithScopes(cloudPlatformScope))...)
 	if err != nil {
-		return fmt.Errorf("failed to generate JWT config: %v", err)
+		return fmt.Errorf("failed to create HTTP client: %v", err)
 	}
 
 	var api = fmt.Sprintf(
 		"https://publicca.googleapis.com/v1beta1/projects/%s/locations/global/externalAccountKeys",
-		cred.ProjectID)
-	var postData *bytes.Reader
-	if old != nil {
-		old.B64MacKey = base64.StdEncoding.Strict().EncodeToString([]byte(old.B64MacKey))
-		buf, _ := json.Marshal(old)
-		postData = bytes.NewReader(buf)
-	}
+		clients.project)
 
 	var resp *http.Response
 	requestFunc := func() error {
-		if old != nil {
-			resp, err = conf.Client(context.Background()).Post(api, "application/json", postData)
-		} else {
-			resp, err = conf.Client(context.Background()).Post(api, "application/json", nil)
+		req, err := http.NewRequest(http.MethodPost, api, nil)
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
 		}
+		req.Header.Set("Content-Type", "application/json")
 
+		resp, err = httpClient.Do(req.WithContext(ctx))
 		if err != nil {
 			errMsg := err.Error()
 			tflog.Warn(ctx, "Failed to request API", map[string]interface{}{
@@ -237,3 +339,90 @@ func createEabCred(ctx context.Context, s *acmeEabState, credentialsJSON []byte,
 
 	return nil
 }
+
+// validateEabCred validates the EAB credential in state can still be used by
+// the ACME clients.
+func validateEabCred(s *acmeEabState) error {
+	if s.KeyID.ValueString() == "" {
+		return fmt.Errorf("key_id is empty")
+	}
+	// The HMAC key is base64url-encoded, see RFC 8555 section 7.3.4.
+	hmacKey, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s.HmacBase64.ValueString(), "="))
+	if err != nil {
+		return fmt.Errorf("failed to base64url-decode hmac_base64: %v", err)
+	}
+	if len(hmacKey) == 0 {
+		return fmt.Errorf("hmac_base64 is empty")
+	}
+	return nil
+}
+
+// acmeEabExpired returns true if expiry_days has elapsed since create_at.
+func acmeEabExpired(s *acmeEabState) bool {
+	expiryDays := int64(defaultAcmeEabExpiryDays)
+	if !s.ExpiryDays.IsNull() {
+		expiryDays = s.ExpiryDays.ValueInt64()
+	}
+	if expiryDays <= 0 || s.CreateAt.IsNull() {
+		return false
+	}
+	expireAt := time.Unix(s.CreateAt.ValueInt64(), 0).Add(time.Duration(expiryDays) * 24 * time.Hour)
+	return time.Now().After(expireAt)
+}
+
+// deactivateAcmeAccount deactivates the ACME account of the account key, the
+// EAB credential can not be deleted but it is useless once the account bound
+// to it is deactivated.
+// see: https://www.rfc-editor.org/rfc/rfc8555#section-7.3.6
+func deactivateAcmeAccount(ctx context.Context, s *acmeEabState) error {
+	if s.AcmeAccountKeyPem.ValueString() == "" {
+		return fmt.Errorf("acme_account_key_pem is required to deactivate the ACME account")
+	}
+	key, err := parseAcmeAccountKey(s.AcmeAccountKeyPem.ValueString())
+	if err != nil {
+		return err
+	}
+
+	directoryURL := defaultAcmeDirectoryURL
+	if s.AcmeDirectoryURL.ValueString() != "" {
+		directoryURL = s.AcmeDirectoryURL.ValueString()
+	}
+	client := &acme.Client{
+		Key:          key,
+		DirectoryURL: directoryURL,
+	}
+	if err := client.DeactivateReg(ctx); err != nil {
+		if errors.Is(err, acme.ErrNoAccount) {
+			tflog.Warn(ctx, "ACME account not found, skip deactivation", map[string]interface{}{
+				"key_id": s.KeyID.ValueString(),
+			})
+			return nil
+		}
+		return fmt.Errorf("failed to deactivate ACME account: %v", err)
+	}
+	return nil
+}
+
+// parseAcmeAccountKey parses the PKCS#1 RSA, SEC 1 EC or PKCS#8 private key in
+// PEM format.
+func parseAcmeAccountKey(keyPem string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(keyPem))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode acme_account_key_pem")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse acme_account_key_pem: %v", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("acme_account_key_pem is not a signing key")
+	}
+	return signer, nil
+}
@@ -0,0 +1,422 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleMonitoringClient "google.golang.org/api/monitoring/v3"
+)
+
+const (
+	defaultLbAlertLatencyThresholdMs = 1000
+	defaultLbAlertLatencyPercentile  = 95
+	defaultLbAlertErrorRatio         = 0.05
+	defaultLbAlertDurationSecs       = 300
+	lbAlertAlignmentPeriod           = "60s"
+	lbAlertBackendServiceLabel       = "backend_service"
+)
+
+var lbAlertPercentileAligners = map[int64]string{
+	50: "ALIGN_PERCENTILE_50",
+	95: "ALIGN_PERCENTILE_95",
+	99: "ALIGN_PERCENTILE_99",
+}
+
+// alertPolicyForLbResource Present st-gcp_alert_policy_for_lb resource
+type alertPolicyForLbResource struct {
+	client *gcpClients
+}
+
+type alertPolicyForLbState struct {
+	ClientConfig                *clientConfig `tfsdk:"client_config"`
+	ID                          types.String  `tfsdk:"id"`
+	DisplayName                 types.String  `tfsdk:"display_name"`
+	BackendService              types.String  `tfsdk:"backend_service"`
+	NotificationChannels        types.List    `tfsdk:"notification_channels"`
+	Enabled                     types.Bool    `tfsdk:"enabled"`
+	DurationSeconds             types.Int64   `tfsdk:"duration_seconds"`
+	LatencyThresholdMs          types.Int64   `tfsdk:"latency_threshold_ms"`
+	LatencyPercentile           types.Int64   `tfsdk:"latency_percentile"`
+	ErrorRatioThreshold         types.Float64 `tfsdk:"error_ratio_threshold"`
+	UnhealthyBackendsMetricType types.String  `tfsdk:"unhealthy_backends_metric_type"`
+	UnhealthyBackendsThreshold  types.Int64   `tfsdk:"unhealthy_backends_threshold"`
+	Documentation               types.String  `tfsdk:"documentation"`
+}
+
+// NewAlertPolicyForLbResource
+func NewAlertPolicyForLbResource() resource.Resource {
+	return &alertPolicyForLbResource{}
+}
+
+// Metadata
+func (r *alertPolicyForLbResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_policy_for_lb"
+}
+
+// Schema
+func (r *alertPolicyForLbResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a Cloud Monitoring alert policy pre-wired with the common " +
+			"conditions of a backend service of the global external HTTP(S) load " +
+			"balancer: backend latency, 5xx ratio and optionally the unhealthy backend " +
+			"count. The alert fires if any condition is met.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource name of alert policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of alert policy.",
+				Required:    true,
+			},
+			"backend_service": schema.StringAttribute{
+				Description: "Self link or name of the backend service to be alerted.",
+				Required:    true,
+			},
+			"notification_channels": schema.ListAttribute{
+				Description: "Resource names of the notification channels, e.g. " +
+					"projects/<project>/notificationChannels/<id>.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the alert policy is enabled. Default to true.",
+				Optional:    true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Description: "Seconds a condition must be met before alerting. Default to " +
+					strconv.Itoa(defaultLbAlertDurationSecs) + ".",
+				Optional: true,
+			},
+			"latency_threshold_ms": schema.Int64Attribute{
+				Description: "Backend latency in milliseconds to be alerted. Default to " +
+					strconv.Itoa(defaultLbAlertLatencyThresholdMs) + ".",
+				Optional: true,
+			},
+			"latency_percentile": schema.Int64Attribute{
+				Description: "Percentile of the backend latency, 50, 95 or 99. Default to " +
+					strconv.Itoa(defaultLbAlertLatencyPercentile) + ".",
+				Optional: true,
+			},
+			"error_ratio_threshold": schema.Float64Attribute{
+				Description: "Ratio of the 5xx responses to all responses to be alerted. " +
+					"Default to " + strconv.FormatFloat(defaultLbAlertErrorRatio, 'f', -1, 64) + ".",
+				Optional: true,
+			},
+			"unhealthy_backends_metric_type": schema.StringAttribute{
+				Description: "Type of the metric counting the unhealthy backends, e.g. a " +
+					"log-based metric on the health check logs. The metric must have a " +
+					lbAlertBackendServiceLabel + " label of the backend service name. The " +
+					"unhealthy backend condition is only added if it is set, as the load " +
+					"balancer has no built-in metric of the backend health.",
+				Optional: true,
+			},
+			"unhealthy_backends_threshold": schema.Int64Attribute{
+				Description: "Number of unhealthy backends to be alerted if exceeded. Default to 0.",
+				Optional:    true,
+			},
+			"documentation": schema.StringAttribute{
+				Description: "Markdown documentation included in the notifications, e.g. the runbook.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *alertPolicyForLbResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *alertPolicyForLbResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state alertPolicyForLbState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	monitoringClient, err := googleMonitoringClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Monitoring client", err.Error())
+		return
+	}
+
+	policy, err := r.newLbAlertPolicy(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid alert policy", err.Error())
+		return
+	}
+	createdPolicy, err := monitoringClient.Projects.AlertPolicies.Create("projects/"+r.client.project, policy).
+		Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create alert policy", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(createdPolicy.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *alertPolicyForLbResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertPolicyForLbState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	monitoringClient, err := googleMonitoringClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Monitoring client", err.Error())
+		return
+	}
+
+	policy, err := monitoringClient.Projects.AlertPolicies.Get(state.ID.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get alert policy", err.Error())
+		return
+	}
+
+	state.DisplayName = types.StringValue(policy.DisplayName)
+	if !state.Enabled.IsNull() || !policy.Enabled {
+		state.Enabled = types.BoolValue(policy.Enabled)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *alertPolicyForLbResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state alertPolicyForLbState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	monitoringClient, err := googleMonitoringClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Monitoring client", err.Error())
+		return
+	}
+
+	// The whole alert policy is replaced without update mask.
+	policy, err := r.newLbAlertPolicy(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid alert policy", err.Error())
+		return
+	}
+	if _, err := monitoringClient.Projects.AlertPolicies.Patch(state.ID.ValueString(), policy).
+		Context(ctx).Do(); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update alert policy", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *alertPolicyForLbResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state alertPolicyForLbState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	monitoringClient, err := googleMonitoringClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Monitoring client", err.Error())
+		return
+	}
+
+	_, err = monitoringClient.Projects.AlertPolicies.Delete(state.ID.ValueString()).Context(ctx).Do()
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete alert policy", err.Error())
+	}
+}
+
+// newLbAlertPolicy converts the state into the alert policy with the
+// conditions of the backend service.
+func (r *alertPolicyForLbResource) newLbAlertPolicy(ctx context.Context,
+	s *alertPolicyForLbState) (*googleMonitoringClient.AlertPolicy, error) {
+	backendService := resourceNameFromSelfLink(s.BackendService.ValueString())
+
+	duration := fmt.Sprintf("%ds", int64(defaultLbAlertDurationSecs))
+	if !s.DurationSeconds.IsNull() {
+		duration = fmt.Sprintf("%ds", s.DurationSeconds.ValueInt64())
+	}
+	latencyThreshold := int64(defaultLbAlertLatencyThresholdMs)
+	if !s.LatencyThresholdMs.IsNull() {
+		latencyThreshold = s.LatencyThresholdMs.ValueInt64()
+	}
+	latencyPercentile := int64(defaultLbAlertLatencyPercentile)
+	if !s.LatencyPercentile.IsNull() {
+		latencyPercentile = s.LatencyPercentile.ValueInt64()
+	}
+	latencyAligner, ok := lbAlertPercentileAligners[latencyPercentile]
+	if !ok {
+		return nil, fmt.Errorf("latency_percentile must be 50, 95 or 99, got %d", latencyPercentile)
+	}
+	errorRatio := defaultLbAlertErrorRatio
+	if !s.ErrorRatioThreshold.IsNull() {
+		errorRatio = s.ErrorRatioThreshold.ValueFloat64()
+	}
+
+	lbRuleFilter := fmt.Sprintf(`resource.type = "https_lb_rule" AND resource.labels.backend_target_name = %q`,
+		backendService)
+	conditions := []*googleMonitoringClient.Condition{
+		{
+			DisplayName: fmt.Sprintf("%s backend latency p%d > %dms", backendService, latencyPercentile, latencyThreshold),
+			ConditionThreshold: &googleMonitoringClient.MetricThreshold{
+				Filter: `metric.type = "loadbalancing.googleapis.com/https/backend_latencies" AND ` +
+					lbRuleFilter,
+				Aggregations: []*googleMonitoringClient.Aggregation{{
+					AlignmentPeriod:    lbAlertAlignmentPeriod,
+					PerSeriesAligner:   latencyAligner,
+					CrossSeriesReducer: "REDUCE_MAX",
+				}},
+				Comparison:     "COMPARISON_GT",
+				ThresholdValue: float64(latencyThreshold),
+				Duration:       duration,
+				Trigger:        &googleMonitoringClient.Trigger{Count: 1},
+			},
+		},
+		{
+			DisplayName: fmt.Sprintf("%s 5xx ratio > %g", backendService, errorRatio),
+			ConditionThreshold: &googleMonitoringClient.MetricThreshold{
+				Filter: `metric.type = "loadbalancing.googleapis.com/https/request_count" AND ` +
+					`metric.labels.response_code_class = 500 AND ` + lbRuleFilter,
+				Aggregations: []*googleMonitoringClient.Aggregation{{
+					AlignmentPeriod:    lbAlertAlignmentPeriod,
+					PerSeriesAligner:   "ALIGN_RATE",
+					CrossSeriesReducer: "REDUCE_SUM",
+				}},
+				DenominatorFilter: `metric.type = "loadbalancing.googleapis.com/https/request_count" AND ` +
+					lbRuleFilter,
+				DenominatorAggregations: []*googleMonitoringClient.Aggregation{{
+					AlignmentPeriod:    lbAlertAlignmentPeriod,
+					PerSeriesAligner:   "ALIGN_RATE",
+					CrossSeriesReducer: "REDUCE_SUM",
+				}},
+				Comparison:     "COMPARISON_GT",
+				ThresholdValue: errorRatio,
+				Duration:       duration,
+				Trigger:        &googleMonitoringClient.Trigger{Count: 1},
+			},
+		},
+	}
+	if metricType := s.UnhealthyBackendsMetricType.ValueString(); metricType != "" {
+		conditions = append(conditions, &googleMonitoringClient.Condition{
+			DisplayName: fmt.Sprintf("%s unhealthy backends > %d", backendService,
+				s.UnhealthyBackendsThreshold.ValueInt64()),
+			ConditionThreshold: &googleMonitoringClient.MetricThreshold{
+				Filter: fmt.Sprintf(`metric.type = %q AND metric.labels.%s = %q`,
+					metricType, lbAlertBackendServiceLabel, backendService),
+				Aggregations: []*googleMonitoringClient.Aggregation{{
+					AlignmentPeriod:    lbAlertAlignmentPeriod,
+					PerSeriesAligner:   "ALIGN_DELTA",
+					CrossSeriesReducer: "REDUCE_SUM",
+				}},
+				Comparison:      "COMPARISON_GT",
+				ThresholdValue:  float64(s.UnhealthyBackendsThreshold.ValueInt64()),
+				Duration:        duration,
+				Trigger:         &googleMonitoringClient.Trigger{Count: 1},
+				ForceSendFields: []string{"ThresholdValue"},
+			},
+		})
+	}
+
+	notificationChannels := []string{}
+	if diags := s.NotificationChannels.ElementsAs(ctx, &notificationChannels, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid notification_channels")
+	}
+
+	policy := &googleMonitoringClient.AlertPolicy{
+		DisplayName:          s.DisplayName.ValueString(),
+		Combiner:             "OR",
+		Conditions:           conditions,
+		Enabled:              s.Enabled.IsNull() || s.Enabled.ValueBool(),
+		NotificationChannels: notificationChannels,
+		ForceSendFields:      []string{"Enabled"},
+	}
+	if s.Documentation.ValueString() != "" {
+		policy.Documentation = &googleMonitoringClient.Documentation{
+			Content:  s.Documentation.ValueString(),
+			MimeType: "text/markdown",
+		}
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		policy.UserLabels = map[string]string{changeReferenceKey: label}
+	}
+	return policy, nil
+}
@@ -0,0 +1,374 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleStorageClient "google.golang.org/api/storage/v1"
+)
+
+const (
+	applyLockObjectPrefix        = "apply-locks/"
+	defaultApplyLockTimeoutSecs  = 600
+	defaultApplyLockTTLSecs      = 3600
+	applyLockAcquiredAtMetaField = "acquired-at"
+)
+
+// applyLockResource Present st-gcp_apply_lock resource
+type applyLockResource struct {
+	client *gcpClients
+}
+
+type applyLockState struct {
+	ClientConfig   *clientConfig `tfsdk:"client_config"`
+	ID             types.String  `tfsdk:"id"`
+	Bucket         types.String  `tfsdk:"bucket"`
+	Name           types.String  `tfsdk:"name"`
+	Holder         types.String  `tfsdk:"holder"`
+	TimeoutSeconds types.Int64   `tfsdk:"timeout_seconds"`
+	TTLSeconds     types.Int64   `tfsdk:"ttl_seconds"`
+	Triggers       types.Map     `tfsdk:"triggers"`
+	Generation     types.Int64   `tfsdk:"generation"`
+	AcquiredAt     types.Int64   `tfsdk:"acquired_at"`
+}
+
+// NewApplyLockResource
+func NewApplyLockResource() resource.Resource {
+	return &applyLockResource{}
+}
+
+// Metadata
+func (r *applyLockResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apply_lock"
+}
+
+// Schema
+func (r *applyLockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Acquire a distributed lock backed by a GCS object on create and " +
+			"release it on delete, so workspaces mutating the same shared resources " +
+			"can serialize their changes. The lock expires after ttl_seconds, so it " +
+			"is never held forever if the resource is not destroyed or the state is " +
+			"lost: an expired lock held by others is taken over, and an expired lock " +
+			"held by this resource is acquired again on next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GCS object path of the lock.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bucket": schema.StringAttribute{
+				Description: "GCS bucket to store the lock.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the lock. Workspaces using the same lock name in " +
+					"the same bucket are serialized.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"holder": schema.StringAttribute{
+				Description: "Holder of the lock recorded in the object metadata, e.g. the workspace name.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Maximum seconds to wait for the lock to be acquired. " +
+					"Default to " + strconv.Itoa(defaultApplyLockTimeoutSecs) + ".",
+				Optional: true,
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				Description: "Seconds after which the lock expires. Default to " +
+					strconv.Itoa(defaultApplyLockTTLSecs) + ".",
+				Optional: true,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will release " +
+					"and re-acquire the lock.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"generation": schema.Int64Attribute{
+				Description: "Generation of the lock object.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"acquired_at": schema.Int64Attribute{
+				Description: "The unix timestamp when the lock is acquired.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *applyLockResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *applyLockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state applyLockState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	if err := r.acquireApplyLock(ctx, storageClient, &state); err != nil {
+		resp.Diagnostics.AddError("acquireApplyLock error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *applyLockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state applyLockState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	object, err := storageClient.Objects.Get(state.Bucket.ValueString(), applyLockObjectName(&state)).
+		Context(ctx).Do()
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get lock object", err.Error())
+		return
+	}
+
+	// The lock is lost if it is released or broken by others, remove it
+	// from state so that it is acquired again.
+	if err != nil || object.Generation != state.Generation.ValueInt64() {
+		tflog.Warn(ctx, "Lock is no longer held, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	// The expired lock is acquired again, which takes over the lock object
+	// of this resource as a stale lock.
+	if time.Since(time.Unix(state.AcquiredAt.ValueInt64(), 0)) >= applyLockTTL(&state) {
+		tflog.Warn(ctx, "Lock is expired, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *applyLockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	// Only timeout_seconds, ttl_seconds and client_config can be updated,
+	// which take effect on next acquisition, hence the lock object is not
+	// touched.
+	var plan, state applyLockState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	state.TimeoutSeconds = plan.TimeoutSeconds
+	state.TTLSeconds = plan.TTLSeconds
+	state.ClientConfig = plan.ClientConfig
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete
+func (r *applyLockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state applyLockState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	// Only release the lock held by this resource, the lock may have been
+	// broken and acquired by others.
+	err = storageClient.Objects.Delete(state.Bucket.ValueString(), applyLockObjectName(&state)).
+		IfGenerationMatch(state.Generation.ValueInt64()).Context(ctx).Do()
+	if err != nil && !isNotFoundError(err) && !isPreconditionFailedError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to release lock", err.Error())
+	}
+}
+
+// acquireApplyLock Wait until the lock object is created by this resource.
+// The lock object is only created if it does not exist, stale lock older than
+// ttl_seconds is deleted.
+func (r *applyLockResource) acquireApplyLock(ctx context.Context,
+	client *googleStorageClient.Service, s *applyLockState) error {
+	timeout := time.Duration(defaultApplyLockTimeoutSecs) * time.Second
+	if !s.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(s.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+
+	bucket := s.Bucket.ValueString()
+	objectName := applyLockObjectName(s)
+	acquireFunc := func() error {
+		now := time.Now()
+		object := &googleStorageClient.Object{
+			Name:        objectName,
+			ContentType: "text/plain",
+			Metadata: r.client.withChangeReferenceMetadata(map[string]string{
+				"holder":                     s.Holder.ValueString(),
+				applyLockAcquiredAtMetaField: strconv.FormatInt(now.Unix(), 10),
+			}),
+		}
+		created, err := client.Objects.Insert(bucket, object).
+			Media(strings.NewReader(s.Holder.ValueString())).
+			IfGenerationMatch(0).Context(ctx).Do()
+		if err == nil {
+			s.ID = types.StringValue(bucket + "/" + objectName)
+			s.Generation = types.Int64Value(created.Generation)
+			s.AcquiredAt = types.Int64Value(now.Unix())
+			return nil
+		}
+		if !isPreconditionFailedError(err) {
+			return &backoff.PermanentError{Err: fmt.Errorf("failed to create lock object: %v", err)}
+		}
+
+		breakStaleApplyLock(ctx, client, s, bucket, objectName)
+		return fmt.Errorf("lock %q is held by others", s.Name.ValueString())
+	}
+
+	retry := backoff.NewExponentialBackOff()
+	retry.MaxInterval = 30 * time.Second
+	retry.MaxElapsedTime = timeout
+	if err := backoff.Retry(acquireFunc, retry); err != nil {
+		return fmt.Errorf("failed to acquire lock in %s: %v", timeout, err)
+	}
+	return nil
+}
+
+// breakStaleApplyLock Delete the lock object if it is older than
+// ttl_seconds.
+func breakStaleApplyLock(ctx context.Context, client *googleStorageClient.Service,
+	s *applyLockState, bucket, objectName string) {
+	object, err := client.Objects.Get(bucket, objectName).Context(ctx).Do()
+	if err != nil {
+		return
+	}
+	acquiredAt, err := strconv.ParseInt(object.Metadata[applyLockAcquiredAtMetaField], 10, 64)
+	if err != nil {
+		return
+	}
+	if time.Since(time.Unix(acquiredAt, 0)) < applyLockTTL(s) {
+		return
+	}
+
+	tflog.Warn(ctx, "Breaking stale lock", map[string]interface{}{
+		"name":   s.Name.ValueString(),
+		"holder": object.Metadata["holder"],
+	})
+	err = client.Objects.Delete(bucket, objectName).
+		IfGenerationMatch(object.Generation).Context(ctx).Do()
+	if err != nil {
+		tflog.Warn(ctx, "Failed to break stale lock", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// applyLockTTL returns the duration after which the lock expires.
+func applyLockTTL(s *applyLockState) time.Duration {
+	if s.TTLSeconds.IsNull() {
+		return time.Duration(defaultApplyLockTTLSecs) * time.Second
+	}
+	return time.Duration(s.TTLSeconds.ValueInt64()) * time.Second
+}
+
+func applyLockObjectName(s *applyLockState) string {
+	return applyLockObjectPrefix + s.Name.ValueString()
+}
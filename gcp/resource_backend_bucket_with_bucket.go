@@ -0,0 +1,653 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+	googleDnsClient "google.golang.org/api/dns/v1"
+	googleStorageClient "google.golang.org/api/storage/v1"
+)
+
+const (
+	staticSitePublicMember  = "allUsers"
+	staticSitePublicRole    = "roles/storage.objectViewer"
+	defaultStaticSiteDNSTTL = 300
+	defaultCdnCacheMode     = "CACHE_ALL_STATIC"
+)
+
+// backendBucketWithBucketResource Present st-gcp_backend_bucket_with_bucket resource
+type backendBucketWithBucketResource struct {
+	client *gcpClients
+}
+
+type backendBucketWithBucketState struct {
+	ClientConfig          *clientConfig `tfsdk:"client_config"`
+	ID                    types.String  `tfsdk:"id"`
+	Name                  types.String  `tfsdk:"name"`
+	Location              types.String  `tfsdk:"location"`
+	ForceDestroy          types.Bool    `tfsdk:"force_destroy"`
+	PublicRead            types.Bool    `tfsdk:"public_read"`
+	MainPageSuffix        types.String  `tfsdk:"main_page_suffix"`
+	NotFoundPage          types.String  `tfsdk:"not_found_page"`
+	CacheMode             types.String  `tfsdk:"cache_mode"`
+	DefaultTTL            types.Int64   `tfsdk:"default_ttl"`
+	MaxTTL                types.Int64   `tfsdk:"max_ttl"`
+	ClientTTL             types.Int64   `tfsdk:"client_ttl"`
+	URLMap                types.String  `tfsdk:"url_map"`
+	Host                  types.String  `tfsdk:"host"`
+	DNSManagedZone        types.String  `tfsdk:"dns_managed_zone"`
+	DNSRecordIP           types.String  `tfsdk:"dns_record_ip"`
+	DNSRecordTTL          types.Int64   `tfsdk:"dns_record_ttl"`
+	BucketSelfLink        types.String  `tfsdk:"bucket_self_link"`
+	BackendBucketSelfLink types.String  `tfsdk:"backend_bucket_self_link"`
+}
+
+// NewBackendBucketWithBucketResource
+func NewBackendBucketWithBucketResource() resource.Resource {
+	return &backendBucketWithBucketResource{}
+}
+
+// Metadata
+func (r *backendBucketWithBucketResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backend_bucket_with_bucket"
+}
+
+// Schema
+func (r *backendBucketWithBucketResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provision a static site served by Cloud CDN in one resource: the " +
+			"GCS bucket, the backend bucket with CDN policy, the host rule of an " +
+			"existing URL map, and optionally the DNS record of the host.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of the backend bucket.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the GCS bucket and the backend bucket. It is also " +
+					"used as the path matcher name in the URL map.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Description: "Location of the GCS bucket.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "Delete all objects when the bucket is destroyed. Default to false.",
+				Optional:    true,
+			},
+			"public_read": schema.BoolAttribute{
+				Description: "Grant allUsers read access to the objects of the bucket, " +
+					"which is required by the backend bucket unless signed requests " +
+					"are used. Default to true.",
+				Optional: true,
+			},
+			"main_page_suffix": schema.StringAttribute{
+				Description: "Object served for directory requests, e.g. index.html.",
+				Optional:    true,
+			},
+			"not_found_page": schema.StringAttribute{
+				Description: "Object served when the requested object is not found, e.g. 404.html.",
+				Optional:    true,
+			},
+			"cache_mode": schema.StringAttribute{
+				Description: "CDN cache mode, one of USE_ORIGIN_HEADERS, FORCE_CACHE_ALL " +
+					"or CACHE_ALL_STATIC. Default to " + defaultCdnCacheMode + ".",
+				Optional: true,
+			},
+			"default_ttl": schema.Int64Attribute{
+				Description: "Default TTL in seconds of the cached content.",
+				Optional:    true,
+			},
+			"max_ttl": schema.Int64Attribute{
+				Description: "Maximum TTL in seconds of the cached content.",
+				Optional:    true,
+			},
+			"client_ttl": schema.Int64Attribute{
+				Description: "Maximum TTL in seconds of the cached content sent to clients.",
+				Optional:    true,
+			},
+			"url_map": schema.StringAttribute{
+				Description: "Name of the existing global URL map to add the host rule to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Description: "Host of the static site routed to the backend bucket, e.g. static.example.com.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_managed_zone": schema.StringAttribute{
+				Description: "Name of the Cloud DNS managed zone to create the A record " +
+					"of the host. No DNS record is created if not set. On destroy, the " +
+					"record is only deleted if it still matches dns_record_ip and dns_record_ttl.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_record_ip": schema.StringAttribute{
+				Description: "IP address of the load balancer for the A record, required " +
+					"if dns_managed_zone is set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_record_ttl": schema.Int64Attribute{
+				Description: "TTL in seconds of the A record. Default to 300.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"bucket_self_link": schema.StringAttribute{
+				Description: "Self link of the GCS bucket.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backend_bucket_self_link": schema.StringAttribute{
+				Description: "Self link of the backend bucket.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *backendBucketWithBucketResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *backendBucketWithBucketResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state backendBucketWithBucketState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+	if state.DNSManagedZone.ValueString() != "" && state.DNSRecordIP.ValueString() == "" {
+		resp.Diagnostics.AddError("dns_record_ip is required", "dns_record_ip must be set with dns_managed_zone.")
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	// The bucket is created first, resources created are recorded in state
+	// on every failure so that they can be destroyed.
+	name := state.Name.ValueString()
+	bucket := &googleStorageClient.Bucket{
+		Name:     name,
+		Location: state.Location.ValueString(),
+		Website:  newStaticSiteWebsite(&state),
+		IamConfiguration: &googleStorageClient.BucketIamConfiguration{
+			UniformBucketLevelAccess: &googleStorageClient.BucketIamConfigurationUniformBucketLevelAccess{
+				Enabled: true,
+			},
+		},
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		bucket.Labels = map[string]string{changeReferenceKey: label}
+	}
+	createdBucket, err := storageClient.Buckets.Insert(r.client.project, bucket).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create bucket", err.Error())
+		return
+	}
+	state.ID = types.StringValue(name)
+	state.BucketSelfLink = types.StringValue(createdBucket.SelfLink)
+	state.BackendBucketSelfLink = types.StringValue("")
+	defer func() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}()
+
+	if state.PublicRead.IsNull() || state.PublicRead.ValueBool() {
+		if err := updateStaticSitePublicRead(ctx, storageClient, name, true); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to grant public read", err.Error())
+			return
+		}
+	}
+
+	op, err := r.client.computeClient.BackendBuckets.Insert(r.client.project, &googleComputeClient.BackendBucket{
+		Name:       name,
+		BucketName: name,
+		EnableCdn:  true,
+		CdnPolicy:  newStaticSiteCdnPolicy(&state),
+	}).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create backend bucket", err.Error())
+		return
+	}
+	backendBucket, err := r.client.computeClient.BackendBuckets.Get(r.client.project, name).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get backend bucket", err.Error())
+		return
+	}
+	state.BackendBucketSelfLink = types.StringValue(backendBucket.SelfLink)
+
+	if err := r.updateStaticSiteHostRule(ctx, &state, true); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to add host rule to URL map", err.Error())
+		return
+	}
+
+	if state.DNSManagedZone.ValueString() != "" {
+		if err := r.updateStaticSiteDNSRecord(ctx, &state, true, &resp.Diagnostics); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to create DNS record", err.Error())
+			return
+		}
+	}
+}
+
+// Read
+func (r *backendBucketWithBucketResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backendBucketWithBucketState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	backendBucket, err := r.client.computeClient.BackendBuckets.Get(r.client.project, state.ID.ValueString()).
+		Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get backend bucket", err.Error())
+		return
+	}
+
+	if backendBucket.CdnPolicy != nil {
+		if !state.CacheMode.IsNull() {
+			state.CacheMode = types.StringValue(backendBucket.CdnPolicy.CacheMode)
+		}
+		if !state.DefaultTTL.IsNull() {
+			state.DefaultTTL = types.Int64Value(backendBucket.CdnPolicy.DefaultTtl)
+		}
+		if !state.MaxTTL.IsNull() {
+			state.MaxTTL = types.Int64Value(backendBucket.CdnPolicy.MaxTtl)
+		}
+		if !state.ClientTTL.IsNull() {
+			state.ClientTTL = types.Int64Value(backendBucket.CdnPolicy.ClientTtl)
+		}
+	}
+	state.BackendBucketSelfLink = types.StringValue(backendBucket.SelfLink)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *backendBucketWithBucketResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state backendBucketWithBucketState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	name := state.ID.ValueString()
+	bucket := &googleStorageClient.Bucket{Website: newStaticSiteWebsite(&plan)}
+	if bucket.Website == nil {
+		bucket.NullFields = append(bucket.NullFields, "Website")
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		bucket.Labels = map[string]string{changeReferenceKey: label}
+	}
+	if _, err := storageClient.Buckets.Patch(name, bucket).Context(ctx).Do(); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update bucket", err.Error())
+		return
+	}
+
+	publicRead := plan.PublicRead.IsNull() || plan.PublicRead.ValueBool()
+	if publicRead != (state.PublicRead.IsNull() || state.PublicRead.ValueBool()) {
+		if err := updateStaticSitePublicRead(ctx, storageClient, name, publicRead); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to update public read", err.Error())
+			return
+		}
+	}
+
+	op, err := r.client.computeClient.BackendBuckets.Patch(r.client.project, name, &googleComputeClient.BackendBucket{
+		CdnPolicy: newStaticSiteCdnPolicy(&plan),
+	}).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update backend bucket", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.BucketSelfLink = state.BucketSelfLink
+	plan.BackendBucketSelfLink = state.BackendBucketSelfLink
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *backendBucketWithBucketResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state backendBucketWithBucketState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	// Resources are deleted in the reverse order of creation.
+	if state.DNSManagedZone.ValueString() != "" {
+		if err := r.updateStaticSiteDNSRecord(ctx, &state, false, &resp.Diagnostics); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to delete DNS record", err.Error())
+			return
+		}
+	}
+
+	if err := r.updateStaticSiteHostRule(ctx, &state, false); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to remove host rule from URL map", err.Error())
+		return
+	}
+
+	name := state.ID.ValueString()
+	op, err := r.client.computeClient.BackendBuckets.Delete(r.client.project, name).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete backend bucket", err.Error())
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+	if state.ForceDestroy.ValueBool() {
+		if err := deleteAllObjectVersions(ctx, storageClient, name); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to delete objects of bucket", err.Error())
+			return
+		}
+	}
+	err = storageClient.Buckets.Delete(name).Context(ctx).Do()
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete bucket", err.Error())
+	}
+}
+
+// updateStaticSiteHostRule Add or remove the host rule and the path matcher
+// routing the host to the backend bucket. The URL map is updated with its
+// fingerprint and retried on conflicts, as it is shared with others.
+func (r *backendBucketWithBucketResource) updateStaticSiteHostRule(ctx context.Context,
+	s *backendBucketWithBucketState, add bool) error {
+	project := r.client.project
+	urlMapName := s.URLMap.ValueString()
+	pathMatcher := s.ID.ValueString()
+	host := s.Host.ValueString()
+
+	updateFunc := func() error {
+		urlMap, err := r.client.computeClient.UrlMaps.Get(project, urlMapName).Context(ctx).Do()
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+
+		hostRules := []*googleComputeClient.HostRule{}
+		for _, hostRule := range urlMap.HostRules {
+			if hostRule.PathMatcher == pathMatcher {
+				continue
+			}
+			for _, h := range hostRule.Hosts {
+				if add && h == host {
+					return &backoff.PermanentError{
+						Err: fmt.Errorf("host %s is already routed to %s", host, hostRule.PathMatcher),
+					}
+				}
+			}
+			hostRules = append(hostRules, hostRule)
+		}
+		pathMatchers := []*googleComputeClient.PathMatcher{}
+		for _, matcher := range urlMap.PathMatchers {
+			if matcher.Name != pathMatcher {
+				pathMatchers = append(pathMatchers, matcher)
+			}
+		}
+
+		if add {
+			hostRules = append(hostRules, &googleComputeClient.HostRule{
+				Hosts:       []string{host},
+				PathMatcher: pathMatcher,
+			})
+			pathMatchers = append(pathMatchers, &googleComputeClient.PathMatcher{
+				Name:           pathMatcher,
+				DefaultService: s.BackendBucketSelfLink.ValueString(),
+			})
+		}
+		urlMap.HostRules = hostRules
+		urlMap.PathMatchers = pathMatchers
+
+		op, err := r.client.computeClient.UrlMaps.Update(project, urlMapName, urlMap).Context(ctx).Do()
+		if err != nil {
+			// The URL map is updated by others, retry with the new fingerprint.
+			if isPreconditionFailedError(err) {
+				return err
+			}
+			return &backoff.PermanentError{Err: err}
+		}
+		if err := waitComputeOperation(ctx, r.client.computeClient, project, op); err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		return nil
+	}
+	return backoff.Retry(updateFunc, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), maxRetryTimes))
+}
+
+// updateStaticSiteDNSRecord Create or delete the A record of the host. The
+// record is only deleted if it still matches dns_record_ip and
+// dns_record_ttl, a warning is added to the diagnostics and the record is
+// left as is otherwise, since it has been changed outside of this resource.
+func (r *backendBucketWithBucketResource) updateStaticSiteDNSRecord(ctx context.Context,
+	s *backendBucketWithBucketState, add bool, diags *diag.Diagnostics) error {
+	dnsClient, err := googleDnsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to initialize Google Cloud DNS client: %v", err)
+	}
+
+	project := r.client.project
+	managedZone := s.DNSManagedZone.ValueString()
+	recordName := strings.TrimSuffix(s.Host.ValueString(), ".") + "."
+	ttl := int64(defaultStaticSiteDNSTTL)
+	if !s.DNSRecordTTL.IsNull() {
+		ttl = s.DNSRecordTTL.ValueInt64()
+	}
+	change := &googleDnsClient.Change{}
+	if add {
+		change.Additions = []*googleDnsClient.ResourceRecordSet{{
+			Name:    recordName,
+			Type:    "A",
+			Ttl:     ttl,
+			Rrdatas: []string{s.DNSRecordIP.ValueString()},
+		}}
+	} else {
+		// Delete the record as is, it must match exactly.
+		records, err := dnsClient.ResourceRecordSets.List(project, managedZone).
+			Name(recordName).Type("A").Context(ctx).Do()
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		if len(records.Rrsets) == 0 {
+			return nil
+		}
+		record := records.Rrsets[0]
+		if record.Ttl != ttl || len(record.Rrdatas) != 1 || record.Rrdatas[0] != s.DNSRecordIP.ValueString() {
+			diags.AddWarning("DNS record is not deleted",
+				fmt.Sprintf("The A record %s in %s is %s with TTL %d, which does not match "+
+					"dns_record_ip %s and dns_record_ttl %d, hence it is left as is.",
+					recordName, managedZone, strings.Join(record.Rrdatas, ", "), record.Ttl,
+					s.DNSRecordIP.ValueString(), ttl))
+			return nil
+		}
+		change.Deletions = records.Rrsets
+	}
+
+	_, err = dnsClient.Changes.Create(project, managedZone, change).Context(ctx).Do()
+	return err
+}
+
+// newStaticSiteWebsite returns the website config of the bucket, nil if no
+// page is configured.
+func newStaticSiteWebsite(s *backendBucketWithBucketState) *googleStorageClient.BucketWebsite {
+	if s.MainPageSuffix.IsNull() && s.NotFoundPage.IsNull() {
+		return nil
+	}
+	return &googleStorageClient.BucketWebsite{
+		MainPageSuffix: s.MainPageSuffix.ValueString(),
+		NotFoundPage:   s.NotFoundPage.ValueString(),
+	}
+}
+
+// newStaticSiteCdnPolicy returns the CDN policy of the backend bucket.
+func newStaticSiteCdnPolicy(s *backendBucketWithBucketState) *googleComputeClient.BackendBucketCdnPolicy {
+	cdnPolicy := &googleComputeClient.BackendBucketCdnPolicy{
+		CacheMode:  defaultCdnCacheMode,
+		DefaultTtl: s.DefaultTTL.ValueInt64(),
+		MaxTtl:     s.MaxTTL.ValueInt64(),
+		ClientTtl:  s.ClientTTL.ValueInt64(),
+	}
+	if !s.CacheMode.IsNull() {
+		cdnPolicy.CacheMode = s.CacheMode.ValueString()
+	}
+	return cdnPolicy
+}
+
+// updateStaticSitePublicRead Grant or revoke the public read access of the
+// bucket objects.
+func updateStaticSitePublicRead(ctx context.Context, client *googleStorageClient.Service,
+	bucketName string, publicRead bool) error {
+	policy, err := client.Buckets.GetIamPolicy(bucketName).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	bindings := []*googleStorageClient.PolicyBindings{}
+	for _, binding := range policy.Bindings {
+		if binding.Role != staticSitePublicRole {
+			bindings = append(bindings, binding)
+			continue
+		}
+		members := []string{}
+		for _, member := range binding.Members {
+			if member != staticSitePublicMember {
+				members = append(members, member)
+			}
+		}
+		if len(members) > 0 {
+			binding.Members = members
+			bindings = append(bindings, binding)
+		}
+	}
+	if publicRead {
+		bindings = append(bindings, &googleStorageClient.PolicyBindings{
+			Role:    staticSitePublicRole,
+			Members: []string{staticSitePublicMember},
+		})
+	}
+	policy.Bindings = bindings
+
+	_, err = client.Buckets.SetIamPolicy(bucketName, policy).Context(ctx).Do()
+	return err
+}
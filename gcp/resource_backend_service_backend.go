@@ -0,0 +1,455 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// backendServiceBackendResource Present st-gcp_backend_service_backend resource
+type backendServiceBackendResource struct {
+	client *gcpClients
+}
+
+type backendServiceBackendState struct {
+	ClientConfig              *clientConfig `tfsdk:"client_config"`
+	ID                        types.String  `tfsdk:"id"`
+	BackendService            types.String  `tfsdk:"backend_service"`
+	Region                    types.String  `tfsdk:"region"`
+	Group                     types.String  `tfsdk:"group"`
+	Description               types.String  `tfsdk:"description"`
+	BalancingMode             types.String  `tfsdk:"balancing_mode"`
+	CapacityScaler            types.Float64 `tfsdk:"capacity_scaler"`
+	MaxUtilization            types.Float64 `tfsdk:"max_utilization"`
+	MaxRate                   types.Int64   `tfsdk:"max_rate"`
+	MaxRatePerInstance        types.Float64 `tfsdk:"max_rate_per_instance"`
+	MaxRatePerEndpoint        types.Float64 `tfsdk:"max_rate_per_endpoint"`
+	MaxConnections            types.Int64   `tfsdk:"max_connections"`
+	MaxConnectionsPerInstance types.Int64   `tfsdk:"max_connections_per_instance"`
+	MaxConnectionsPerEndpoint types.Int64   `tfsdk:"max_connections_per_endpoint"`
+}
+
+// NewBackendServiceBackendResource
+func NewBackendServiceBackendResource() resource.Resource {
+	return &backendServiceBackendResource{}
+}
+
+// Metadata
+func (r *backendServiceBackendResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backend_service_backend"
+}
+
+// Schema
+func (r *backendServiceBackendResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attach a single backend, i.e. an instance group or a network endpoint " +
+			"group, to an existing backend service managed elsewhere. Only the backend of " +
+			"group is changed, the other backends of the backend service are kept and the " +
+			"changes are patched with the fingerprint of backend service, so every team can " +
+			"register its backends to a centrally owned load balancer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Self link of backend service and group of backend.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backend_service": schema.StringAttribute{
+				Description: "Name of the backend service to attach backend to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of the regional backend service, the global backend " +
+					"service is used if not set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Description: "Self link of the instance group or the network endpoint group " +
+					"of backend.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of backend.",
+				Optional:    true,
+			},
+			"balancing_mode": schema.StringAttribute{
+				Description: "Balancing mode of backend, UTILIZATION, RATE or CONNECTION. " +
+					"Default to the balancing mode chosen by Google Cloud for group.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"capacity_scaler": schema.Float64Attribute{
+				Description: "Multiplier of the capacity of backend between 0.0 and 1.0, " +
+					"0.0 drains backend. Default to 1.0.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_utilization": schema.Float64Attribute{
+				Description: "Target CPU utilization of group between 0.0 and 1.0, for " +
+					"the UTILIZATION balancing mode.",
+				Optional: true,
+			},
+			"max_rate": schema.Int64Attribute{
+				Description: "Maximum requests per second of group, for the RATE " +
+					"balancing mode.",
+				Optional: true,
+			},
+			"max_rate_per_instance": schema.Float64Attribute{
+				Description: "Maximum requests per second of each instance, for the " +
+					"RATE balancing mode.",
+				Optional: true,
+			},
+			"max_rate_per_endpoint": schema.Float64Attribute{
+				Description: "Maximum requests per second of each endpoint, for the " +
+					"RATE balancing mode.",
+				Optional: true,
+			},
+			"max_connections": schema.Int64Attribute{
+				Description: "Maximum concurrent connections of group, for the " +
+					"CONNECTION balancing mode.",
+				Optional: true,
+			},
+			"max_connections_per_instance": schema.Int64Attribute{
+				Description: "Maximum concurrent connections of each instance, for the " +
+					"CONNECTION balancing mode.",
+				Optional: true,
+			},
+			"max_connections_per_endpoint": schema.Int64Attribute{
+				Description: "Maximum concurrent connections of each endpoint, for the " +
+					"CONNECTION balancing mode.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *backendServiceBackendResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *backendServiceBackendResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state backendServiceBackendState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	group := state.Group.ValueString()
+	err := r.updateBackends(ctx, &state, func(backends []*googleComputeClient.Backend) (
+		[]*googleComputeClient.Backend, error) {
+		if findBackend(backends, group) >= 0 {
+			return nil, fmt.Errorf("group %s is already attached to backend service %s",
+				group, state.BackendService.ValueString())
+		}
+		return append(backends, newBackend(&state)), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to attach backend to backend service", err.Error())
+		return
+	}
+
+	if err := r.refreshBackend(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get backend service", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *backendServiceBackendResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backendServiceBackendState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.refreshBackend(ctx, &state); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get backend service", err.Error())
+		return
+	}
+	// The backend is detached by others.
+	if state.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *backendServiceBackendResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan backendServiceBackendState
+	d := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	group := plan.Group.ValueString()
+	err := r.updateBackends(ctx, &plan, func(backends []*googleComputeClient.Backend) (
+		[]*googleComputeClient.Backend, error) {
+		i := findBackend(backends, group)
+		if i < 0 {
+			return nil, fmt.Errorf("group %s is not attached to backend service %s",
+				group, plan.BackendService.ValueString())
+		}
+		backends[i] = newBackend(&plan)
+		return backends, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update backend of backend service", err.Error())
+		return
+	}
+
+	if err := r.refreshBackend(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get backend service", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *backendServiceBackendResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state backendServiceBackendState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	group := state.Group.ValueString()
+	err := r.updateBackends(ctx, &state, func(backends []*googleComputeClient.Backend) (
+		[]*googleComputeClient.Backend, error) {
+		i := findBackend(backends, group)
+		if i < 0 {
+			return nil, nil
+		}
+		return append(backends[:i], backends[i+1:]...), nil
+	})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to detach backend from backend service", err.Error())
+	}
+}
+
+// getBackendService gets the global or the regional backend service.
+func (r *backendServiceBackendResource) getBackendService(ctx context.Context,
+	s *backendServiceBackendState) (*googleComputeClient.BackendService, error) {
+	name := s.BackendService.ValueString()
+	if region := s.Region.ValueString(); region != "" {
+		return r.client.computeClient.RegionBackendServices.Get(r.client.project, region, name).
+			Context(ctx).Do()
+	}
+	return r.client.computeClient.BackendServices.Get(r.client.project, name).Context(ctx).Do()
+}
+
+// updateBackends Patch the backends of backend service changed by
+// updateBackendsFunc, the backends are unchanged if it returns nil backends.
+func (r *backendServiceBackendResource) updateBackends(ctx context.Context,
+	s *backendServiceBackendState, updateBackendsFunc func([]*googleComputeClient.Backend) (
+		[]*googleComputeClient.Backend, error)) error {
+	project := r.client.project
+	name := s.BackendService.ValueString()
+	region := s.Region.ValueString()
+
+	updateFunc := func() error {
+		backendService, err := r.getBackendService(ctx, s)
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+
+		backends, err := updateBackendsFunc(backendService.Backends)
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		if backends == nil {
+			return nil
+		}
+		patch := &googleComputeClient.BackendService{
+			Backends:    backends,
+			Fingerprint: backendService.Fingerprint,
+			// Detaching the last backend sends an empty list.
+			ForceSendFields: []string{"Backends"},
+		}
+
+		var op *googleComputeClient.Operation
+		if region != "" {
+			op, err = r.client.computeClient.RegionBackendServices.Patch(project, region, name, patch).
+				Context(ctx).Do()
+		} else {
+			op, err = r.client.computeClient.BackendServices.Patch(project, name, patch).Context(ctx).Do()
+		}
+		if err != nil {
+			// The backend service is updated by others, retry with the new fingerprint.
+			if isPreconditionFailedError(err) {
+				return err
+			}
+			return &backoff.PermanentError{Err: err}
+		}
+		if err := waitComputeOperation(ctx, r.client.computeClient, project, op); err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		return nil
+	}
+	return backoff.Retry(updateFunc, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), maxRetryTimes))
+}
+
+// refreshBackend gets the backend of group and updates the settings in state,
+// the ID is set to null if group is not attached to backend service.
+func (r *backendServiceBackendResource) refreshBackend(ctx context.Context,
+	s *backendServiceBackendState) error {
+	backendService, err := r.getBackendService(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	i := findBackend(backendService.Backends, s.Group.ValueString())
+	if i < 0 {
+		s.ID = types.StringNull()
+		return nil
+	}
+	backend := backendService.Backends[i]
+
+	s.ID = types.StringValue(backendService.SelfLink + "|" + s.Group.ValueString())
+	s.BalancingMode = types.StringValue(backend.BalancingMode)
+	s.CapacityScaler = types.Float64Value(backend.CapacityScaler)
+	if !s.Description.IsNull() || backend.Description != "" {
+		s.Description = types.StringValue(backend.Description)
+	}
+	if !s.MaxUtilization.IsNull() {
+		s.MaxUtilization = types.Float64Value(backend.MaxUtilization)
+	}
+	if !s.MaxRate.IsNull() {
+		s.MaxRate = types.Int64Value(backend.MaxRate)
+	}
+	if !s.MaxRatePerInstance.IsNull() {
+		s.MaxRatePerInstance = types.Float64Value(backend.MaxRatePerInstance)
+	}
+	if !s.MaxRatePerEndpoint.IsNull() {
+		s.MaxRatePerEndpoint = types.Float64Value(backend.MaxRatePerEndpoint)
+	}
+	if !s.MaxConnections.IsNull() {
+		s.MaxConnections = types.Int64Value(backend.MaxConnections)
+	}
+	if !s.MaxConnectionsPerInstance.IsNull() {
+		s.MaxConnectionsPerInstance = types.Int64Value(backend.MaxConnectionsPerInstance)
+	}
+	if !s.MaxConnectionsPerEndpoint.IsNull() {
+		s.MaxConnectionsPerEndpoint = types.Int64Value(backend.MaxConnectionsPerEndpoint)
+	}
+	return nil
+}
+
+// newBackend converts the state into the backend of backend service.
+func newBackend(s *backendServiceBackendState) *googleComputeClient.Backend {
+	backend := &googleComputeClient.Backend{
+		Group:                     s.Group.ValueString(),
+		Description:               s.Description.ValueString(),
+		BalancingMode:             s.BalancingMode.ValueString(),
+		MaxUtilization:            s.MaxUtilization.ValueFloat64(),
+		MaxRate:                   s.MaxRate.ValueInt64(),
+		MaxRatePerInstance:        s.MaxRatePerInstance.ValueFloat64(),
+		MaxRatePerEndpoint:        s.MaxRatePerEndpoint.ValueFloat64(),
+		MaxConnections:            s.MaxConnections.ValueInt64(),
+		MaxConnectionsPerInstance: s.MaxConnectionsPerInstance.ValueInt64(),
+		MaxConnectionsPerEndpoint: s.MaxConnectionsPerEndpoint.ValueInt64(),
+	}
+	// A capacity scaler of 0.0 drains backend and must be sent explicitly.
+	if !(s.CapacityScaler.IsUnknown() || s.CapacityScaler.IsNull()) {
+		backend.CapacityScaler = s.CapacityScaler.ValueFloat64()
+		backend.ForceSendFields = []string{"CapacityScaler"}
+	}
+	return backend
+}
+
+// findBackend returns the index of the backend of group, -1 if group is not
+// attached.
+func findBackend(backends []*googleComputeClient.Backend, group string) int {
+	for i, backend := range backends {
+		if matchResourceReference(backend.Group, group) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,394 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// backendServiceSecurityPolicyAttachmentResource Present st-gcp_backend_service_security_policy_attachment resource
+type backendServiceSecurityPolicyAttachmentResource struct {
+	client *gcpClients
+}
+
+type backendServiceSecurityPolicyAttachmentState struct {
+	ClientConfig       *clientConfig `tfsdk:"client_config"`
+	ID                 types.String  `tfsdk:"id"`
+	BackendService     types.String  `tfsdk:"backend_service"`
+	SecurityPolicy     types.String  `tfsdk:"security_policy"`
+	EdgeSecurityPolicy types.String  `tfsdk:"edge_security_policy"`
+}
+
+// NewBackendServiceSecurityPolicyAttachmentResource
+func NewBackendServiceSecurityPolicyAttachmentResource() resource.Resource {
+	return &backendServiceSecurityPolicyAttachmentResource{}
+}
+
+// Metadata
+func (r *backendServiceSecurityPolicyAttachmentResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backend_service_security_policy_attachment"
+}
+
+// Schema
+func (r *backendServiceSecurityPolicyAttachmentResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attach the Cloud Armor security policy and the edge security policy " +
+			"to an existing backend service managed elsewhere, so the security team " +
+			"can enforce the WAF independently of the team owning the backend service. The " +
+			"owner of backend service should ignore the changes of its security policies. " +
+			"Either security_policy or edge_security_policy must be set. The regional " +
+			"backend service only supports security_policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Self link of backend service.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backend_service": schema.StringAttribute{
+				Description: "Name of the global backend service, or self link of the global " +
+					"or regional backend service to attach security policies to.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_policy": schema.StringAttribute{
+				Description: "Self link or name of the Cloud Armor backend security policy.",
+				Optional:    true,
+			},
+			"edge_security_policy": schema.StringAttribute{
+				Description: "Self link or name of the Cloud Armor edge security policy.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *backendServiceSecurityPolicyAttachmentResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *backendServiceSecurityPolicyAttachmentResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state backendServiceSecurityPolicyAttachmentState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+	if state.SecurityPolicy.IsNull() && state.EdgeSecurityPolicy.IsNull() {
+		resp.Diagnostics.AddError("security_policy or edge_security_policy is required",
+			"Either security_policy or edge_security_policy must be set.")
+		return
+	}
+	if !validateBackendServiceSecurityPolicies(&state, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.setSecurityPolicies(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to set security policy of backend service", err.Error())
+		return
+	}
+
+	if err := r.refreshSecurityPolicies(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get backend service", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *backendServiceSecurityPolicyAttachmentResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backendServiceSecurityPolicyAttachmentState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.refreshSecurityPolicies(ctx, &state); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get backend service", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *backendServiceSecurityPolicyAttachmentResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state backendServiceSecurityPolicyAttachmentState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+	if plan.SecurityPolicy.IsNull() && plan.EdgeSecurityPolicy.IsNull() {
+		resp.Diagnostics.AddError("security_policy or edge_security_policy is required",
+			"Either security_policy or edge_security_policy must be set.")
+		return
+	}
+	if !validateBackendServiceSecurityPolicies(&plan, &resp.Diagnostics) {
+		return
+	}
+
+	// The security policy removed from plan is detached from backend service.
+	detach := &backendServiceSecurityPolicyAttachmentState{
+		BackendService: state.BackendService,
+	}
+	if plan.SecurityPolicy.IsNull() {
+		detach.SecurityPolicy = state.SecurityPolicy
+	}
+	if plan.EdgeSecurityPolicy.IsNull() {
+		detach.EdgeSecurityPolicy = state.EdgeSecurityPolicy
+	}
+	if err := r.clearSecurityPolicies(ctx, detach); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to clear security policy of backend service", err.Error())
+		return
+	}
+	if err := r.setSecurityPolicies(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to set security policy of backend service", err.Error())
+		return
+	}
+
+	if err := r.refreshSecurityPolicies(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get backend service", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *backendServiceSecurityPolicyAttachmentResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state backendServiceSecurityPolicyAttachmentState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.clearSecurityPolicies(ctx, &state); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to clear security policy of backend service", err.Error())
+	}
+}
+
+// setSecurityPolicies Attach the security policies set in state to backend
+// service.
+func (r *backendServiceSecurityPolicyAttachmentResource) setSecurityPolicies(ctx context.Context,
+	s *backendServiceSecurityPolicyAttachmentState) error {
+	return r.updateSecurityPolicies(ctx, s, false)
+}
+
+// clearSecurityPolicies Detach the security policies set in state from
+// backend service.
+func (r *backendServiceSecurityPolicyAttachmentResource) clearSecurityPolicies(ctx context.Context,
+	s *backendServiceSecurityPolicyAttachmentState) error {
+	return r.updateSecurityPolicies(ctx, s, true)
+}
+
+// updateSecurityPolicies Set or clear the security policies set in state of
+// backend service.
+func (r *backendServiceSecurityPolicyAttachmentResource) updateSecurityPolicies(ctx context.Context,
+	s *backendServiceSecurityPolicyAttachmentState, clear bool) error {
+	project, region, name := r.backendServiceScope(s)
+	if region != "" {
+		return r.patchRegionSecurityPolicy(ctx, s, clear)
+	}
+	backendServices := r.client.computeClient.BackendServices
+
+	newReference := func(securityPolicy types.String) *googleComputeClient.SecurityPolicyReference {
+		if clear {
+			return &googleComputeClient.SecurityPolicyReference{}
+		}
+		return &googleComputeClient.SecurityPolicyReference{SecurityPolicy: securityPolicy.ValueString()}
+	}
+
+	if !s.SecurityPolicy.IsNull() {
+		op, err := backendServices.SetSecurityPolicy(project, name, newReference(s.SecurityPolicy)).
+			Context(ctx).Do()
+		if err == nil {
+			err = waitComputeOperation(ctx, r.client.computeClient, project, op)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if !s.EdgeSecurityPolicy.IsNull() {
+		op, err := backendServices.SetEdgeSecurityPolicy(project, name, newReference(s.EdgeSecurityPolicy)).
+			Context(ctx).Do()
+		if err == nil {
+			err = waitComputeOperation(ctx, r.client.computeClient, project, op)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// patchRegionSecurityPolicy Set or clear the security policy of regional
+// backend service. The regional backend service has no setSecurityPolicy
+// method, so the security policy is patched with the fingerprint instead.
+func (r *backendServiceSecurityPolicyAttachmentResource) patchRegionSecurityPolicy(ctx context.Context,
+	s *backendServiceSecurityPolicyAttachmentState, clear bool) error {
+	if s.SecurityPolicy.IsNull() {
+		return nil
+	}
+	project, region, name := r.backendServiceScope(s)
+	regionBackendServices := r.client.computeClient.RegionBackendServices
+
+	updateFunc := func() error {
+		backendService, err := regionBackendServices.Get(project, region, name).Context(ctx).Do()
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+
+		patch := &googleComputeClient.BackendService{Fingerprint: backendService.Fingerprint}
+		if clear {
+			patch.NullFields = []string{"SecurityPolicy"}
+		} else {
+			patch.SecurityPolicy = s.SecurityPolicy.ValueString()
+		}
+		op, err := regionBackendServices.Patch(project, region, name, patch).Context(ctx).Do()
+		if err != nil {
+			// The backend service is updated by others, retry with the new fingerprint.
+			if isPreconditionFailedError(err) {
+				return err
+			}
+			return &backoff.PermanentError{Err: err}
+		}
+		if err := waitComputeOperation(ctx, r.client.computeClient, project, op); err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		return nil
+	}
+	return backoff.Retry(updateFunc, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), maxRetryTimes))
+}
+
+// refreshSecurityPolicies gets backend service and updates the attached
+// security policies in state.
+func (r *backendServiceSecurityPolicyAttachmentResource) refreshSecurityPolicies(ctx context.Context,
+	s *backendServiceSecurityPolicyAttachmentState) error {
+	var backendService *googleComputeClient.BackendService
+	var err error
+	project, region, name := r.backendServiceScope(s)
+	if region != "" {
+		backendService, err = r.client.computeClient.RegionBackendServices.Get(project, region, name).
+			Context(ctx).Do()
+	} else {
+		backendService, err = r.client.computeClient.BackendServices.Get(project, name).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+
+	s.ID = types.StringValue(backendService.SelfLink)
+	s.SecurityPolicy = securityPolicyReferenceValue(s.SecurityPolicy, backendService.SecurityPolicy)
+	s.EdgeSecurityPolicy = securityPolicyReferenceValue(s.EdgeSecurityPolicy, backendService.EdgeSecurityPolicy)
+	return nil
+}
+
+// backendServiceScope returns the project, the region and the name of backend
+// service, the region is empty for the global backend service. The backend
+// service set by name is the global backend service of the provider project.
+func (r *backendServiceSecurityPolicyAttachmentResource) backendServiceScope(
+	s *backendServiceSecurityPolicyAttachmentState) (project, region, name string) {
+	backendService := s.BackendService.ValueString()
+	project, scope, collection, name := parseComputeSelfLink(backendService)
+	if collection != "backendServices" {
+		return r.client.project, "", backendService
+	}
+	if scope != globalScope {
+		region = scope
+	}
+	return project, region, name
+}
+
+// validateBackendServiceSecurityPolicies rejects the edge security policy of
+// regional backend service, which is only supported by the global backend
+// service.
+func validateBackendServiceSecurityPolicies(s *backendServiceSecurityPolicyAttachmentState,
+	diags *diag.Diagnostics) bool {
+	_, scope, collection, _ := parseComputeSelfLink(s.BackendService.ValueString())
+	if collection == "backendServices" && scope != globalScope && !s.EdgeSecurityPolicy.IsNull() {
+		diags.AddError("edge_security_policy is not supported by regional backend service",
+			"The edge security policy can only be attached to the global backend service, "+
+				"please remove edge_security_policy.")
+		return false
+	}
+	return true
+}
+
+// securityPolicyReferenceValue returns the security policy in state if it
+// references the attached security policy, so the name and the self link of
+// the same security policy are not treated as a change. The security policy
+// not managed by the attachment is kept null.
+func securityPolicyReferenceValue(securityPolicy types.String, selfLink string) types.String {
+	if securityPolicy.IsNull() || matchResourceReference(selfLink, securityPolicy.ValueString()) {
+		return securityPolicy
+	}
+	return types.StringValue(selfLink)
+}
@@ -0,0 +1,656 @@
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const (
+	certRotationStepUploaded  = "UPLOADED"
+	certRotationStepAttached  = "ATTACHED"
+	certRotationStepVerified  = "VERIFIED"
+	certRotationStepDetached  = "DETACHED"
+	certRotationStepCompleted = "COMPLETED"
+
+	defaultCertRotationGracePeriodSecs   = 300
+	defaultCertRotationVerifyTimeoutSecs = 600
+	certRotationVerifyIntervalSecs       = 10
+	certRotationNameSuffixLength         = 8
+)
+
+// certRotationOrchestratorResource Present st-gcp_cert_rotation_orchestrator resource
+type certRotationOrchestratorResource struct {
+	client *gcpClients
+}
+
+type certRotationOrchestratorState struct {
+	ClientConfig            *clientConfig `tfsdk:"client_config"`
+	ID                      types.String  `tfsdk:"id"`
+	NamePrefix              types.String  `tfsdk:"name_prefix"`
+	Certificate             types.String  `tfsdk:"certificate"`
+	PrivateKey              types.String  `tfsdk:"private_key"`
+	TargetHTTPSProxies      types.List    `tfsdk:"target_https_proxies"`
+	VerifyHost              types.String  `tfsdk:"verify_host"`
+	VerifyPort              types.Int64   `tfsdk:"verify_port"`
+	VerifyServerName        types.String  `tfsdk:"verify_server_name"`
+	VerifyTimeoutSeconds    types.Int64   `tfsdk:"verify_timeout_seconds"`
+	GracePeriodSeconds      types.Int64   `tfsdk:"grace_period_seconds"`
+	CertificateName         types.String  `tfsdk:"certificate_name"`
+	PendingCertificateName  types.String  `tfsdk:"pending_certificate_name"`
+	PreviousCertificateName types.String  `tfsdk:"previous_certificate_name"`
+	RotationStep            types.String  `tfsdk:"rotation_step"`
+	RotatedAt               types.String  `tfsdk:"rotated_at"`
+}
+
+// NewCertRotationOrchestratorResource
+func NewCertRotationOrchestratorResource() resource.Resource {
+	return &certRotationOrchestratorResource{}
+}
+
+// Metadata
+func (r *certRotationOrchestratorResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cert_rotation_orchestrator"
+}
+
+// Schema
+func (r *certRotationOrchestratorResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotate the global SSL certificate of target HTTPS proxies without " +
+			"downtime. A new certificate is uploaded and attached alongside the old one, " +
+			"the live endpoint is verified to serve it with a TLS handshake, then the old " +
+			"certificate is detached and deleted after a grace period. Each step is " +
+			"recorded in state, so a failed rotation is resumed on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name prefix of the certificates.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Prefix of the names of the SSL certificates, a random suffix " +
+					"is appended on every rotation.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Description: "Certificate chain in PEM format, starting from the leaf " +
+					"certificate. Changing it rotates the certificate.",
+				Required: true,
+			},
+			"private_key": schema.StringAttribute{
+				Description: "Private key of the certificate in PEM format.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"target_https_proxies": schema.ListAttribute{
+				Description: "Names of the global target HTTPS proxies serving the certificate.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"verify_host": schema.StringAttribute{
+				Description: "Hostname or IP address of the load balancer to verify the new " +
+					"certificate is served. The verification is skipped if not set.",
+				Optional: true,
+			},
+			"verify_port": schema.Int64Attribute{
+				Description: "Port of the verification. Default to " + strconv.Itoa(defaultTLSPort) + ".",
+				Optional:    true,
+			},
+			"verify_server_name": schema.StringAttribute{
+				Description: "Server name indication of the verification. Default to the verify host.",
+				Optional:    true,
+			},
+			"verify_timeout_seconds": schema.Int64Attribute{
+				Description: "Seconds to wait for the load balancer to serve the new certificate. " +
+					"Default to " + strconv.Itoa(defaultCertRotationVerifyTimeoutSecs) + ".",
+				Optional: true,
+			},
+			"grace_period_seconds": schema.Int64Attribute{
+				Description: "Seconds to keep the old certificate attached after the new one " +
+					"is verified. Default to " + strconv.Itoa(defaultCertRotationGracePeriodSecs) + ".",
+				Optional: true,
+			},
+			"certificate_name": schema.StringAttribute{
+				Description: "Name of the SSL certificate of the last completed rotation.",
+				Computed:    true,
+			},
+			"pending_certificate_name": schema.StringAttribute{
+				Description: "Name of the SSL certificate being rotated in, empty if no " +
+					"rotation is in progress.",
+				Computed: true,
+			},
+			"previous_certificate_name": schema.StringAttribute{
+				Description: "Name of the SSL certificate being rotated out, empty if no " +
+					"rotation is in progress.",
+				Computed: true,
+			},
+			"rotation_step": schema.StringAttribute{
+				Description: "Last completed step of the rotation, UPLOADED, ATTACHED, " +
+					"VERIFIED, DETACHED or COMPLETED.",
+				Computed: true,
+			},
+			"rotated_at": schema.StringAttribute{
+				Description: "The time of the last completed rotation in RFC3339 format.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *certRotationOrchestratorResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan
+func (r *certRotationOrchestratorResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var rotationStep types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotation_step"), &rotationStep)...)
+	if resp.Diagnostics.HasError() || rotationStep.ValueString() == certRotationStepCompleted {
+		return
+	}
+
+	// Mark the progress unknown so that the interrupted rotation is resumed.
+	for _, attribute := range []string{"certificate_name", "pending_certificate_name",
+		"previous_certificate_name", "rotation_step", "rotated_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+	}
+}
+
+// Create
+func (r *certRotationOrchestratorResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state certRotationOrchestratorState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	state.ID = state.NamePrefix
+	state.CertificateName = types.StringValue("")
+	state.PendingCertificateName = types.StringValue("")
+	state.PreviousCertificateName = types.StringValue("")
+	state.RotationStep = types.StringValue("")
+	state.RotatedAt = types.StringValue("")
+
+	// The progress is recorded in state on every failure so that the rotation
+	// can be resumed or destroyed.
+	defer func() {
+		if state.PendingCertificateName.ValueString() != "" || state.CertificateName.ValueString() != "" {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		}
+	}()
+
+	if err := r.rotate(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to rotate certificate", err.Error())
+		return
+	}
+}
+
+// Read
+func (r *certRotationOrchestratorResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state certRotationOrchestratorState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	// The state of an interrupted rotation is kept to be resumed.
+	if state.RotationStep.ValueString() != certRotationStepCompleted {
+		return
+	}
+
+	_, err := r.client.computeClient.SslCertificates.Get(r.client.project,
+		state.CertificateName.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get SSL certificate", err.Error())
+		return
+	}
+}
+
+// Update
+func (r *certRotationOrchestratorResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state certRotationOrchestratorState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.CertificateName = state.CertificateName
+	plan.PendingCertificateName = state.PendingCertificateName
+	plan.PreviousCertificateName = state.PreviousCertificateName
+	plan.RotationStep = state.RotationStep
+	plan.RotatedAt = state.RotatedAt
+	defer func() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}()
+
+	var planProxies, stateProxies []string
+	resp.Diagnostics.Append(plan.TargetHTTPSProxies.ElementsAs(ctx, &planProxies, false)...)
+	resp.Diagnostics.Append(state.TargetHTTPSProxies.ElementsAs(ctx, &stateProxies, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The certificates are moved to the added proxies before any rotation,
+	// so that the rotation covers every proxy.
+	addedProxies := stringsNotIn(planProxies, stateProxies)
+	removedProxies := stringsNotIn(stateProxies, planProxies)
+	for _, name := range []string{plan.CertificateName.ValueString(), plan.PendingCertificateName.ValueString()} {
+		if name == "" {
+			continue
+		}
+		if err := r.updateProxyCertificates(ctx, removedProxies, "", name); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to detach SSL certificate", err.Error())
+			return
+		}
+	}
+	attachCertificates := []string{}
+	switch plan.RotationStep.ValueString() {
+	case certRotationStepCompleted, certRotationStepUploaded:
+		attachCertificates = append(attachCertificates, plan.CertificateName.ValueString())
+	case certRotationStepAttached, certRotationStepVerified:
+		attachCertificates = append(attachCertificates, plan.CertificateName.ValueString(),
+			plan.PendingCertificateName.ValueString())
+	case certRotationStepDetached:
+		attachCertificates = append(attachCertificates, plan.PendingCertificateName.ValueString())
+	}
+	for _, name := range attachCertificates {
+		if name == "" {
+			continue
+		}
+		if err := r.updateProxyCertificates(ctx, addedProxies, name, ""); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to attach SSL certificate", err.Error())
+			return
+		}
+	}
+
+	certificateChanged := plan.Certificate.ValueString() != state.Certificate.ValueString() ||
+		plan.PrivateKey.ValueString() != state.PrivateKey.ValueString()
+	if certificateChanged && plan.PendingCertificateName.ValueString() != "" {
+		switch plan.RotationStep.ValueString() {
+		case certRotationStepUploaded, certRotationStepAttached:
+			// The interrupted rotation is abandoned before its certificate is
+			// verified, as it is superseded by the new certificate.
+			if err := r.abandonRotation(ctx, &plan); err != nil {
+				resp.Diagnostics.AddError("Failed to abandon interrupted rotation", err.Error())
+				return
+			}
+		default:
+			// The certificate of the interrupted rotation is already serving,
+			// so the rotation is completed before the new one.
+			if err := r.rotate(ctx, &plan); err != nil {
+				resp.Diagnostics.AddError("Failed to resume interrupted rotation", err.Error())
+				return
+			}
+		}
+	}
+
+	if certificateChanged || plan.RotationStep.ValueString() != certRotationStepCompleted {
+		if err := r.rotate(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("Failed to rotate certificate", err.Error())
+			return
+		}
+	}
+}
+
+// Delete
+func (r *certRotationOrchestratorResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state certRotationOrchestratorState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	var proxies []string
+	resp.Diagnostics.Append(state.TargetHTTPSProxies.ElementsAs(ctx, &proxies, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, name := range []string{state.PendingCertificateName.ValueString(),
+		state.PreviousCertificateName.ValueString(), state.CertificateName.ValueString()} {
+		if name == "" {
+			continue
+		}
+		if err := r.updateProxyCertificates(ctx, proxies, "", name); err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError("[API ERROR] Failed to detach SSL certificate", err.Error())
+			return
+		}
+		if err := r.deleteCertificate(ctx, name); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to delete SSL certificate", err.Error())
+			return
+		}
+	}
+}
+
+// rotate Run the rotation from the last completed step. The state is updated
+// after every step, so that it can be saved on failure.
+func (r *certRotationOrchestratorResource) rotate(ctx context.Context,
+	s *certRotationOrchestratorState) error {
+	var proxies []string
+	if diags := s.TargetHTTPSProxies.ElementsAs(ctx, &proxies, false); diags.HasError() {
+		return errors.New("invalid target_https_proxies")
+	}
+
+	if s.PendingCertificateName.ValueString() == "" {
+		name, err := r.uploadCertificate(ctx, s)
+		if err != nil {
+			return err
+		}
+		s.PendingCertificateName = types.StringValue(name)
+		s.PreviousCertificateName = s.CertificateName
+		s.RotationStep = types.StringValue(certRotationStepUploaded)
+	}
+	pending := s.PendingCertificateName.ValueString()
+	previous := s.PreviousCertificateName.ValueString()
+
+	if s.RotationStep.ValueString() == certRotationStepUploaded {
+		if err := r.updateProxyCertificates(ctx, proxies, pending, ""); err != nil {
+			return fmt.Errorf("failed to attach SSL certificate %s: %v", pending, err)
+		}
+		s.RotationStep = types.StringValue(certRotationStepAttached)
+	}
+
+	if s.RotationStep.ValueString() == certRotationStepAttached {
+		if err := verifyRotatedCertificate(ctx, s); err != nil {
+			return fmt.Errorf("failed to verify SSL certificate %s is served: %v", pending, err)
+		}
+		s.RotationStep = types.StringValue(certRotationStepVerified)
+	}
+
+	if s.RotationStep.ValueString() == certRotationStepVerified {
+		if previous != "" {
+			gracePeriod := time.Duration(defaultCertRotationGracePeriodSecs) * time.Second
+			if !s.GracePeriodSeconds.IsNull() {
+				gracePeriod = time.Duration(s.GracePeriodSeconds.ValueInt64()) * time.Second
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(gracePeriod):
+			}
+			if err := r.updateProxyCertificates(ctx, proxies, "", previous); err != nil {
+				return fmt.Errorf("failed to detach SSL certificate %s: %v", previous, err)
+			}
+		}
+		s.RotationStep = types.StringValue(certRotationStepDetached)
+	}
+
+	if previous != "" {
+		if err := r.deleteCertificate(ctx, previous); err != nil {
+			return fmt.Errorf("failed to delete SSL certificate %s: %v", previous, err)
+		}
+	}
+	s.CertificateName = types.StringValue(pending)
+	s.PendingCertificateName = types.StringValue("")
+	s.PreviousCertificateName = types.StringValue("")
+	s.RotationStep = types.StringValue(certRotationStepCompleted)
+	s.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	return nil
+}
+
+// abandonRotation Detach and delete the certificate of the interrupted
+// rotation, and restore the state of the last completed rotation.
+func (r *certRotationOrchestratorResource) abandonRotation(ctx context.Context,
+	s *certRotationOrchestratorState) error {
+	var proxies []string
+	if diags := s.TargetHTTPSProxies.ElementsAs(ctx, &proxies, false); diags.HasError() {
+		return errors.New("invalid target_https_proxies")
+	}
+
+	pending := s.PendingCertificateName.ValueString()
+	if err := r.updateProxyCertificates(ctx, proxies, "", pending); err != nil {
+		return fmt.Errorf("failed to detach SSL certificate %s: %v", pending, err)
+	}
+	if err := r.deleteCertificate(ctx, pending); err != nil {
+		return fmt.Errorf("failed to delete SSL certificate %s: %v", pending, err)
+	}
+
+	s.CertificateName = s.PreviousCertificateName
+	s.PendingCertificateName = types.StringValue("")
+	s.PreviousCertificateName = types.StringValue("")
+	s.RotationStep = types.StringValue(certRotationStepCompleted)
+	return nil
+}
+
+// uploadCertificate Create the SSL certificate with a random suffix and
+// return its name.
+func (r *certRotationOrchestratorResource) uploadCertificate(ctx context.Context,
+	s *certRotationOrchestratorState) (string, error) {
+	suffix, err := randomHexSuffix(certRotationNameSuffixLength)
+	if err != nil {
+		return "", err
+	}
+	name := s.NamePrefix.ValueString() + "-" + suffix
+
+	certificate := &googleComputeClient.SslCertificate{
+		Name:        name,
+		Certificate: s.Certificate.ValueString(),
+		PrivateKey:  s.PrivateKey.ValueString(),
+	}
+	if r.client.changeReference != "" {
+		certificate.Description = changeReferenceKey + ":" + r.client.changeReference
+	}
+	op, err := r.client.computeClient.SslCertificates.Insert(r.client.project, certificate).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create SSL certificate %s: %v", name, err)
+	}
+	return name, nil
+}
+
+// deleteCertificate Delete the SSL certificate, ignoring if it is not found.
+func (r *certRotationOrchestratorResource) deleteCertificate(ctx context.Context, name string) error {
+	op, err := r.client.computeClient.SslCertificates.Delete(r.client.project, name).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+	return nil
+}
+
+// updateProxyCertificates Attach and/or detach the SSL certificate on the
+// target HTTPS proxies. The attached certificate is put first, so that it is
+// the primary certificate of the proxies.
+func (r *certRotationOrchestratorResource) updateProxyCertificates(ctx context.Context,
+	proxies []string, attach, detach string) error {
+	project := r.client.project
+	for _, proxyName := range proxies {
+		proxy, err := r.client.computeClient.TargetHttpsProxies.Get(project, proxyName).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+
+		certificates := []string{}
+		if attach != "" {
+			certificates = append(certificates, fmt.Sprintf("projects/%s/global/sslCertificates/%s", project, attach))
+		}
+		for _, certificate := range proxy.SslCertificates {
+			name := resourceNameFromSelfLink(certificate)
+			if name == attach || name == detach {
+				continue
+			}
+			certificates = append(certificates, certificate)
+		}
+		if len(certificates) == len(proxy.SslCertificates) && (attach == "" ||
+			resourceNameFromSelfLink(proxy.SslCertificates[0]) == attach) {
+			continue
+		}
+
+		op, err := r.client.computeClient.TargetHttpsProxies.SetSslCertificates(project, proxyName,
+			&googleComputeClient.TargetHttpsProxiesSetSslCertificatesRequest{
+				SslCertificates: certificates,
+			}).Context(ctx).Do()
+		if err == nil {
+			err = waitComputeOperation(ctx, r.client.computeClient, project, op)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyRotatedCertificate Wait until the verify host serves the leaf of the
+// new certificate chain, or the verify timeout is reached.
+func verifyRotatedCertificate(ctx context.Context, s *certRotationOrchestratorState) error {
+	if s.VerifyHost.ValueString() == "" {
+		tflog.Warn(ctx, "verify_host is not set, skip the verification of the new certificate")
+		return nil
+	}
+
+	block, _ := pem.Decode([]byte(s.Certificate.ValueString()))
+	if block == nil {
+		return errors.New("certificate is not in PEM format")
+	}
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+
+	port := int64(defaultTLSPort)
+	if !s.VerifyPort.IsNull() {
+		port = s.VerifyPort.ValueInt64()
+	}
+	serverName := s.VerifyHost.ValueString()
+	if s.VerifyServerName.ValueString() != "" {
+		serverName = s.VerifyServerName.ValueString()
+	}
+	timeout := time.Duration(defaultCertRotationVerifyTimeoutSecs) * time.Second
+	if !s.VerifyTimeoutSeconds.IsNull() {
+		timeout = time.Duration(s.VerifyTimeoutSeconds.ValueInt64()) * time.Second
+	}
+	handshake := &tlsHandshakeRequest{
+		address:    net.JoinHostPort(s.VerifyHost.ValueString(), strconv.FormatInt(port, 10)),
+		serverName: serverName,
+		timeout:    time.Duration(defaultProbeTimeoutSecs) * time.Second,
+	}
+
+	// The load balancer takes minutes to serve the new certificate.
+	deadline := time.Now().Add(timeout)
+	for {
+		connState, err := tlsHandshake(ctx, handshake)
+		switch {
+		case err != nil:
+			tflog.Warn(ctx, "TLS handshake failed", map[string]interface{}{"error": err.Error()})
+		case len(connState.PeerCertificates) > 0 && bytes.Equal(connState.PeerCertificates[0].Raw, leaf.Raw):
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s still does not serve the certificate %s after %s",
+				handshake.address, certificateFingerprint(leaf), timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(certRotationVerifyIntervalSecs * time.Second):
+		}
+	}
+}
+
+// stringsNotIn returns the strings of a which are not in b.
+func stringsNotIn(a, b []string) []string {
+	set := make(map[string]bool, len(b))
+	for _, v := range b {
+		set[v] = true
+	}
+	result := []string{}
+	for _, v := range a {
+		if !set[v] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
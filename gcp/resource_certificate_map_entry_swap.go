@@ -0,0 +1,375 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleCertificateManagerClient "google.golang.org/api/certificatemanager/v1"
+)
+
+const managedCertificateStateActive = "ACTIVE"
+
+// certificateMapEntrySwapResource Present st-gcp_certificate_map_entry_swap resource
+type certificateMapEntrySwapResource struct {
+	client *gcpClients
+}
+
+type certificateMapEntrySwapState struct {
+	ClientConfig        *clientConfig `tfsdk:"client_config"`
+	ID                  types.String  `tfsdk:"id"`
+	Location            types.String  `tfsdk:"location"`
+	CertificateMap      types.String  `tfsdk:"certificate_map"`
+	CertificateMapEntry types.String  `tfsdk:"certificate_map_entry"`
+	Certificate         types.String  `tfsdk:"certificate"`
+	Verify              types.Bool    `tfsdk:"verify"`
+	PreviousCertificate types.String  `tfsdk:"previous_certificate"`
+}
+
+// NewCertificateMapEntrySwapResource
+func NewCertificateMapEntrySwapResource() resource.Resource {
+	return &certificateMapEntrySwapResource{}
+}
+
+// Metadata
+func (r *certificateMapEntrySwapResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_map_entry_swap"
+}
+
+// Schema
+func (r *certificateMapEntrySwapResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Swap the primary certificate of an existing Certificate Manager " +
+			"certificate map entry managed elsewhere to a new certificate in a single " +
+			"update, keeping the backup certificates of entry, for the zero-downtime " +
+			"certificate cutovers. The new certificate is optionally verified to cover the " +
+			"hostname of entry and to be ACTIVE before the swap. Destroying the resource " +
+			"does not swap the certificate back.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource name of certificate map entry.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Description: "Location of certificate map. Default to " + globalScope + ".",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_map": schema.StringAttribute{
+				Description: "Name of certificate map.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_map_entry": schema.StringAttribute{
+				Description: "Name of the certificate map entry to swap certificate of.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Description: "Name or resource name of the new primary certificate, e.g. " +
+					"projects/my-project/locations/global/certificates/www-2025.",
+				Required: true,
+			},
+			"verify": schema.BoolAttribute{
+				Description: "Verify that the new certificate covers the hostname of entry " +
+					"and is ACTIVE if it is a Google managed certificate, or not expired if " +
+					"it is a self managed certificate. Default to false.",
+				Optional: true,
+			},
+			"previous_certificate": schema.StringAttribute{
+				Description: "Resource name of the primary certificate before the last swap, " +
+					"to roll back to.",
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *certificateMapEntrySwapResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *certificateMapEntrySwapResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state certificateMapEntrySwapState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("projects/%s/locations/%s/certificateMaps/%s/certificateMapEntries/%s",
+		r.client.project, r.location(&state), state.CertificateMap.ValueString(),
+		state.CertificateMapEntry.ValueString()))
+	if err := r.swapCertificate(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to swap certificate", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *certificateMapEntrySwapResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state certificateMapEntrySwapState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	certificateManagerClient, err := googleCertificateManagerClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Certificate Manager client", err.Error())
+		return
+	}
+
+	entry, err := certificateManagerClient.Projects.Locations.CertificateMaps.CertificateMapEntries.
+		Get(state.ID.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get certificate map entry", err.Error())
+		return
+	}
+
+	// The primary certificate swapped by others is swapped again.
+	primary := ""
+	if len(entry.Certificates) > 0 {
+		primary = entry.Certificates[0]
+	}
+	if !matchResourceReference(primary, state.Certificate.ValueString()) {
+		state.Certificate = types.StringValue(primary)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *certificateMapEntrySwapResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state certificateMapEntrySwapState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.PreviousCertificate = state.PreviousCertificate
+	if plan.Certificate.Equal(state.Certificate) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+	if err := r.swapCertificate(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to swap certificate", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *certificateMapEntrySwapResource) Delete(_ context.Context,
+	_ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+	// The certificate map entry is owned by others and the previous
+	// certificate may be deleted already, only remove from state.
+}
+
+// location returns the location of certificate map in state.
+func (r *certificateMapEntrySwapResource) location(s *certificateMapEntrySwapState) string {
+	if s.Location.ValueString() != "" {
+		return s.Location.ValueString()
+	}
+	return globalScope
+}
+
+// swapCertificate Replace the primary certificate of entry with the
+// certificate in state, after verifying it if enabled.
+func (r *certificateMapEntrySwapResource) swapCertificate(ctx context.Context,
+	s *certificateMapEntrySwapState) error {
+	certificateManagerClient, err := googleCertificateManagerClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to initialize Google Certificate Manager client: %v", err)
+	}
+
+	entries := certificateManagerClient.Projects.Locations.CertificateMaps.CertificateMapEntries
+	entry, err := entries.Get(s.ID.ValueString()).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	certificateName := s.Certificate.ValueString()
+	if !strings.Contains(certificateName, "/") {
+		certificateName = fmt.Sprintf("projects/%s/locations/%s/certificates/%s",
+			r.client.project, r.location(s), certificateName)
+	}
+	if s.Verify.ValueBool() {
+		certificate, err := certificateManagerClient.Projects.Locations.Certificates.Get(certificateName).
+			Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+		if err := verifyCertificateForEntry(certificate, entry); err != nil {
+			return err
+		}
+	}
+
+	certificates := []string{certificateName}
+	previous := ""
+	if len(entry.Certificates) > 0 {
+		previous = entry.Certificates[0]
+		for _, certificate := range entry.Certificates[1:] {
+			if certificate != certificateName {
+				certificates = append(certificates, certificate)
+			}
+		}
+	}
+	// The certificate is the primary certificate already.
+	if previous == certificateName {
+		if s.PreviousCertificate.IsUnknown() {
+			s.PreviousCertificate = types.StringNull()
+		}
+		return nil
+	}
+
+	op, err := entries.Patch(entry.Name, &googleCertificateManagerClient.CertificateMapEntry{
+		Certificates: certificates,
+	}).UpdateMask("certificates").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if err := waitCertificateManagerOperation(ctx, certificateManagerClient, op); err != nil {
+		return err
+	}
+	s.PreviousCertificate = types.StringValue(previous)
+	return nil
+}
+
+// verifyCertificateForEntry returns an error if the certificate does not
+// cover the hostname of entry, or it is not ACTIVE or expired.
+func verifyCertificateForEntry(certificate *googleCertificateManagerClient.Certificate,
+	entry *googleCertificateManagerClient.CertificateMapEntry) error {
+	if certificate.Managed != nil && certificate.Managed.State != managedCertificateStateActive {
+		return fmt.Errorf("certificate %s is %s, not %s", certificate.Name,
+			certificate.Managed.State, managedCertificateStateActive)
+	}
+	if certificate.ExpireTime != "" {
+		expireTime, err := time.Parse(time.RFC3339, certificate.ExpireTime)
+		if err == nil && time.Now().After(expireTime) {
+			return fmt.Errorf("certificate %s expired at %s", certificate.Name, certificate.ExpireTime)
+		}
+	}
+
+	// The entry of the primary matcher has no hostname.
+	if entry.Hostname == "" {
+		return nil
+	}
+	dnsNames := certificate.SanDnsnames
+	if certificate.Managed != nil {
+		dnsNames = append(dnsNames, certificate.Managed.Domains...)
+	}
+	for _, dnsName := range dnsNames {
+		if matchCertificateDNSName(dnsName, entry.Hostname) {
+			return nil
+		}
+	}
+	return fmt.Errorf("certificate %s does not cover hostname %s", certificate.Name, entry.Hostname)
+}
+
+// matchCertificateDNSName returns whether the DNS name of certificate covers
+// the hostname, the wildcard DNS name covers a single label.
+func matchCertificateDNSName(dnsName, hostname string) bool {
+	dnsName, hostname = strings.ToLower(dnsName), strings.ToLower(hostname)
+	if dnsName == hostname {
+		return true
+	}
+	if !strings.HasPrefix(dnsName, "*.") {
+		return false
+	}
+	label, domain, found := strings.Cut(hostname, ".")
+	return found && label != "" && label != "*" && "*."+domain == dnsName
+}
+
+// waitCertificateManagerOperation waits for the Certificate Manager operation
+// to be done.
+func waitCertificateManagerOperation(ctx context.Context, client *googleCertificateManagerClient.Service,
+	op *googleCertificateManagerClient.Operation) error {
+	var err error
+	for !op.Done {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+		op, err = client.Projects.Locations.Operations.Get(op.Name).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+
+	if op.Error != nil {
+		return fmt.Errorf("operation %s failed: %d: %s", op.Name, op.Error.Code, op.Error.Message)
+	}
+	return nil
+}
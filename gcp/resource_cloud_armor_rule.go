@@ -0,0 +1,428 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const (
+	cloudArmorSrcIPsExpr             = "SRC_IPS_V1"
+	defaultCloudArmorConformAction   = "allow"
+	defaultCloudArmorRateLimitKeyAll = "ALL"
+)
+
+// cloudArmorRuleResource Present st-gcp_cloud_armor_rule resource
+type cloudArmorRuleResource struct {
+	client *gcpClients
+}
+
+type cloudArmorRuleState struct {
+	ClientConfig     *clientConfig               `tfsdk:"client_config"`
+	ID               types.String                `tfsdk:"id"`
+	SecurityPolicy   types.String                `tfsdk:"security_policy"`
+	Priority         types.Int64                 `tfsdk:"priority"`
+	Action           types.String                `tfsdk:"action"`
+	Description      types.String                `tfsdk:"description"`
+	Preview          types.Bool                  `tfsdk:"preview"`
+	Expression       types.String                `tfsdk:"expression"`
+	SrcIPRanges      types.List                  `tfsdk:"src_ip_ranges"`
+	RateLimitOptions *cloudArmorRateLimitOptions `tfsdk:"rate_limit_options"`
+}
+
+type cloudArmorRateLimitOptions struct {
+	ConformAction        types.String `tfsdk:"conform_action"`
+	ExceedAction         types.String `tfsdk:"exceed_action"`
+	ThresholdCount       types.Int64  `tfsdk:"threshold_count"`
+	ThresholdIntervalSec types.Int64  `tfsdk:"threshold_interval_sec"`
+	EnforceOnKey         types.String `tfsdk:"enforce_on_key"`
+	BanDurationSec       types.Int64  `tfsdk:"ban_duration_sec"`
+}
+
+// NewCloudArmorRuleResource
+func NewCloudArmorRuleResource() resource.Resource {
+	return &cloudArmorRuleResource{}
+}
+
+// Metadata
+func (r *cloudArmorRuleResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_armor_rule"
+}
+
+// Schema
+func (r *cloudArmorRuleResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a single rule of an existing Cloud Armor security policy " +
+			"managed elsewhere. Only the rule of priority is changed, so multiple teams can " +
+			"contribute rules to one central security policy. Either expression or " +
+			"src_ip_ranges must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Self link of security policy and priority of rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_policy": schema.StringAttribute{
+				Description: "Name of the security policy to add rule to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description: "Priority of rule, the rule of lower priority is evaluated first.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "Action of rule, e.g. allow, deny(403), redirect, throttle or " +
+					"rate_based_ban.",
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of rule.",
+				Optional:    true,
+			},
+			"preview": schema.BoolAttribute{
+				Description: "Whether rule is only logged without being enforced.",
+				Optional:    true,
+			},
+			"expression": schema.StringAttribute{
+				Description: "Common Expression Language expression of the requests " +
+					"matched by rule, e.g. origin.region_code == 'AU'.",
+				Optional: true,
+			},
+			"src_ip_ranges": schema.ListAttribute{
+				Description: "Source IP CIDR ranges of the requests matched by rule.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"rate_limit_options": schema.SingleNestedAttribute{
+				Description: "Rate limit options of the throttle or the rate_based_ban rule.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"conform_action": schema.StringAttribute{
+						Description: "Action of the requests under threshold. Default to " +
+							defaultCloudArmorConformAction + ".",
+						Optional: true,
+					},
+					"exceed_action": schema.StringAttribute{
+						Description: "Action of the requests above threshold, e.g. deny(429).",
+						Required:    true,
+					},
+					"threshold_count": schema.Int64Attribute{
+						Description: "Number of requests allowed in threshold_interval_sec.",
+						Required:    true,
+					},
+					"threshold_interval_sec": schema.Int64Attribute{
+						Description: "Interval in seconds of threshold_count.",
+						Required:    true,
+					},
+					"enforce_on_key": schema.StringAttribute{
+						Description: "Key of the requests to be rate limited separately, e.g. " +
+							"IP or HTTP_HEADER. Default to " + defaultCloudArmorRateLimitKeyAll + ".",
+						Optional: true,
+					},
+					"ban_duration_sec": schema.Int64Attribute{
+						Description: "Duration in seconds of the ban of the rate_based_ban rule.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *cloudArmorRuleResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *cloudArmorRuleResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state cloudArmorRuleState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	rule, diags := newSecurityPolicyRule(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	op, err := r.client.computeClient.SecurityPolicies.AddRule(r.client.project,
+		state.SecurityPolicy.ValueString(), rule).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to add rule to security policy", err.Error())
+		return
+	}
+
+	if err := r.refreshRule(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get security policy", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *cloudArmorRuleResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cloudArmorRuleState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.refreshRule(ctx, &state); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get security policy", err.Error())
+		return
+	}
+	// The rule is removed by others.
+	if state.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *cloudArmorRuleResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan cloudArmorRuleState
+	d := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	rule, diags := newSecurityPolicyRule(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	op, err := r.client.computeClient.SecurityPolicies.PatchRule(r.client.project,
+		plan.SecurityPolicy.ValueString(), rule).Priority(plan.Priority.ValueInt64()).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update rule of security policy", err.Error())
+		return
+	}
+
+	if err := r.refreshRule(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get security policy", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *cloudArmorRuleResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state cloudArmorRuleState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	op, err := r.client.computeClient.SecurityPolicies.RemoveRule(r.client.project,
+		state.SecurityPolicy.ValueString()).Priority(state.Priority.ValueInt64()).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to remove rule from security policy", err.Error())
+	}
+}
+
+// refreshRule gets the rule of priority from security policy and updates the
+// settings in state, the ID is set to null if the rule does not exist.
+func (r *cloudArmorRuleResource) refreshRule(ctx context.Context, s *cloudArmorRuleState) error {
+	securityPolicy, err := r.client.computeClient.SecurityPolicies.Get(r.client.project,
+		s.SecurityPolicy.ValueString()).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	var rule *googleComputeClient.SecurityPolicyRule
+	for _, policyRule := range securityPolicy.Rules {
+		if policyRule.Priority == s.Priority.ValueInt64() {
+			rule = policyRule
+			break
+		}
+	}
+	if rule == nil {
+		s.ID = types.StringNull()
+		return nil
+	}
+
+	s.ID = types.StringValue(fmt.Sprintf("%s|%d", securityPolicy.SelfLink, rule.Priority))
+	s.Action = types.StringValue(rule.Action)
+	if !s.Description.IsNull() || rule.Description != "" {
+		s.Description = types.StringValue(rule.Description)
+	}
+	if !s.Preview.IsNull() || rule.Preview {
+		s.Preview = types.BoolValue(rule.Preview)
+	}
+	if rule.Match != nil {
+		if rule.Match.Expr != nil {
+			s.Expression = types.StringValue(rule.Match.Expr.Expression)
+		}
+		if rule.Match.Config != nil {
+			srcIPRanges := []attr.Value{}
+			for _, ipRange := range rule.Match.Config.SrcIpRanges {
+				srcIPRanges = append(srcIPRanges, types.StringValue(ipRange))
+			}
+			s.SrcIPRanges = types.ListValueMust(types.StringType, srcIPRanges)
+		}
+	}
+	if o, options := s.RateLimitOptions, rule.RateLimitOptions; o != nil && options != nil {
+		o.ExceedAction = types.StringValue(options.ExceedAction)
+		if options.RateLimitThreshold != nil {
+			o.ThresholdCount = types.Int64Value(options.RateLimitThreshold.Count)
+			o.ThresholdIntervalSec = types.Int64Value(options.RateLimitThreshold.IntervalSec)
+		}
+		if !o.ConformAction.IsNull() {
+			o.ConformAction = types.StringValue(options.ConformAction)
+		}
+		if !o.EnforceOnKey.IsNull() {
+			o.EnforceOnKey = types.StringValue(options.EnforceOnKey)
+		}
+		if !o.BanDurationSec.IsNull() {
+			o.BanDurationSec = types.Int64Value(options.BanDurationSec)
+		}
+	}
+	return nil
+}
+
+// newSecurityPolicyRule converts the state into the rule of security policy.
+func newSecurityPolicyRule(ctx context.Context,
+	s *cloudArmorRuleState) (*googleComputeClient.SecurityPolicyRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	hasExpression := !(s.Expression.IsUnknown() || s.Expression.IsNull())
+	hasSrcIPRanges := !(s.SrcIPRanges.IsUnknown() || s.SrcIPRanges.IsNull())
+	if hasExpression == hasSrcIPRanges {
+		diags.AddError("expression or src_ip_ranges is required",
+			"Exactly one of expression and src_ip_ranges must be set.")
+		return nil, diags
+	}
+
+	match := &googleComputeClient.SecurityPolicyRuleMatcher{}
+	if hasExpression {
+		match.Expr = &googleComputeClient.Expr{Expression: s.Expression.ValueString()}
+	} else {
+		srcIPRanges := []string{}
+		diags.Append(s.SrcIPRanges.ElementsAs(ctx, &srcIPRanges, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		match.VersionedExpr = cloudArmorSrcIPsExpr
+		match.Config = &googleComputeClient.SecurityPolicyRuleMatcherConfig{
+			SrcIpRanges: srcIPRanges,
+		}
+	}
+
+	rule := &googleComputeClient.SecurityPolicyRule{
+		Priority:    s.Priority.ValueInt64(),
+		Action:      s.Action.ValueString(),
+		Description: s.Description.ValueString(),
+		Preview:     s.Preview.ValueBool(),
+		Match:       match,
+		// Unset preview must be sent explicitly on update.
+		ForceSendFields: []string{"Preview"},
+	}
+	if o := s.RateLimitOptions; o != nil {
+		conformAction := defaultCloudArmorConformAction
+		if o.ConformAction.ValueString() != "" {
+			conformAction = o.ConformAction.ValueString()
+		}
+		enforceOnKey := defaultCloudArmorRateLimitKeyAll
+		if o.EnforceOnKey.ValueString() != "" {
+			enforceOnKey = o.EnforceOnKey.ValueString()
+		}
+		rule.RateLimitOptions = &googleComputeClient.SecurityPolicyRuleRateLimitOptions{
+			ConformAction: conformAction,
+			ExceedAction:  o.ExceedAction.ValueString(),
+			EnforceOnKey:  enforceOnKey,
+			RateLimitThreshold: &googleComputeClient.SecurityPolicyRuleRateLimitOptionsThreshold{
+				Count:       o.ThresholdCount.ValueInt64(),
+				IntervalSec: o.ThresholdIntervalSec.ValueInt64(),
+			},
+			BanDurationSec: o.BanDurationSec.ValueInt64(),
+		}
+	}
+	return rule, diags
+}
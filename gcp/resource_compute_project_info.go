@@ -0,0 +1,346 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const (
+	xpnProjectStatusHost = "HOST"
+	osLoginMetadataKey   = "enable-oslogin"
+)
+
+// computeProjectInfoResource Present st-gcp_compute_project_info resource
+type computeProjectInfoResource struct {
+	client *gcpClients
+}
+
+type computeProjectInfoState struct {
+	ClientConfig          *clientConfig `tfsdk:"client_config"`
+	ID                    types.String  `tfsdk:"id"`
+	DefaultNetworkTier    types.String  `tfsdk:"default_network_tier"`
+	XpnHost               types.Bool    `tfsdk:"xpn_host"`
+	UsageExportBucket     types.String  `tfsdk:"usage_export_bucket"`
+	UsageExportPrefix     types.String  `tfsdk:"usage_export_prefix"`
+	EnableOsLogin         types.Bool    `tfsdk:"enable_oslogin"`
+	DefaultServiceAccount types.String  `tfsdk:"default_service_account"`
+}
+
+// NewComputeProjectInfoResource
+func NewComputeProjectInfoResource() resource.Resource {
+	return &computeProjectInfoResource{}
+}
+
+// Metadata
+func (r *computeProjectInfoResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_project_info"
+}
+
+// Schema
+func (r *computeProjectInfoResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the project-level compute settings of the provider project. Only " +
+			"the settings configured are managed, the settings are left unchanged on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Project ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_network_tier": schema.StringAttribute{
+				Description: "Default network tier of project, PREMIUM or STANDARD.",
+				Optional:    true,
+			},
+			"xpn_host": schema.BoolAttribute{
+				Description: "Whether project is a Shared VPC host project.",
+				Optional:    true,
+			},
+			"usage_export_bucket": schema.StringAttribute{
+				Description: "Name of the bucket the usage reports are exported to, the usage " +
+					"export is disabled if empty.",
+				Optional: true,
+			},
+			"usage_export_prefix": schema.StringAttribute{
+				Description: "Name prefix of the usage reports.",
+				Optional:    true,
+			},
+			"enable_oslogin": schema.BoolAttribute{
+				Description: "Value of the enable-oslogin project metadata.",
+				Optional:    true,
+			},
+			"default_service_account": schema.StringAttribute{
+				Description: "Email of the default service account of project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *computeProjectInfoResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *computeProjectInfoResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state computeProjectInfoState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.applyComputeProjectInfo(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update project compute settings", err.Error())
+		return
+	}
+	if err := r.refreshComputeProjectInfo(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get project", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *computeProjectInfoResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state computeProjectInfoState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.refreshComputeProjectInfo(ctx, &state); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get project", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *computeProjectInfoResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var state computeProjectInfoState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.applyComputeProjectInfo(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update project compute settings", err.Error())
+		return
+	}
+	if err := r.refreshComputeProjectInfo(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get project", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete
+func (r *computeProjectInfoResource) Delete(_ context.Context,
+	_ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"[Warning] Delete function will do nothing",
+		"The project compute settings are left unchanged, since reverting them, e.g. disabling "+
+			"the Shared VPC host project, may break the workloads of the project.",
+	)
+}
+
+// applyComputeProjectInfo updates the configured settings which are different
+// from the current settings of project.
+func (r *computeProjectInfoResource) applyComputeProjectInfo(ctx context.Context,
+	s *computeProjectInfoState) error {
+	project, err := r.client.computeClient.Projects.Get(r.client.project).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if !s.DefaultNetworkTier.IsNull() && s.DefaultNetworkTier.ValueString() != project.DefaultNetworkTier {
+		op, err := r.client.computeClient.Projects.SetDefaultNetworkTier(r.client.project,
+			&googleComputeClient.ProjectsSetDefaultNetworkTierRequest{
+				NetworkTier: s.DefaultNetworkTier.ValueString(),
+			}).Context(ctx).Do()
+		if err == nil {
+			err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if !s.XpnHost.IsNull() && s.XpnHost.ValueBool() != (project.XpnProjectStatus == xpnProjectStatusHost) {
+		var op *googleComputeClient.Operation
+		if s.XpnHost.ValueBool() {
+			op, err = r.client.computeClient.Projects.EnableXpnHost(r.client.project).Context(ctx).Do()
+		} else {
+			op, err = r.client.computeClient.Projects.DisableXpnHost(r.client.project).Context(ctx).Do()
+		}
+		if err == nil {
+			err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if !s.UsageExportBucket.IsNull() {
+		location := project.UsageExportLocation
+		if location == nil {
+			location = &googleComputeClient.UsageExportLocation{}
+		}
+		if s.UsageExportBucket.ValueString() != location.BucketName ||
+			s.UsageExportPrefix.ValueString() != location.ReportNamePrefix {
+			op, err := r.client.computeClient.Projects.SetUsageExportBucket(r.client.project,
+				&googleComputeClient.UsageExportLocation{
+					BucketName:       s.UsageExportBucket.ValueString(),
+					ReportNamePrefix: s.UsageExportPrefix.ValueString(),
+				}).Context(ctx).Do()
+			if err == nil {
+				err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !s.EnableOsLogin.IsNull() {
+		metadata := project.CommonInstanceMetadata
+		if metadata == nil {
+			metadata = &googleComputeClient.Metadata{}
+		}
+		value := strings.ToUpper(s.EnableOsLogin.String())
+		found := false
+		for _, item := range metadata.Items {
+			if item.Key == osLoginMetadataKey {
+				found = true
+				if item.Value != nil && strings.EqualFold(*item.Value, value) {
+					return nil
+				}
+				item.Value = &value
+			}
+		}
+		if !found {
+			metadata.Items = append(metadata.Items, &googleComputeClient.MetadataItems{
+				Key:   osLoginMetadataKey,
+				Value: &value,
+			})
+		}
+		// The fingerprint of the metadata got prevents overwriting the
+		// metadata changed concurrently.
+		op, err := r.client.computeClient.Projects.SetCommonInstanceMetadata(r.client.project, metadata).
+			Context(ctx).Do()
+		if err == nil {
+			err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refreshComputeProjectInfo gets project and updates the configured settings
+// in state.
+func (r *computeProjectInfoResource) refreshComputeProjectInfo(ctx context.Context,
+	s *computeProjectInfoState) error {
+	project, err := r.client.computeClient.Projects.Get(r.client.project).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	s.ID = types.StringValue(project.Name)
+	s.DefaultServiceAccount = types.StringValue(project.DefaultServiceAccount)
+	if !s.DefaultNetworkTier.IsNull() {
+		s.DefaultNetworkTier = types.StringValue(project.DefaultNetworkTier)
+	}
+	if !s.XpnHost.IsNull() {
+		s.XpnHost = types.BoolValue(project.XpnProjectStatus == xpnProjectStatusHost)
+	}
+	if !s.UsageExportBucket.IsNull() {
+		location := project.UsageExportLocation
+		if location == nil {
+			location = &googleComputeClient.UsageExportLocation{}
+		}
+		s.UsageExportBucket = types.StringValue(location.BucketName)
+		if !s.UsageExportPrefix.IsNull() || location.ReportNamePrefix != "" {
+			s.UsageExportPrefix = types.StringValue(location.ReportNamePrefix)
+		}
+	}
+	if !s.EnableOsLogin.IsNull() {
+		enableOsLogin := false
+		if project.CommonInstanceMetadata != nil {
+			for _, item := range project.CommonInstanceMetadata.Items {
+				if item.Key == osLoginMetadataKey && item.Value != nil {
+					enableOsLogin = strings.EqualFold(*item.Value, "true")
+				}
+			}
+		}
+		s.EnableOsLogin = types.BoolValue(enableOsLogin)
+	}
+	return nil
+}
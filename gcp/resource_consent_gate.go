@@ -0,0 +1,384 @@
+package gcp
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleSecretManagerClient "google.golang.org/api/secretmanager/v1"
+	googleStorageClient "google.golang.org/api/storage/v1"
+)
+
+const (
+	defaultConsentGateTimeoutSecs = 3600
+	maxConsentTokenSizeInBytes    = 1 << 20
+)
+
+// consentGateResource Present st-gcp_consent_gate resource
+type consentGateResource struct {
+	client *gcpClients
+}
+
+type consentGateState struct {
+	ClientConfig   *clientConfig `tfsdk:"client_config"`
+	ID             types.String  `tfsdk:"id"`
+	GcsObject      types.String  `tfsdk:"gcs_object"`
+	SecretVersion  types.String  `tfsdk:"secret_version"`
+	HTTPURL        types.String  `tfsdk:"http_url"`
+	ExpectedToken  types.String  `tfsdk:"expected_token"`
+	TimeoutSeconds types.Int64   `tfsdk:"timeout_seconds"`
+	Triggers       types.Map     `tfsdk:"triggers"`
+	ApprovedAt     types.Int64   `tfsdk:"approved_at"`
+}
+
+// NewConsentGateResource
+func NewConsentGateResource() resource.Resource {
+	return &consentGateResource{}
+}
+
+// Metadata
+func (r *consentGateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_consent_gate"
+}
+
+// Schema
+func (r *consentGateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Block the apply on create until an approval token appears at a GCS object, " +
+			"a Secret Manager secret version or a HTTP endpoint, so the destructive changes " +
+			"depending on the gate are applied only after a human approval. Exactly one of " +
+			"gcs_object, secret_version and http_url must be set. The GCS object of the " +
+			"approval token is deleted, or the secret version is disabled, once the " +
+			"approval is found, so a stale token never approves the next change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Location of the approval token.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gcs_object": schema.StringAttribute{
+				Description: "GCS object of the approval token in the format of {bucket}/{object}.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret_version": schema.StringAttribute{
+				Description: "Secret Manager secret version of the approval token in the format of " +
+					"projects/{project}/secrets/{secret}/versions/{version}, or the name of secret " +
+					"in the provider project to use the latest version.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"http_url": schema.StringAttribute{
+				Description: "HTTP endpoint responding the approval token with status 200. " +
+					"expected_token must be set, since the token cannot be consumed.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expected_token": schema.StringAttribute{
+				Description: "Approval token expected, the leading and trailing whitespaces of " +
+					"the content are ignored. Any content is accepted if not set.",
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Maximum seconds to wait for the approval. Default to " +
+					strconv.Itoa(defaultConsentGateTimeoutSecs) + ".",
+				Optional: true,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will require the " +
+					"approval again.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"approved_at": schema.Int64Attribute{
+				Description: "The unix timestamp when the approval is found.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *consentGateResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *consentGateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state consentGateState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	fetchToken, consumeToken, err := r.consentTokenFetcher(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid approval token location", err.Error())
+		return
+	}
+	if err := waitConsentToken(ctx, fetchToken, &state); err != nil {
+		resp.Diagnostics.AddError("waitConsentToken error", err.Error())
+		return
+	}
+	if err := consumeToken(); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to consume approval token", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *consentGateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The approval is only checked on create, revoking the token later does
+	// not affect the changes already applied.
+	var state consentGateState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *consentGateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	// Only timeout_seconds and client_config can be updated, which take
+	// effect on next approval.
+	var plan, state consentGateState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	state.TimeoutSeconds = plan.TimeoutSeconds
+	state.ClientConfig = plan.ClientConfig
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete
+func (r *consentGateResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The approval token is owned by the approver, hence it is not deleted.
+	r.client.checkReadOnly(&resp.Diagnostics, "delete")
+}
+
+// consentTokenFetcher returns the function fetching the approval token from
+// the configured location, the function returns an empty token if the token
+// does not exist yet. The second function consumes the token fetched, so the
+// token cannot approve the gate again when the triggers change.
+func (r *consentGateResource) consentTokenFetcher(ctx context.Context,
+	s *consentGateState) (func() (string, error), func() error, error) {
+	locations := 0
+	for _, location := range []types.String{s.GcsObject, s.SecretVersion, s.HTTPURL} {
+		if location.ValueString() != "" {
+			locations++
+		}
+	}
+	if locations != 1 {
+		return nil, nil, fmt.Errorf("exactly one of gcs_object, secret_version and http_url must be set")
+	}
+
+	switch {
+	case s.GcsObject.ValueString() != "":
+		bucket, object, ok := strings.Cut(s.GcsObject.ValueString(), "/")
+		if !ok || bucket == "" || object == "" {
+			return nil, nil, fmt.Errorf("gcs_object %q is not in the format of {bucket}/{object}",
+				s.GcsObject.ValueString())
+		}
+		storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.ID = types.StringValue("gs://" + s.GcsObject.ValueString())
+		// The generation fetched, so only the token approving the gate is
+		// consumed, not the one written again after it is fetched.
+		var generation int64
+		fetch := func() (string, error) {
+			metadata, err := storageClient.Objects.Get(bucket, object).Context(ctx).Do()
+			if isNotFoundError(err) {
+				return "", nil
+			}
+			if err != nil {
+				return "", err
+			}
+			generation = metadata.Generation
+			resp, err := storageClient.Objects.Get(bucket, object).Generation(generation).Context(ctx).Download()
+			if isNotFoundError(err) {
+				return "", nil
+			}
+			if err != nil {
+				return "", err
+			}
+			defer resp.Body.Close()
+			content, err := io.ReadAll(io.LimitReader(resp.Body, maxConsentTokenSizeInBytes))
+			return string(content), err
+		}
+		consume := func() error {
+			err := storageClient.Objects.Delete(bucket, object).IfGenerationMatch(generation).Context(ctx).Do()
+			if isNotFoundError(err) || isPreconditionFailedError(err) {
+				return nil
+			}
+			return err
+		}
+		return fetch, consume, nil
+	case s.SecretVersion.ValueString() != "":
+		name := s.SecretVersion.ValueString()
+		if !strings.HasPrefix(name, "projects/") {
+			name = fmt.Sprintf("projects/%s/secrets/%s/versions/latest", r.client.project, name)
+		}
+		secretManagerClient, err := googleSecretManagerClient.NewService(ctx, r.client.clientOptions...)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.ID = types.StringValue(name)
+		// The version accessed, which is resolved from the latest alias.
+		var versionName string
+		fetch := func() (string, error) {
+			version, err := secretManagerClient.Projects.Secrets.Versions.Access(name).Context(ctx).Do()
+			if isNotFoundError(err) {
+				return "", nil
+			}
+			if err != nil {
+				return "", err
+			}
+			versionName = version.Name
+			if version.Payload == nil {
+				return "", nil
+			}
+			content, err := base64.StdEncoding.DecodeString(version.Payload.Data)
+			return string(content), err
+		}
+		consume := func() error {
+			_, err := secretManagerClient.Projects.Secrets.Versions.Disable(versionName,
+				&googleSecretManagerClient.DisableSecretVersionRequest{}).Context(ctx).Do()
+			if isNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		return fetch, consume, nil
+	default:
+		if s.ExpectedToken.ValueString() == "" {
+			return nil, nil, fmt.Errorf("expected_token must be set with http_url")
+		}
+		s.ID = types.StringValue(s.HTTPURL.ValueString())
+		fetch := func() (string, error) {
+			result := probeHTTP(ctx, &httpProbeRequest{
+				url:     s.HTTPURL.ValueString(),
+				timeout: time.Duration(defaultProbeTimeoutSecs) * time.Second,
+			})
+			if result.err != nil {
+				return "", result.err
+			}
+			if result.statusCode != http.StatusOK {
+				return "", nil
+			}
+			return result.body, nil
+		}
+		// The token of the HTTP endpoint cannot be consumed, the expected
+		// token required above prevents a stale token from approving.
+		consume := func() error {
+			return nil
+		}
+		return fetch, consume, nil
+	}
+}
+
+// waitConsentToken Wait until the approval token is found. The errors of
+// fetching the token are retried until timeout, since the token location may
+// be created by the approver.
+func waitConsentToken(ctx context.Context, fetchToken func() (string, error), s *consentGateState) error {
+	timeout := time.Duration(defaultConsentGateTimeoutSecs) * time.Second
+	if !s.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(s.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Waiting for approval", map[string]interface{}{
+		"location": s.ID.ValueString(),
+	})
+	approveFunc := func() error {
+		token, err := fetchToken()
+		if err != nil {
+			return fmt.Errorf("failed to fetch approval token: %v", err)
+		}
+		token = strings.TrimSpace(token)
+		if token == "" {
+			return fmt.Errorf("approval token is not found at %s", s.ID.ValueString())
+		}
+		if !s.ExpectedToken.IsNull() && token != strings.TrimSpace(s.ExpectedToken.ValueString()) {
+			return fmt.Errorf("approval token at %s does not match the expected token", s.ID.ValueString())
+		}
+		return nil
+	}
+
+	retry := backoff.NewExponentialBackOff()
+	retry.MaxInterval = 30 * time.Second
+	retry.MaxElapsedTime = timeout
+	if err := backoff.Retry(approveFunc, backoff.WithContext(retry, ctx)); err != nil {
+		return fmt.Errorf("failed to get approval in %s: %v", timeout, err)
+	}
+	s.ApprovedAt = types.Int64Value(time.Now().Unix())
+	return nil
+}
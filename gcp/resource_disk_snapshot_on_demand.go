@@ -0,0 +1,380 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// diskSnapshotNameTimeFormat is appended to the name prefix of snapshots,
+// snapshot names only allow lowercase letters, numbers and dashes.
+const diskSnapshotNameTimeFormat = "20060102-150405"
+
+// diskSnapshotOnDemandResource Present st-gcp_disk_snapshot_on_demand resource
+type diskSnapshotOnDemandResource struct {
+	client *gcpClients
+}
+
+type diskSnapshotOnDemandState struct {
+	ClientConfig             *clientConfig `tfsdk:"client_config"`
+	ID                       types.String  `tfsdk:"id"`
+	Disk                     types.String  `tfsdk:"disk"`
+	Zone                     types.String  `tfsdk:"zone"`
+	Region                   types.String  `tfsdk:"region"`
+	NamePrefix               types.String  `tfsdk:"name_prefix"`
+	Labels                   types.Map     `tfsdk:"labels"`
+	RetentionDays            types.Int64   `tfsdk:"retention_days"`
+	DeleteSnapshotsOnDestroy types.Bool    `tfsdk:"delete_snapshots_on_destroy"`
+	Triggers                 types.Map     `tfsdk:"triggers"`
+	SnapshotName             types.String  `tfsdk:"snapshot_name"`
+	SnapshotSelfLink         types.String  `tfsdk:"snapshot_self_link"`
+	Snapshots                types.List    `tfsdk:"snapshots"`
+}
+
+// NewDiskSnapshotOnDemandResource
+func NewDiskSnapshotOnDemandResource() resource.Resource {
+	return &diskSnapshotOnDemandResource{}
+}
+
+// Metadata
+func (r *diskSnapshotOnDemandResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk_snapshot_on_demand"
+}
+
+// Schema
+func (r *diskSnapshotOnDemandResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create a snapshot of a disk on create and whenever triggers change, " +
+			"e.g. before a risky migration, for point-in-time backups as part of apply. The " +
+			"snapshots created are kept until retention_days has passed, they are deleted " +
+			"on the subsequent refreshes and applies except the latest snapshot.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of disk.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disk": schema.StringAttribute{
+				Description: "Name of the disk to snapshot.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of zonal disk. One of zone and region must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of regional disk. One of zone and region must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Prefix of the snapshot names, followed by the creation time " +
+					"in the format " + diskSnapshotNameTimeFormat + ". Default to the name of disk.",
+				Optional: true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of the snapshots.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"retention_days": schema.Int64Attribute{
+				Description: "Days to keep the snapshots created, the snapshots are kept " +
+					"forever if not set.",
+				Optional: true,
+			},
+			"delete_snapshots_on_destroy": schema.BoolAttribute{
+				Description: "Delete the snapshots created when resource is destroyed. " +
+					"Default to false.",
+				Optional: true,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will create a new snapshot.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"snapshot_name": schema.StringAttribute{
+				Description: "Name of the latest snapshot.",
+				Computed:    true,
+			},
+			"snapshot_self_link": schema.StringAttribute{
+				Description: "Self link of the latest snapshot.",
+				Computed:    true,
+			},
+			"snapshots": schema.ListAttribute{
+				Description: "Names of the snapshots created and not yet deleted, from the " +
+					"oldest to the latest.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *diskSnapshotOnDemandResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *diskSnapshotOnDemandResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state diskSnapshotOnDemandState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if (state.Zone.ValueString() == "") == (state.Region.ValueString() == "") {
+		resp.Diagnostics.AddError("Invalid location", "Exactly one of zone and region must be set.")
+		return
+	}
+
+	state.ID = types.StringValue(state.Disk.ValueString())
+	state.Snapshots = types.ListValueMust(types.StringType, []attr.Value{})
+	if err := r.createSnapshot(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create snapshot", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *diskSnapshotOnDemandResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state diskSnapshotOnDemandState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.cleanupSnapshots(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to clean up snapshots", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *diskSnapshotOnDemandResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state diskSnapshotOnDemandState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.SnapshotName = state.SnapshotName
+	plan.SnapshotSelfLink = state.SnapshotSelfLink
+	plan.Snapshots = state.Snapshots
+	// Only the changes of triggers create a new snapshot, the other arguments
+	// apply to the snapshots created afterwards.
+	if !plan.Triggers.Equal(state.Triggers) {
+		if err := r.createSnapshot(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to create snapshot", err.Error())
+			return
+		}
+	}
+	if err := r.cleanupSnapshots(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to clean up snapshots", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *diskSnapshotOnDemandResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state diskSnapshotOnDemandState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	// The snapshots are backups, only remove from state by default.
+	if !state.DeleteSnapshotsOnDestroy.ValueBool() {
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	for _, snapshot := range state.Snapshots.Elements() {
+		name := snapshot.(types.String).ValueString()
+		op, err := r.client.computeClient.Snapshots.Delete(r.client.project, name).Context(ctx).Do()
+		if err == nil {
+			err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+		}
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError("[API ERROR] Failed to delete snapshot", err.Error())
+			return
+		}
+	}
+}
+
+// createSnapshot Create a snapshot of disk and append it to the snapshots
+// in state.
+func (r *diskSnapshotOnDemandResource) createSnapshot(ctx context.Context, s *diskSnapshotOnDemandState) error {
+	prefix := s.Disk.ValueString()
+	if !s.NamePrefix.IsNull() {
+		prefix = s.NamePrefix.ValueString()
+	}
+	snapshot := &googleComputeClient.Snapshot{
+		Name:   prefix + "-" + time.Now().UTC().Format(diskSnapshotNameTimeFormat),
+		Labels: map[string]string{},
+	}
+	if !s.Labels.IsNull() {
+		if diags := s.Labels.ElementsAs(ctx, &snapshot.Labels, false); diags.HasError() {
+			return fmt.Errorf("failed to read labels")
+		}
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		snapshot.Labels[changeReferenceKey] = label
+	}
+
+	project := r.client.project
+	var op *googleComputeClient.Operation
+	var err error
+	if zone := s.Zone.ValueString(); zone != "" {
+		op, err = r.client.computeClient.Disks.CreateSnapshot(project, zone,
+			s.Disk.ValueString(), snapshot).Context(ctx).Do()
+	} else {
+		op, err = r.client.computeClient.RegionDisks.CreateSnapshot(project, s.Region.ValueString(),
+			s.Disk.ValueString(), snapshot).Context(ctx).Do()
+	}
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, project, op)
+	}
+	if err != nil {
+		return err
+	}
+
+	created, err := r.client.computeClient.Snapshots.Get(project, snapshot.Name).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	s.SnapshotName = types.StringValue(created.Name)
+	s.SnapshotSelfLink = types.StringValue(created.SelfLink)
+	snapshots := append(s.Snapshots.Elements(), types.StringValue(created.Name))
+	s.Snapshots = types.ListValueMust(types.StringType, snapshots)
+	return nil
+}
+
+// cleanupSnapshots Delete the snapshots in state older than retention days
+// except the latest one, and remove the snapshots deleted from state. The
+// snapshots are not deleted if the provider is read only.
+func (r *diskSnapshotOnDemandResource) cleanupSnapshots(ctx context.Context, s *diskSnapshotOnDemandState) error {
+	project := r.client.project
+	snapshots := []attr.Value{}
+	for _, value := range s.Snapshots.Elements() {
+		name := value.(types.String).ValueString()
+		snapshot, err := r.client.computeClient.Snapshots.Get(project, name).Context(ctx).Do()
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if !s.RetentionDays.IsNull() && !r.client.readOnly && name != s.SnapshotName.ValueString() {
+			createdAt, err := time.Parse(time.RFC3339, snapshot.CreationTimestamp)
+			if err == nil && time.Since(createdAt) > time.Duration(s.RetentionDays.ValueInt64())*24*time.Hour {
+				op, err := r.client.computeClient.Snapshots.Delete(project, name).Context(ctx).Do()
+				if err == nil {
+					err = waitComputeOperation(ctx, r.client.computeClient, project, op)
+				}
+				if err != nil && !isNotFoundError(err) {
+					return err
+				}
+				continue
+			}
+		}
+		snapshots = append(snapshots, value)
+	}
+	s.Snapshots = types.ListValueMust(types.StringType, snapshots)
+
+	// The latest snapshot deleted by others.
+	if !containsSnapshot(snapshots, s.SnapshotName.ValueString()) {
+		s.SnapshotName = types.StringNull()
+		s.SnapshotSelfLink = types.StringNull()
+	}
+	return nil
+}
+
+// containsSnapshot returns whether the snapshot names contain name.
+func containsSnapshot(snapshots []attr.Value, name string) bool {
+	for _, snapshot := range snapshots {
+		if snapshot.(types.String).ValueString() == name {
+			return true
+		}
+	}
+	return false
+}
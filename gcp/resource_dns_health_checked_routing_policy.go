@@ -0,0 +1,499 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+	googleDnsClient "google.golang.org/api/dns/v1"
+)
+
+const (
+	defaultHealthCheckedRecordType = "A"
+	defaultHealthCheckedRecordTTL  = 30
+)
+
+// dnsHealthCheckedRoutingPolicyResource Present st-gcp_dns_health_checked_routing_policy resource
+type dnsHealthCheckedRoutingPolicyResource struct {
+	client *gcpClients
+}
+
+type dnsHealthCheckedRoutingPolicyState struct {
+	ClientConfig           *clientConfig                   `tfsdk:"client_config"`
+	ID                     types.String                    `tfsdk:"id"`
+	ManagedZone            types.String                    `tfsdk:"managed_zone"`
+	Name                   types.String                    `tfsdk:"name"`
+	Type                   types.String                    `tfsdk:"type"`
+	TTL                    types.Int64                     `tfsdk:"ttl"`
+	PrimaryForwardingRules []types.String                  `tfsdk:"primary_forwarding_rules"`
+	BackupGeo              []*dnsHealthCheckedGeoItemState `tfsdk:"backup_geo"`
+	TrickleRatio           types.Float64                   `tfsdk:"trickle_ratio"`
+	Wrr                    []*dnsHealthCheckedWrrItemState `tfsdk:"wrr"`
+}
+
+type dnsHealthCheckedGeoItemState struct {
+	Location        types.String   `tfsdk:"location"`
+	ForwardingRules []types.String `tfsdk:"forwarding_rules"`
+	Rrdatas         []types.String `tfsdk:"rrdatas"`
+}
+
+type dnsHealthCheckedWrrItemState struct {
+	Weight          types.Float64  `tfsdk:"weight"`
+	ForwardingRules []types.String `tfsdk:"forwarding_rules"`
+}
+
+// NewDNSHealthCheckedRoutingPolicyResource
+func NewDNSHealthCheckedRoutingPolicyResource() resource.Resource {
+	return &dnsHealthCheckedRoutingPolicyResource{}
+}
+
+// Metadata
+func (r *dnsHealthCheckedRoutingPolicyResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_health_checked_routing_policy"
+}
+
+// Schema
+func (r *dnsHealthCheckedRoutingPolicyResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	forwardingRulesAttribute := schema.ListAttribute{
+		Description: "Self links of the forwarding rules of the internal load balancers " +
+			"to be health checked. The IP address, protocol, port, network and the load " +
+			"balancer type of the targets are resolved from the forwarding rules.",
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage a record set of a private Cloud DNS managed zone with a " +
+			"routing policy health checking the internal load balancers. Either " +
+			"primary_forwarding_rules with backup_geo for failover, or wrr for weighted " +
+			"round robin must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of record set in the format <managed_zone>/<name>/<type>.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"managed_zone": schema.StringAttribute{
+				Description: "Name of the private managed zone.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "DNS name of record set, e.g. api.internal.example.com.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of record set. Default to " + defaultHealthCheckedRecordType + ".",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Description: "TTL of record set in seconds. Default to " +
+					strconv.Itoa(defaultHealthCheckedRecordTTL) + ".",
+				Optional: true,
+			},
+			"primary_forwarding_rules": schema.ListAttribute{
+				Description: "Self links of the forwarding rules of the primary internal " +
+					"load balancers, traffic fails over to backup_geo if all of them are unhealthy.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"backup_geo": schema.ListNestedAttribute{
+				Description: "Backup targets by the location of the client, required with " +
+					"primary_forwarding_rules.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"location": schema.StringAttribute{
+							Description: "Region of the clients, e.g. asia-east1.",
+							Required:    true,
+						},
+						"forwarding_rules": forwardingRulesAttribute,
+						"rrdatas": schema.ListAttribute{
+							Description: "Records returned without health checking, e.g. the " +
+								"IP addresses outside Google Cloud.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"trickle_ratio": schema.Float64Attribute{
+				Description: "Ratio of traffic sent to backup_geo even if the primary " +
+					"targets are healthy. Default to 0.",
+				Optional: true,
+			},
+			"wrr": schema.ListNestedAttribute{
+				Description: "Weighted round robin targets, the unhealthy targets are " +
+					"excluded from the answers.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"weight": schema.Float64Attribute{
+							Description: "Weight of targets.",
+							Required:    true,
+						},
+						"forwarding_rules": forwardingRulesAttribute,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *dnsHealthCheckedRoutingPolicyResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *dnsHealthCheckedRoutingPolicyResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state dnsHealthCheckedRoutingPolicyState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	dnsClient, err := googleDnsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud DNS client", err.Error())
+		return
+	}
+
+	recordSet, err := r.newHealthCheckedRecordSet(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid routing policy", err.Error())
+		return
+	}
+	_, err = dnsClient.ResourceRecordSets.Create(r.client.project, state.ManagedZone.ValueString(),
+		recordSet).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create record set", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", state.ManagedZone.ValueString(),
+		recordSet.Name, recordSet.Type))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *dnsHealthCheckedRoutingPolicyResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsHealthCheckedRoutingPolicyState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	dnsClient, err := googleDnsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud DNS client", err.Error())
+		return
+	}
+
+	recordSet, err := dnsClient.ResourceRecordSets.Get(r.client.project, state.ManagedZone.ValueString(),
+		healthCheckedRecordName(&state), healthCheckedRecordType(&state)).Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get record set", err.Error())
+		return
+	}
+
+	if !state.TTL.IsNull() || recordSet.Ttl != defaultHealthCheckedRecordTTL {
+		state.TTL = types.Int64Value(recordSet.Ttl)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *dnsHealthCheckedRoutingPolicyResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state dnsHealthCheckedRoutingPolicyState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	dnsClient, err := googleDnsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud DNS client", err.Error())
+		return
+	}
+
+	// The routing policy is replaced as a whole, switching between failover
+	// and weighted round robin is allowed.
+	recordSet, err := r.newHealthCheckedRecordSet(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid routing policy", err.Error())
+		return
+	}
+	if _, err := dnsClient.ResourceRecordSets.Patch(r.client.project, plan.ManagedZone.ValueString(),
+		recordSet.Name, recordSet.Type, recordSet).Context(ctx).Do(); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update record set", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *dnsHealthCheckedRoutingPolicyResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state dnsHealthCheckedRoutingPolicyState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	dnsClient, err := googleDnsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud DNS client", err.Error())
+		return
+	}
+
+	_, err = dnsClient.ResourceRecordSets.Delete(r.client.project, state.ManagedZone.ValueString(),
+		healthCheckedRecordName(&state), healthCheckedRecordType(&state)).Context(ctx).Do()
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete record set", err.Error())
+	}
+}
+
+// newHealthCheckedRecordSet converts the state into the record set with the
+// health checked routing policy.
+func (r *dnsHealthCheckedRoutingPolicyResource) newHealthCheckedRecordSet(ctx context.Context,
+	s *dnsHealthCheckedRoutingPolicyState) (*googleDnsClient.ResourceRecordSet, error) {
+	ttl := int64(defaultHealthCheckedRecordTTL)
+	if !s.TTL.IsNull() {
+		ttl = s.TTL.ValueInt64()
+	}
+	recordSet := &googleDnsClient.ResourceRecordSet{
+		Name:          healthCheckedRecordName(s),
+		Type:          healthCheckedRecordType(s),
+		Ttl:           ttl,
+		RoutingPolicy: &googleDnsClient.RRSetRoutingPolicy{},
+	}
+
+	switch {
+	case len(s.PrimaryForwardingRules) > 0 && len(s.Wrr) > 0:
+		return nil, fmt.Errorf("only one of primary_forwarding_rules and wrr can be set")
+	case len(s.PrimaryForwardingRules) > 0:
+		if len(s.BackupGeo) == 0 {
+			return nil, fmt.Errorf("backup_geo is required with primary_forwarding_rules")
+		}
+		primaryTargets, err := r.newHealthCheckTargets(ctx, s.PrimaryForwardingRules)
+		if err != nil {
+			return nil, err
+		}
+		backupGeo := &googleDnsClient.RRSetRoutingPolicyGeoPolicy{}
+		for _, item := range s.BackupGeo {
+			geoItem := &googleDnsClient.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{
+				Location: item.Location.ValueString(),
+			}
+			for _, rrdata := range item.Rrdatas {
+				geoItem.Rrdatas = append(geoItem.Rrdatas, rrdata.ValueString())
+			}
+			if len(item.ForwardingRules) > 0 {
+				if geoItem.HealthCheckedTargets, err = r.newHealthCheckTargets(ctx, item.ForwardingRules); err != nil {
+					return nil, err
+				}
+			}
+			backupGeo.Items = append(backupGeo.Items, geoItem)
+		}
+		recordSet.RoutingPolicy.PrimaryBackup = &googleDnsClient.RRSetRoutingPolicyPrimaryBackupPolicy{
+			PrimaryTargets:   primaryTargets,
+			BackupGeoTargets: backupGeo,
+			TrickleTraffic:   s.TrickleRatio.ValueFloat64(),
+		}
+	case len(s.Wrr) > 0:
+		wrr := &googleDnsClient.RRSetRoutingPolicyWrrPolicy{}
+		for _, item := range s.Wrr {
+			targets, err := r.newHealthCheckTargets(ctx, item.ForwardingRules)
+			if err != nil {
+				return nil, err
+			}
+			wrr.Items = append(wrr.Items, &googleDnsClient.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{
+				Weight:               item.Weight.ValueFloat64(),
+				HealthCheckedTargets: targets,
+				ForceSendFields:      []string{"Weight"},
+			})
+		}
+		recordSet.RoutingPolicy.Wrr = wrr
+	default:
+		return nil, fmt.Errorf("one of primary_forwarding_rules and wrr must be set")
+	}
+	return recordSet, nil
+}
+
+// newHealthCheckTargets resolves the forwarding rules into the internal load
+// balancer targets to be health checked.
+func (r *dnsHealthCheckedRoutingPolicyResource) newHealthCheckTargets(ctx context.Context,
+	forwardingRules []types.String) (*googleDnsClient.RRSetRoutingPolicyHealthCheckTargets, error) {
+	targets := &googleDnsClient.RRSetRoutingPolicyHealthCheckTargets{}
+	for _, forwardingRule := range forwardingRules {
+		project, region, name := parseForwardingRuleSelfLink(forwardingRule.ValueString())
+		if project == "" {
+			return nil, fmt.Errorf("invalid forwarding rule self link %q", forwardingRule.ValueString())
+		}
+
+		var rule *googleComputeClient.ForwardingRule
+		var err error
+		if region == globalScope {
+			rule, err = r.client.computeClient.GlobalForwardingRules.Get(project, name).Context(ctx).Do()
+		} else {
+			rule, err = r.client.computeClient.ForwardingRules.Get(project, region, name).Context(ctx).Do()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get forwarding rule %s: %v", name, err)
+		}
+
+		target, err := newLoadBalancerTarget(project, rule)
+		if err != nil {
+			return nil, err
+		}
+		targets.InternalLoadBalancers = append(targets.InternalLoadBalancers, target)
+	}
+	return targets, nil
+}
+
+// newLoadBalancerTarget converts the forwarding rule of the internal load
+// balancer into the health checked target.
+func newLoadBalancerTarget(project string,
+	rule *googleComputeClient.ForwardingRule) (*googleDnsClient.RRSetRoutingPolicyLoadBalancerTarget, error) {
+	var loadBalancerType string
+	switch {
+	case rule.LoadBalancingScheme == "INTERNAL":
+		loadBalancerType = "regionalL4ilb"
+	case rule.LoadBalancingScheme == "INTERNAL_MANAGED" && rule.Region != "":
+		loadBalancerType = "regionalL7ilb"
+	case rule.LoadBalancingScheme == "INTERNAL_MANAGED":
+		loadBalancerType = "globalL7ilb"
+	default:
+		return nil, fmt.Errorf("forwarding rule %s is not of an internal load balancer, got scheme %s",
+			rule.Name, rule.LoadBalancingScheme)
+	}
+
+	// Only the first port is health checked if the rule has multiple ports.
+	port := strings.SplitN(rule.PortRange, "-", 2)[0]
+	if len(rule.Ports) > 0 {
+		port = rule.Ports[0]
+	}
+	if port == "" {
+		return nil, fmt.Errorf("forwarding rule %s has no port to be health checked", rule.Name)
+	}
+
+	return &googleDnsClient.RRSetRoutingPolicyLoadBalancerTarget{
+		IpAddress:        rule.IPAddress,
+		IpProtocol:       strings.ToLower(rule.IPProtocol),
+		LoadBalancerType: loadBalancerType,
+		NetworkUrl:       rule.Network,
+		Port:             port,
+		Project:          project,
+		Region:           resourceNameFromSelfLink(rule.Region),
+	}, nil
+}
+
+// parseForwardingRuleSelfLink returns the project, the region and the name of
+// the forwarding rule self link. The region is global for the global
+// forwarding rules.
+func parseForwardingRuleSelfLink(selfLink string) (project, region, name string) {
+	i := strings.Index(selfLink, "projects/")
+	if i < 0 {
+		return "", "", ""
+	}
+	parts := strings.Split(selfLink[i:], "/")
+	switch {
+	case len(parts) == 6 && parts[2] == "regions" && parts[4] == "forwardingRules":
+		return parts[1], parts[3], parts[5]
+	case len(parts) == 5 && parts[2] == globalScope && parts[3] == "forwardingRules":
+		return parts[1], globalScope, parts[4]
+	}
+	return "", "", ""
+}
+
+// healthCheckedRecordName returns the fully qualified DNS name of the record set.
+func healthCheckedRecordName(s *dnsHealthCheckedRoutingPolicyState) string {
+	return strings.TrimSuffix(s.Name.ValueString(), ".") + "."
+}
+
+// healthCheckedRecordType returns the type of the record set.
+func healthCheckedRecordType(s *dnsHealthCheckedRoutingPolicyState) string {
+	if s.Type.ValueString() != "" {
+		return s.Type.ValueString()
+	}
+	return defaultHealthCheckedRecordType
+}
@@ -0,0 +1,397 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleDnsClient "google.golang.org/api/dns/v1"
+)
+
+const (
+	defaultBatchRecordTTL = 300
+	dnsChangeStatusDone   = "done"
+)
+
+// dnsRecordSetsBatchResource Present st-gcp_dns_record_sets_batch resource
+type dnsRecordSetsBatchResource struct {
+	client *gcpClients
+}
+
+type dnsRecordSetsBatchState struct {
+	ClientConfig *clientConfig             `tfsdk:"client_config"`
+	ID           types.String              `tfsdk:"id"`
+	ManagedZone  types.String              `tfsdk:"managed_zone"`
+	RecordSets   []*dnsBatchRecordSetState `tfsdk:"record_sets"`
+	ChangeID     types.String              `tfsdk:"change_id"`
+}
+
+type dnsBatchRecordSetState struct {
+	Name    types.String   `tfsdk:"name"`
+	Type    types.String   `tfsdk:"type"`
+	TTL     types.Int64    `tfsdk:"ttl"`
+	Rrdatas []types.String `tfsdk:"rrdatas"`
+}
+
+// NewDNSRecordSetsBatchResource
+func NewDNSRecordSetsBatchResource() resource.Resource {
+	return &dnsRecordSetsBatchResource{}
+}
+
+// Metadata
+func (r *dnsRecordSetsBatchResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record_sets_batch"
+}
+
+// Schema
+func (r *dnsRecordSetsBatchResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage many record sets of a Cloud DNS managed zone atomically. All " +
+			"the added, changed and removed record sets are applied in a single change of " +
+			"managed zone, so either all of them or none of them are applied.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of managed zone.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"managed_zone": schema.StringAttribute{
+				Description: "Name of the managed zone of the record sets.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"record_sets": schema.ListNestedAttribute{
+				Description: "Record sets of managed zone, each name and type must be unique.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "DNS name of record set, e.g. www.example.com.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of record set, e.g. A, CNAME or TXT.",
+							Required:    true,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "TTL of record set in seconds. Default to " +
+								strconv.Itoa(defaultBatchRecordTTL) + ".",
+							Optional: true,
+						},
+						"rrdatas": schema.ListAttribute{
+							Description: "Resource records of record set.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"change_id": schema.StringAttribute{
+				Description: "ID of the last change applied to managed zone.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *dnsRecordSetsBatchResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *dnsRecordSetsBatchResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state dnsRecordSetsBatchState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	dnsClient, err := googleDnsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud DNS client", err.Error())
+		return
+	}
+
+	state.ChangeID = types.StringNull()
+	if err := r.applyChange(ctx, dnsClient, &state, nil); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create record sets", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(state.ManagedZone.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *dnsRecordSetsBatchResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsRecordSetsBatchState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	dnsClient, err := googleDnsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud DNS client", err.Error())
+		return
+	}
+
+	existing, err := r.listRecordSets(ctx, dnsClient, &state)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to list record sets", err.Error())
+		return
+	}
+
+	// The record sets deleted by others are removed from state to be created again.
+	recordSets := []*dnsBatchRecordSetState{}
+	for _, recordSet := range state.RecordSets {
+		current, ok := existing[batchRecordSetKey(recordSet.Name.ValueString(), recordSet.Type.ValueString())]
+		if !ok {
+			continue
+		}
+		if !recordSet.TTL.IsNull() || current.Ttl != defaultBatchRecordTTL {
+			recordSet.TTL = types.Int64Value(current.Ttl)
+		}
+		recordSet.Rrdatas = []types.String{}
+		for _, rrdata := range current.Rrdatas {
+			recordSet.Rrdatas = append(recordSet.Rrdatas, types.StringValue(rrdata))
+		}
+		recordSets = append(recordSets, recordSet)
+	}
+	state.RecordSets = recordSets
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *dnsRecordSetsBatchResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state dnsRecordSetsBatchState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	dnsClient, err := googleDnsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud DNS client", err.Error())
+		return
+	}
+
+	plan.ChangeID = state.ChangeID
+	if err := r.applyChange(ctx, dnsClient, &plan, state.RecordSets); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update record sets", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *dnsRecordSetsBatchResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state dnsRecordSetsBatchState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	dnsClient, err := googleDnsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud DNS client", err.Error())
+		return
+	}
+
+	prior := state.RecordSets
+	state.RecordSets = nil
+	if err := r.applyChange(ctx, dnsClient, &state, prior); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete record sets", err.Error())
+	}
+}
+
+// applyChange Apply the record sets changed from prior to the record sets in
+// state in a single change of managed zone, and wait until it is done. The
+// deleted record sets are read from managed zone as they must match exactly.
+func (r *dnsRecordSetsBatchResource) applyChange(ctx context.Context, client *googleDnsClient.Service,
+	s *dnsRecordSetsBatchState, prior []*dnsBatchRecordSetState) error {
+	desired := map[string]*googleDnsClient.ResourceRecordSet{}
+	for _, recordSet := range s.RecordSets {
+		rrset := newBatchRecordSet(recordSet)
+		key := batchRecordSetKey(rrset.Name, rrset.Type)
+		if _, ok := desired[key]; ok {
+			return fmt.Errorf("record set %s is duplicated", key)
+		}
+		desired[key] = rrset
+	}
+
+	existing, err := r.listRecordSets(ctx, client, s)
+	if err != nil {
+		return err
+	}
+
+	change := &googleDnsClient.Change{}
+	for _, recordSet := range prior {
+		key := batchRecordSetKey(recordSet.Name.ValueString(), recordSet.Type.ValueString())
+		current, ok := existing[key]
+		if !ok {
+			continue
+		}
+		if rrset, ok := desired[key]; ok && batchRecordSetEqual(current, rrset) {
+			delete(desired, key)
+			continue
+		}
+		change.Deletions = append(change.Deletions, current)
+	}
+	for _, key := range sortedKeys(desired) {
+		change.Additions = append(change.Additions, desired[key])
+	}
+	if len(change.Additions) == 0 && len(change.Deletions) == 0 {
+		return nil
+	}
+
+	change, err = client.Changes.Create(r.client.project, s.ManagedZone.ValueString(), change).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	s.ChangeID = types.StringValue(change.Id)
+
+	waitFunc := func() error {
+		if change.Status == dnsChangeStatusDone {
+			return nil
+		}
+		change, err = client.Changes.Get(r.client.project, s.ManagedZone.ValueString(), change.Id).
+			Context(ctx).Do()
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		if change.Status != dnsChangeStatusDone {
+			return fmt.Errorf("change %s is %s", change.Id, change.Status)
+		}
+		return nil
+	}
+	return backoff.Retry(waitFunc, backoff.WithContext(backoff.NewExponentialBackOff(), ctx))
+}
+
+// listRecordSets returns the record sets of managed zone by name and type.
+func (r *dnsRecordSetsBatchResource) listRecordSets(ctx context.Context, client *googleDnsClient.Service,
+	s *dnsRecordSetsBatchState) (map[string]*googleDnsClient.ResourceRecordSet, error) {
+	recordSets := map[string]*googleDnsClient.ResourceRecordSet{}
+	err := client.ResourceRecordSets.List(r.client.project, s.ManagedZone.ValueString()).Pages(ctx,
+		func(page *googleDnsClient.ResourceRecordSetsListResponse) error {
+			for _, rrset := range page.Rrsets {
+				recordSets[batchRecordSetKey(rrset.Name, rrset.Type)] = rrset
+			}
+			return nil
+		})
+	return recordSets, err
+}
+
+// newBatchRecordSet converts the record set state into the record set.
+func newBatchRecordSet(s *dnsBatchRecordSetState) *googleDnsClient.ResourceRecordSet {
+	ttl := int64(defaultBatchRecordTTL)
+	if !s.TTL.IsNull() {
+		ttl = s.TTL.ValueInt64()
+	}
+	rrdatas := []string{}
+	for _, rrdata := range s.Rrdatas {
+		rrdatas = append(rrdatas, rrdata.ValueString())
+	}
+	return &googleDnsClient.ResourceRecordSet{
+		Name:    strings.TrimSuffix(s.Name.ValueString(), ".") + ".",
+		Type:    s.Type.ValueString(),
+		Ttl:     ttl,
+		Rrdatas: rrdatas,
+	}
+}
+
+// batchRecordSetKey returns the key of the record set in the format
+// <name>/<type>, the name is always fully qualified.
+func batchRecordSetKey(name, recordType string) string {
+	return strings.TrimSuffix(name, ".") + "./" + recordType
+}
+
+// batchRecordSetEqual returns true if the existing record set is the same as
+// the desired one, so it is not deleted and added again.
+func batchRecordSetEqual(existing, desired *googleDnsClient.ResourceRecordSet) bool {
+	if existing.Ttl != desired.Ttl || len(existing.Rrdatas) != len(desired.Rrdatas) ||
+		existing.RoutingPolicy != nil {
+		return false
+	}
+	for i := range existing.Rrdatas {
+		if existing.Rrdatas[i] != desired.Rrdatas[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,579 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const (
+	defaultFirewallBatchConcurrency = 10
+	defaultFirewallDirection        = "INGRESS"
+	defaultFirewallPriority         = 1000
+	firewallActionAllow             = "allow"
+	firewallActionDeny              = "deny"
+)
+
+// firewallRulesBatchResource Present st-gcp_firewall_rules_batch resource
+type firewallRulesBatchResource struct {
+	client *gcpClients
+}
+
+type firewallRulesBatchState struct {
+	ClientConfig *clientConfig                 `tfsdk:"client_config"`
+	ID           types.String                  `tfsdk:"id"`
+	Network      types.String                  `tfsdk:"network"`
+	NamePrefix   types.String                  `tfsdk:"name_prefix"`
+	Concurrency  types.Int64                   `tfsdk:"concurrency"`
+	Rules        map[string]*firewallRuleModel `tfsdk:"rules"`
+	SelfLinks    types.Map                     `tfsdk:"self_links"`
+}
+
+type firewallRuleModel struct {
+	Description           types.String             `tfsdk:"description"`
+	Direction             types.String             `tfsdk:"direction"`
+	Priority              types.Int64              `tfsdk:"priority"`
+	Action                types.String             `tfsdk:"action"`
+	Protocols             []*firewallProtocolModel `tfsdk:"protocols"`
+	SourceRanges          types.List               `tfsdk:"source_ranges"`
+	DestinationRanges     types.List               `tfsdk:"destination_ranges"`
+	SourceTags            types.List               `tfsdk:"source_tags"`
+	TargetTags            types.List               `tfsdk:"target_tags"`
+	TargetServiceAccounts types.List               `tfsdk:"target_service_accounts"`
+	Disabled              types.Bool               `tfsdk:"disabled"`
+	EnableLogging         types.Bool               `tfsdk:"enable_logging"`
+}
+
+type firewallProtocolModel struct {
+	Protocol types.String `tfsdk:"protocol"`
+	Ports    types.List   `tfsdk:"ports"`
+}
+
+// NewFirewallRulesBatchResource
+func NewFirewallRulesBatchResource() resource.Resource {
+	return &firewallRulesBatchResource{}
+}
+
+// Metadata
+func (r *firewallRulesBatchResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_rules_batch"
+}
+
+// Schema
+func (r *firewallRulesBatchResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	listAttribute := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Description: description,
+			ElementType: types.StringType,
+			Optional:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage a batch of VPC firewall rules of a network from a single map, " +
+			"each rule is named <name_prefix>-<key>. Only the added, changed and removed " +
+			"rules are applied with bounded concurrency, so hundreds of firewall rules are " +
+			"planned and applied as one resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name prefix of the firewall rules.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network": schema.StringAttribute{
+				Description: "Self link of the network of the firewall rules.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Name prefix of the firewall rules.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				Description: "Maximum number of the firewall rules applied concurrently. " +
+					fmt.Sprintf("Default to %d.", defaultFirewallBatchConcurrency),
+				Optional: true,
+			},
+			"rules": schema.MapNestedAttribute{
+				Description: "Map of the keys to the firewall rules.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Description: "Description of firewall rule.",
+							Optional:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description: "Direction of firewall rule, INGRESS or EGRESS. " +
+								"Default to " + defaultFirewallDirection + ".",
+							Optional: true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "Priority of firewall rule. " +
+								fmt.Sprintf("Default to %d.", defaultFirewallPriority),
+							Optional: true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action of firewall rule, " + firewallActionAllow +
+								" or " + firewallActionDeny + ". Default to " + firewallActionAllow + ".",
+							Optional: true,
+						},
+						"protocols": schema.ListNestedAttribute{
+							Description: "Protocols and ports allowed or denied by firewall rule.",
+							Required:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"protocol": schema.StringAttribute{
+										Description: "IP protocol, e.g. tcp, udp, icmp or all.",
+										Required:    true,
+									},
+									"ports": schema.ListAttribute{
+										Description: "Ports or port ranges, e.g. 443 or 8000-8080.",
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"source_ranges":           listAttribute("Source IP CIDR ranges of the ingress firewall rule."),
+						"destination_ranges":      listAttribute("Destination IP CIDR ranges of the egress firewall rule."),
+						"source_tags":             listAttribute("Network tags of the source instances of the ingress firewall rule."),
+						"target_tags":             listAttribute("Network tags of the instances firewall rule applies to."),
+						"target_service_accounts": listAttribute("Service accounts of the instances firewall rule applies to."),
+						"disabled": schema.BoolAttribute{
+							Description: "Whether firewall rule is disabled.",
+							Optional:    true,
+						},
+						"enable_logging": schema.BoolAttribute{
+							Description: "Whether the connections matched by firewall rule are logged.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"self_links": schema.MapAttribute{
+				Description: "Map of the keys to the self links of the firewall rules.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *firewallRulesBatchResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *firewallRulesBatchResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state firewallRulesBatchState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	state.ID = types.StringValue(state.NamePrefix.ValueString())
+	r.applyRules(ctx, &state, map[string]*firewallRuleModel{}, &resp.Diagnostics)
+	if err := r.refreshRules(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to list firewall rules", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *firewallRulesBatchResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state firewallRulesBatchState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.refreshRules(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to list firewall rules", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *firewallRulesBatchResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state firewallRulesBatchState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	plan.ID = state.ID
+	r.applyRules(ctx, &plan, state.Rules, &resp.Diagnostics)
+	if err := r.refreshRules(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to list firewall rules", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *firewallRulesBatchResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state firewallRulesBatchState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	prior := state.Rules
+	state.Rules = map[string]*firewallRuleModel{}
+	r.applyRules(ctx, &state, prior, &resp.Diagnostics)
+}
+
+// applyRules Create, update and delete the firewall rules changed from prior
+// to the rules in state concurrently. The rules in state are replaced by the
+// applied rules, so the failed changes are retried in the next apply.
+func (r *firewallRulesBatchResource) applyRules(ctx context.Context, s *firewallRulesBatchState,
+	prior map[string]*firewallRuleModel, diags *diag.Diagnostics) {
+	project := r.client.project
+	firewalls := r.client.computeClient.Firewalls
+
+	applied := map[string]*firewallRuleModel{}
+	for key, rule := range prior {
+		applied[key] = rule
+	}
+
+	type change struct {
+		key   string
+		rule  *firewallRuleModel
+		apply func() (*googleComputeClient.Operation, error)
+	}
+	changes := []*change{}
+	for _, key := range sortedKeys(prior) {
+		if _, ok := s.Rules[key]; !ok {
+			name := firewallRuleName(s, key)
+			changes = append(changes, &change{key: key, apply: func() (*googleComputeClient.Operation, error) {
+				return firewalls.Delete(project, name).Context(ctx).Do()
+			}})
+		}
+	}
+	for _, key := range sortedKeys(s.Rules) {
+		rule := s.Rules[key]
+		firewall, d := newFirewall(ctx, s, key, rule)
+		diags.Append(d...)
+		if d.HasError() {
+			continue
+		}
+		priorRule, ok := prior[key]
+		switch {
+		case !ok:
+			changes = append(changes, &change{key: key, rule: rule, apply: func() (*googleComputeClient.Operation, error) {
+				return firewalls.Insert(project, firewall).Context(ctx).Do()
+			}})
+		case firewallRuleChanged(ctx, s, key, priorRule, firewall):
+			changes = append(changes, &change{key: key, rule: rule, apply: func() (*googleComputeClient.Operation, error) {
+				return firewalls.Update(project, firewall.Name, firewall).Context(ctx).Do()
+			}})
+		}
+	}
+
+	concurrency := int64(defaultFirewallBatchConcurrency)
+	if s.Concurrency.ValueInt64() > 0 {
+		concurrency = s.Concurrency.ValueInt64()
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	failures := []string{}
+	for _, c := range changes {
+		c := c
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			op, err := c.apply()
+			if err == nil {
+				err = waitComputeOperation(ctx, r.client.computeClient, project, op)
+			}
+			// The deleted firewall rule is removed by others.
+			if err != nil && !(c.rule == nil && isNotFoundError(err)) {
+				mu.Lock()
+				failures = append(failures, fmt.Sprintf("%s: %v", firewallRuleName(s, c.key), err))
+				mu.Unlock()
+				return
+			}
+			mu.Lock()
+			if c.rule == nil {
+				delete(applied, c.key)
+			} else {
+				applied[c.key] = c.rule
+			}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	s.Rules = applied
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		diags.AddError("[API ERROR] Failed to apply firewall rules", strings.Join(failures, "\n"))
+	}
+}
+
+// refreshRules lists the firewall rules of name prefix and updates the rules
+// in state, the rules deleted by others are removed from state.
+func (r *firewallRulesBatchResource) refreshRules(ctx context.Context, s *firewallRulesBatchState) error {
+	prefix := s.NamePrefix.ValueString() + "-"
+	existing := map[string]*googleComputeClient.Firewall{}
+	err := r.client.computeClient.Firewalls.List(r.client.project).Pages(ctx,
+		func(page *googleComputeClient.FirewallList) error {
+			for _, firewall := range page.Items {
+				if strings.HasPrefix(firewall.Name, prefix) {
+					existing[firewall.Name] = firewall
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	selfLinks := map[string]attr.Value{}
+	for key, rule := range s.Rules {
+		firewall, ok := existing[firewallRuleName(s, key)]
+		if !ok {
+			delete(s.Rules, key)
+			continue
+		}
+		refreshFirewallRule(rule, firewall)
+		selfLinks[key] = types.StringValue(firewall.SelfLink)
+	}
+	s.SelfLinks = types.MapValueMust(types.StringType, selfLinks)
+	return nil
+}
+
+// firewallRuleName returns the name of the firewall rule of key.
+func firewallRuleName(s *firewallRulesBatchState, key string) string {
+	return s.NamePrefix.ValueString() + "-" + key
+}
+
+// firewallRuleChanged returns true if the prior rule of key is not converted
+// into the same firewall.
+func firewallRuleChanged(ctx context.Context, s *firewallRulesBatchState, key string,
+	prior *firewallRuleModel, firewall *googleComputeClient.Firewall) bool {
+	priorFirewall, diags := newFirewall(ctx, s, key, prior)
+	if diags.HasError() {
+		return true
+	}
+	priorJSON, _ := json.Marshal(priorFirewall)
+	firewallJSON, _ := json.Marshal(firewall)
+	return string(priorJSON) != string(firewallJSON)
+}
+
+// newFirewall converts the rule of key into the firewall.
+func newFirewall(ctx context.Context, s *firewallRulesBatchState, key string,
+	rule *firewallRuleModel) (*googleComputeClient.Firewall, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elements := func(list types.List) []string {
+		values := []string{}
+		diags.Append(list.ElementsAs(ctx, &values, false)...)
+		return values
+	}
+
+	direction := defaultFirewallDirection
+	if rule.Direction.ValueString() != "" {
+		direction = rule.Direction.ValueString()
+	}
+	priority := int64(defaultFirewallPriority)
+	if !(rule.Priority.IsUnknown() || rule.Priority.IsNull()) {
+		priority = rule.Priority.ValueInt64()
+	}
+
+	firewall := &googleComputeClient.Firewall{
+		Name:                  firewallRuleName(s, key),
+		Network:               s.Network.ValueString(),
+		Description:           rule.Description.ValueString(),
+		Direction:             direction,
+		Priority:              priority,
+		SourceRanges:          elements(rule.SourceRanges),
+		DestinationRanges:     elements(rule.DestinationRanges),
+		SourceTags:            elements(rule.SourceTags),
+		TargetTags:            elements(rule.TargetTags),
+		TargetServiceAccounts: elements(rule.TargetServiceAccounts),
+		Disabled:              rule.Disabled.ValueBool(),
+		LogConfig: &googleComputeClient.FirewallLogConfig{
+			Enable:          rule.EnableLogging.ValueBool(),
+			ForceSendFields: []string{"Enable"},
+		},
+		// Priority 0 and the re-enabled firewall rule must be sent explicitly.
+		ForceSendFields: []string{"Priority", "Disabled"},
+	}
+
+	switch action := rule.Action.ValueString(); action {
+	case "", firewallActionAllow:
+		for _, protocol := range rule.Protocols {
+			firewall.Allowed = append(firewall.Allowed, &googleComputeClient.FirewallAllowed{
+				IPProtocol: protocol.Protocol.ValueString(),
+				Ports:      elements(protocol.Ports),
+			})
+		}
+	case firewallActionDeny:
+		for _, protocol := range rule.Protocols {
+			firewall.Denied = append(firewall.Denied, &googleComputeClient.FirewallDenied{
+				IPProtocol: protocol.Protocol.ValueString(),
+				Ports:      elements(protocol.Ports),
+			})
+		}
+	default:
+		diags.AddError("Invalid action",
+			fmt.Sprintf("Action %q of firewall rule %s must be %s or %s.",
+				action, key, firewallActionAllow, firewallActionDeny))
+	}
+	return firewall, diags
+}
+
+// refreshFirewallRule updates the rule with the firewall, the optional
+// attributes not set in rule are kept null.
+func refreshFirewallRule(rule *firewallRuleModel, firewall *googleComputeClient.Firewall) {
+	listValue := func(list types.List, values []string) types.List {
+		if list.IsNull() && len(values) == 0 {
+			return list
+		}
+		elements := []attr.Value{}
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+
+	if !rule.Description.IsNull() || firewall.Description != "" {
+		rule.Description = types.StringValue(firewall.Description)
+	}
+	if !rule.Direction.IsNull() || firewall.Direction != defaultFirewallDirection {
+		rule.Direction = types.StringValue(firewall.Direction)
+	}
+	if !rule.Priority.IsNull() || firewall.Priority != defaultFirewallPriority {
+		rule.Priority = types.Int64Value(firewall.Priority)
+	}
+	if !rule.Disabled.IsNull() || firewall.Disabled {
+		rule.Disabled = types.BoolValue(firewall.Disabled)
+	}
+	logging := firewall.LogConfig != nil && firewall.LogConfig.Enable
+	if !rule.EnableLogging.IsNull() || logging {
+		rule.EnableLogging = types.BoolValue(logging)
+	}
+	rule.SourceRanges = listValue(rule.SourceRanges, firewall.SourceRanges)
+	rule.DestinationRanges = listValue(rule.DestinationRanges, firewall.DestinationRanges)
+	rule.SourceTags = listValue(rule.SourceTags, firewall.SourceTags)
+	rule.TargetTags = listValue(rule.TargetTags, firewall.TargetTags)
+	rule.TargetServiceAccounts = listValue(rule.TargetServiceAccounts, firewall.TargetServiceAccounts)
+
+	protocols := []*firewallProtocolModel{}
+	ports := map[int]types.List{}
+	for i, protocol := range rule.Protocols {
+		ports[i] = protocol.Ports
+	}
+	if len(firewall.Denied) > 0 {
+		rule.Action = types.StringValue(firewallActionDeny)
+		for i, denied := range firewall.Denied {
+			protocols = append(protocols, &firewallProtocolModel{
+				Protocol: types.StringValue(denied.IPProtocol),
+				Ports:    listValue(portsOrNull(ports, i), denied.Ports),
+			})
+		}
+	} else {
+		if !rule.Action.IsNull() {
+			rule.Action = types.StringValue(firewallActionAllow)
+		}
+		for i, allowed := range firewall.Allowed {
+			protocols = append(protocols, &firewallProtocolModel{
+				Protocol: types.StringValue(allowed.IPProtocol),
+				Ports:    listValue(portsOrNull(ports, i), allowed.Ports),
+			})
+		}
+	}
+	rule.Protocols = protocols
+}
+
+// portsOrNull returns the ports of the protocol at index i in state, null if
+// the protocol is not in state.
+func portsOrNull(ports map[int]types.List, i int) types.List {
+	if p, ok := ports[i]; ok {
+		return p
+	}
+	return types.ListNull(types.StringType)
+}
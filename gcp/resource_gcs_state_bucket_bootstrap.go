@@ -0,0 +1,492 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleStorageClient "google.golang.org/api/storage/v1"
+)
+
+const (
+	stateBucketCIRole                       = "roles/storage.objectAdmin"
+	defaultNoncurrentVersionRetentionInDays = 30
+)
+
+// gcsStateBucketBootstrapResource Present st-gcp_gcs_state_bucket_bootstrap resource
+type gcsStateBucketBootstrapResource struct {
+	client *gcpClients
+}
+
+type gcsStateBucketBootstrapState struct {
+	ClientConfig                   *clientConfig  `tfsdk:"client_config"`
+	ID                             types.String   `tfsdk:"id"`
+	Name                           types.String   `tfsdk:"name"`
+	Location                       types.String   `tfsdk:"location"`
+	KmsKeyName                     types.String   `tfsdk:"kms_key_name"`
+	NoncurrentVersionRetentionDays types.Int64    `tfsdk:"noncurrent_version_retention_days"`
+	CIServiceAccounts              []types.String `tfsdk:"ci_service_accounts"`
+	Labels                         types.Map      `tfsdk:"labels"`
+	ForceDestroy                   types.Bool     `tfsdk:"force_destroy"`
+	SelfLink                       types.String   `tfsdk:"self_link"`
+	URL                            types.String   `tfsdk:"url"`
+}
+
+// NewGcsStateBucketBootstrapResource
+func NewGcsStateBucketBootstrapResource() resource.Resource {
+	return &gcsStateBucketBootstrapResource{}
+}
+
+// Metadata
+func (r *gcsStateBucketBootstrapResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gcs_state_bucket_bootstrap"
+}
+
+// Schema
+func (r *gcsStateBucketBootstrapResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provision a hardened GCS bucket for Terraform states, with " +
+			"versioning, uniform bucket-level access, public access prevention, " +
+			"noncurrent version retention, CMEK and IAM for CI service accounts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of the bucket.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the bucket.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Description: "Location of the bucket, e.g. ASIA or asia-southeast1.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kms_key_name": schema.StringAttribute{
+				Description: "Cloud KMS key used to encrypt the objects by default. " +
+					"The Cloud Storage service agent must be granted to use the key.",
+				Optional: true,
+			},
+			"noncurrent_version_retention_days": schema.Int64Attribute{
+				Description: "Days to retain noncurrent versions of the states before " +
+					"they are deleted. Default to 30.",
+				Optional: true,
+			},
+			"ci_service_accounts": schema.ListAttribute{
+				Description: "Emails of CI service accounts to be granted " + stateBucketCIRole +
+					" on the bucket.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of the bucket.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "Delete all objects and their versions when the bucket is " +
+					"destroyed. Destroying a non-empty bucket fails if not set.",
+				Optional: true,
+			},
+			"self_link": schema.StringAttribute{
+				Description: "Self link of the bucket.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "gs:// URL of the bucket.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *gcsStateBucketBootstrapResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *gcsStateBucketBootstrapResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state gcsStateBucketBootstrapState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	bucket, diags := r.newStateBucket(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	bucket.Location = state.Location.ValueString()
+	bucket.Versioning = &googleStorageClient.BucketVersioning{Enabled: true}
+	bucket.IamConfiguration = &googleStorageClient.BucketIamConfiguration{
+		UniformBucketLevelAccess: &googleStorageClient.BucketIamConfigurationUniformBucketLevelAccess{
+			Enabled: true,
+		},
+		PublicAccessPrevention: "enforced",
+	}
+
+	created, err := storageClient.Buckets.Insert(r.client.project, bucket).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create state bucket", err.Error())
+		return
+	}
+	state.ID = types.StringValue(created.Name)
+	state.SelfLink = types.StringValue(created.SelfLink)
+	state.URL = types.StringValue("gs://" + created.Name)
+
+	if err := updateStateBucketCIBindings(ctx, storageClient, created.Name,
+		nil, state.CIServiceAccounts); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to grant CI service accounts", err.Error())
+		// Bucket is created, record it in state so that it can be destroyed.
+		state.CIServiceAccounts = nil
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *gcsStateBucketBootstrapResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state gcsStateBucketBootstrapState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	bucket, err := storageClient.Buckets.Get(state.Name.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get state bucket", err.Error())
+		return
+	}
+
+	state.SelfLink = types.StringValue(bucket.SelfLink)
+	if bucket.Encryption != nil && bucket.Encryption.DefaultKmsKeyName != "" {
+		state.KmsKeyName = types.StringValue(bucket.Encryption.DefaultKmsKeyName)
+	} else {
+		state.KmsKeyName = types.StringNull()
+	}
+	// The change reference label is managed by the provider.
+	delete(bucket.Labels, changeReferenceKey)
+	if len(bucket.Labels) > 0 || !state.Labels.IsNull() {
+		labels, diags := types.MapValueFrom(ctx, types.StringType, bucket.Labels)
+		resp.Diagnostics.Append(diags...)
+		state.Labels = labels
+	}
+
+	policy, err := storageClient.Buckets.GetIamPolicy(bucket.Name).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get state bucket IAM policy", err.Error())
+		return
+	}
+	state.CIServiceAccounts = grantedStateBucketCIServiceAccounts(policy, state.CIServiceAccounts)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *gcsStateBucketBootstrapResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state gcsStateBucketBootstrapState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	bucket, diags := r.newStateBucket(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if bucket.Encryption == nil {
+		bucket.Encryption = &googleStorageClient.BucketEncryption{}
+		bucket.NullFields = append(bucket.NullFields, "Encryption")
+	}
+	// Labels are merged by patch, remove the labels deleted from plan.
+	if !state.Labels.IsNull() {
+		for key := range state.Labels.Elements() {
+			if _, ok := bucket.Labels[key]; !ok {
+				bucket.NullFields = append(bucket.NullFields, "Labels."+key)
+			}
+		}
+	}
+
+	if _, err := storageClient.Buckets.Patch(plan.Name.ValueString(), bucket).Context(ctx).Do(); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update state bucket", err.Error())
+		return
+	}
+
+	if err := updateStateBucketCIBindings(ctx, storageClient, plan.Name.ValueString(),
+		state.CIServiceAccounts, plan.CIServiceAccounts); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update CI service accounts", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.SelfLink = state.SelfLink
+	plan.URL = state.URL
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *gcsStateBucketBootstrapResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state gcsStateBucketBootstrapState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	bucketName := state.Name.ValueString()
+	if state.ForceDestroy.ValueBool() {
+		if err := deleteAllObjectVersions(ctx, storageClient, bucketName); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to delete objects of state bucket", err.Error())
+			return
+		}
+	}
+
+	err = storageClient.Buckets.Delete(bucketName).Context(ctx).Do()
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete state bucket", err.Error())
+	}
+}
+
+// newStateBucket builds the updatable fields of the state bucket from plan.
+func (r *gcsStateBucketBootstrapResource) newStateBucket(ctx context.Context,
+	s *gcsStateBucketBootstrapState) (*googleStorageClient.Bucket, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	retentionDays := int64(defaultNoncurrentVersionRetentionInDays)
+	if !s.NoncurrentVersionRetentionDays.IsNull() {
+		retentionDays = s.NoncurrentVersionRetentionDays.ValueInt64()
+	}
+
+	bucket := &googleStorageClient.Bucket{
+		Name: s.Name.ValueString(),
+		Lifecycle: &googleStorageClient.BucketLifecycle{
+			Rule: []*googleStorageClient.BucketLifecycleRule{
+				{
+					Action: &googleStorageClient.BucketLifecycleRuleAction{Type: "Delete"},
+					Condition: &googleStorageClient.BucketLifecycleRuleCondition{
+						DaysSinceNoncurrentTime: retentionDays,
+						IsLive:                  googleBool(false),
+					},
+				},
+			},
+		},
+	}
+	if s.KmsKeyName.ValueString() != "" {
+		bucket.Encryption = &googleStorageClient.BucketEncryption{
+			DefaultKmsKeyName: s.KmsKeyName.ValueString(),
+		}
+	}
+	labels := map[string]string{}
+	if !s.Labels.IsNull() {
+		diags.Append(s.Labels.ElementsAs(ctx, &labels, false)...)
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		labels[changeReferenceKey] = label
+	}
+	if len(labels) > 0 {
+		bucket.Labels = labels
+	}
+	return bucket, diags
+}
+
+// updateStateBucketCIBindings Revoke the removed and grant the added CI
+// service accounts on the bucket. Members granted by others are untouched.
+func updateStateBucketCIBindings(ctx context.Context, client *googleStorageClient.Service,
+	bucketName string, oldAccounts, newAccounts []types.String) error {
+	toRemove := map[string]bool{}
+	for _, account := range oldAccounts {
+		toRemove["serviceAccount:"+account.ValueString()] = true
+	}
+	toAdd := []string{}
+	for _, account := range newAccounts {
+		member := "serviceAccount:" + account.ValueString()
+		if toRemove[member] {
+			delete(toRemove, member)
+			continue
+		}
+		toAdd = append(toAdd, member)
+	}
+	if len(toAdd) == 0 && len(toRemove) == 0 {
+		return nil
+	}
+
+	policy, err := client.Buckets.GetIamPolicy(bucketName).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	var binding *googleStorageClient.PolicyBindings
+	for _, b := range policy.Bindings {
+		if b.Role == stateBucketCIRole && b.Condition == nil {
+			binding = b
+			break
+		}
+	}
+	if binding == nil {
+		binding = &googleStorageClient.PolicyBindings{Role: stateBucketCIRole}
+		policy.Bindings = append(policy.Bindings, binding)
+	}
+
+	members := []string{}
+	for _, member := range binding.Members {
+		if !toRemove[member] {
+			members = append(members, member)
+		}
+	}
+	binding.Members = append(members, toAdd...)
+
+	if _, err := client.Buckets.SetIamPolicy(bucketName, policy).Context(ctx).Do(); err != nil {
+		return fmt.Errorf("failed to set IAM policy: %v", err)
+	}
+	return nil
+}
+
+// grantedStateBucketCIServiceAccounts returns the CI service accounts that
+// are still granted on the bucket.
+func grantedStateBucketCIServiceAccounts(policy *googleStorageClient.Policy,
+	accounts []types.String) []types.String {
+	if accounts == nil {
+		return nil
+	}
+
+	granted := map[string]bool{}
+	for _, b := range policy.Bindings {
+		if b.Role != stateBucketCIRole || b.Condition != nil {
+			continue
+		}
+		for _, member := range b.Members {
+			granted[member] = true
+		}
+	}
+
+	result := []types.String{}
+	for _, account := range accounts {
+		if granted["serviceAccount:"+account.ValueString()] {
+			result = append(result, account)
+		}
+	}
+	return result
+}
+
+// deleteAllObjectVersions Delete all objects including noncurrent versions
+// in the bucket.
+func deleteAllObjectVersions(ctx context.Context, client *googleStorageClient.Service, bucketName string) error {
+	return client.Objects.List(bucketName).Versions(true).Pages(ctx,
+		func(page *googleStorageClient.Objects) error {
+			for _, object := range page.Items {
+				err := client.Objects.Delete(bucketName, object.Name).
+					Generation(object.Generation).Context(ctx).Do()
+				if err != nil && !isNotFoundError(err) {
+					return fmt.Errorf("failed to delete %s#%d: %v", object.Name, object.Generation, err)
+				}
+			}
+			return nil
+		})
+}
+
+func googleBool(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,263 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultHTTPProbeRetries       = 3
+	defaultHTTPProbeRetryInterval = 5
+)
+
+// httpProbeResource Present st-gcp_http_probe resource
+type httpProbeResource struct {
+	client *gcpClients
+}
+
+type httpProbeState struct {
+	ID                   types.String `tfsdk:"id"`
+	URL                  types.String `tfsdk:"url"`
+	Host                 types.String `tfsdk:"host"`
+	ExpectedStatus       types.Int64  `tfsdk:"expected_status"`
+	ExpectedBodyContains types.String `tfsdk:"expected_body_contains"`
+	TimeoutSeconds       types.Int64  `tfsdk:"timeout_seconds"`
+	Retries              types.Int64  `tfsdk:"retries"`
+	RetryIntervalSeconds types.Int64  `tfsdk:"retry_interval_seconds"`
+	InsecureSkipVerify   types.Bool   `tfsdk:"insecure_skip_verify"`
+	Triggers             types.Map    `tfsdk:"triggers"`
+	StatusCode           types.Int64  `tfsdk:"status_code"`
+	LatencyMs            types.Int64  `tfsdk:"latency_ms"`
+	CheckedAt            types.String `tfsdk:"checked_at"`
+}
+
+// NewHTTPProbeResource
+func NewHTTPProbeResource() resource.Resource {
+	return &httpProbeResource{}
+}
+
+// Metadata
+func (r *httpProbeResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_http_probe"
+}
+
+// Schema
+func (r *httpProbeResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Probe a HTTP(S) endpoint from the provider host with retries on " +
+			"create and update, and fail the apply if the expectations are not met. " +
+			"Used to gate changes such as DNS cutovers on the endpoint health.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "URL of the probe.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL to be probed, e.g. https://www.example.com/healthz.",
+				Required:    true,
+			},
+			"host": schema.StringAttribute{
+				Description: "Host header and TLS server name of the probe, used to " +
+					"probe an endpoint by IP before DNS is pointed to it.",
+				Optional: true,
+			},
+			"expected_status": schema.Int64Attribute{
+				Description: "Expected HTTP status code. Default to " +
+					strconv.Itoa(defaultProbeExpectedStatus) + ".",
+				Optional: true,
+			},
+			"expected_body_contains": schema.StringAttribute{
+				Description: "Substring expected in the response body.",
+				Optional:    true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Timeout in seconds of each attempt. Default to " +
+					strconv.Itoa(defaultProbeTimeoutSecs) + ".",
+				Optional: true,
+			},
+			"retries": schema.Int64Attribute{
+				Description: "Number of retries after the first failed attempt. Default to " +
+					strconv.Itoa(defaultHTTPProbeRetries) + ".",
+				Optional: true,
+			},
+			"retry_interval_seconds": schema.Int64Attribute{
+				Description: "Seconds to wait between attempts. Default to " +
+					strconv.Itoa(defaultHTTPProbeRetryInterval) + ".",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip the verification of the server certificate. Default to false.",
+				Optional:    true,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will run the probe again.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"status_code": schema.Int64Attribute{
+				Description: "HTTP status code returned by the last attempt.",
+				Computed:    true,
+			},
+			"latency_ms": schema.Int64Attribute{
+				Description: "Latency in milliseconds of the last attempt.",
+				Computed:    true,
+			},
+			"checked_at": schema.StringAttribute{
+				Description: "The time of the successful probe in RFC3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure
+func (r *httpProbeResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *httpProbeResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state httpProbeState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if err := runHTTPProbe(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("HTTP probe failed", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *httpProbeResource) Read(_ context.Context,
+	_ resource.ReadRequest, _ *resource.ReadResponse) {
+	// The probe only runs on create and update, so that refresh does not fail
+	// when the endpoint is unhealthy.
+}
+
+// Update
+func (r *httpProbeResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var state httpProbeState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if err := runHTTPProbe(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("HTTP probe failed", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete
+func (r *httpProbeResource) Delete(_ context.Context,
+	_ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+	// Nothing to delete, only remove from state.
+}
+
+// runHTTPProbe Probe the endpoint until the expectations are met or the
+// retries are exhausted, and record the result of the last attempt.
+func runHTTPProbe(ctx context.Context, s *httpProbeState) error {
+	expectedStatus := int64(defaultProbeExpectedStatus)
+	if !s.ExpectedStatus.IsNull() {
+		expectedStatus = s.ExpectedStatus.ValueInt64()
+	}
+	timeout := time.Duration(defaultProbeTimeoutSecs) * time.Second
+	if !s.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(s.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	retries := int64(defaultHTTPProbeRetries)
+	if !s.Retries.IsNull() {
+		retries = s.Retries.ValueInt64()
+	}
+	retryInterval := time.Duration(defaultHTTPProbeRetryInterval) * time.Second
+	if !s.RetryIntervalSeconds.IsNull() {
+		retryInterval = time.Duration(s.RetryIntervalSeconds.ValueInt64()) * time.Second
+	}
+
+	probe := &httpProbeRequest{
+		url:                s.URL.ValueString(),
+		host:               s.Host.ValueString(),
+		timeout:            timeout,
+		insecureSkipVerify: s.InsecureSkipVerify.ValueBool(),
+	}
+
+	var lastErr error
+	for attempt := int64(0); attempt <= retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryInterval):
+			}
+		}
+
+		result := probeHTTP(ctx, probe)
+		s.StatusCode = types.Int64Value(int64(result.statusCode))
+		s.LatencyMs = types.Int64Value(result.latency.Milliseconds())
+
+		switch {
+		case result.err != nil:
+			lastErr = result.err
+		case int64(result.statusCode) != expectedStatus:
+			lastErr = fmt.Errorf("expected status %d, got %d", expectedStatus, result.statusCode)
+		case !strings.Contains(result.body, s.ExpectedBodyContains.ValueString()):
+			lastErr = fmt.Errorf("response body does not contain %q", s.ExpectedBodyContains.ValueString())
+		default:
+			s.ID = types.StringValue(probe.url)
+			s.CheckedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+			return nil
+		}
+		tflog.Warn(ctx, "HTTP probe attempt failed", map[string]interface{}{
+			"url":     probe.url,
+			"attempt": attempt + 1,
+			"error":   lastErr.Error(),
+		})
+	}
+	return fmt.Errorf("%s is not healthy after %d attempts: %v", probe.url, retries+1, lastErr)
+}
@@ -0,0 +1,336 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleResourceManagerClient "google.golang.org/api/cloudresourcemanager/v1"
+)
+
+// iamPolicyMaxRetryTimes is the retry times of the concurrent IAM policy
+// modifications, it is higher than maxRetryTimes as dozens of modules may
+// modify the project IAM policy in parallel.
+const iamPolicyMaxRetryTimes = 10
+
+// iamMemberSafeResource Present st-gcp_iam_member_safe resource
+type iamMemberSafeResource struct {
+	client *gcpClients
+}
+
+type iamMemberSafeState struct {
+	ClientConfig *clientConfig       `tfsdk:"client_config"`
+	ID           types.String        `tfsdk:"id"`
+	Role         types.String        `tfsdk:"role"`
+	Member       types.String        `tfsdk:"member"`
+	Condition    *iamMemberCondition `tfsdk:"condition"`
+}
+
+type iamMemberCondition struct {
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Expression  types.String `tfsdk:"expression"`
+}
+
+// NewIamMemberSafeResource
+func NewIamMemberSafeResource() resource.Resource {
+	return &iamMemberSafeResource{}
+}
+
+// Metadata
+func (r *iamMemberSafeResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_member_safe"
+}
+
+// Schema
+func (r *iamMemberSafeResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grant a role to a member in the project IAM policy without " +
+			"touching the other members. The policy is read, modified and written with " +
+			"its etag, and retried with backoff when it is modified concurrently, so " +
+			"parallel applies of many modules do not fail on the conflicts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the binding in the format <project>/<role>/<member>, " +
+					"followed by /<condition title> if condition is set.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role to be granted, e.g. roles/storage.objectViewer.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member": schema.StringAttribute{
+				Description: "Member to be granted role, e.g. " +
+					"serviceAccount:app@my-project.iam.gserviceaccount.com.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"condition": schema.SingleNestedAttribute{
+				Description: "IAM condition of the binding.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Description: "Title of condition.",
+						Required:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "Description of condition.",
+						Optional:    true,
+					},
+					"expression": schema.StringAttribute{
+						Description: "Common Expression Language expression of condition, e.g. " +
+							`request.time < timestamp("2030-01-01T00:00:00Z").`,
+						Required: true,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *iamMemberSafeResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *iamMemberSafeResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state iamMemberSafeState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.updateIamMember(ctx, &state, true); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to add member to project IAM policy", err.Error())
+		return
+	}
+
+	id := fmt.Sprintf("%s/%s/%s", r.client.project, state.Role.ValueString(), state.Member.ValueString())
+	if state.Condition != nil {
+		id += "/" + state.Condition.Title.ValueString()
+	}
+	state.ID = types.StringValue(id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *iamMemberSafeResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state iamMemberSafeState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	resourceManagerClient, err := googleResourceManagerClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Resource Manager client", err.Error())
+		return
+	}
+
+	policy, err := getProjectIamPolicy(ctx, resourceManagerClient, r.client.project)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get project IAM policy", err.Error())
+		return
+	}
+	// The member removed by others is granted again.
+	binding := findIamBinding(policy, &state)
+	if binding == nil || !containsString(binding.Members, state.Member.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+// Update
+func (r *iamMemberSafeResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replacement, only the client config is updated.
+	var plan, state iamMemberSafeState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *iamMemberSafeResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state iamMemberSafeState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.updateIamMember(ctx, &state, false); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to remove member from project IAM policy", err.Error())
+	}
+}
+
+// updateIamMember Add or remove the member of the binding in the project IAM
+// policy, retried with the new etag when the policy is modified concurrently.
+func (r *iamMemberSafeResource) updateIamMember(ctx context.Context, s *iamMemberSafeState, add bool) error {
+	resourceManagerClient, err := googleResourceManagerClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to initialize Google Cloud Resource Manager client: %v", err)
+	}
+
+	project := r.client.project
+	member := s.Member.ValueString()
+	updateFunc := func() error {
+		policy, err := getProjectIamPolicy(ctx, resourceManagerClient, project)
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+
+		binding := findIamBinding(policy, s)
+		switch {
+		case add && binding == nil:
+			binding = &googleResourceManagerClient.Binding{Role: s.Role.ValueString()}
+			if c := s.Condition; c != nil {
+				binding.Condition = &googleResourceManagerClient.Expr{
+					Title:       c.Title.ValueString(),
+					Description: c.Description.ValueString(),
+					Expression:  c.Expression.ValueString(),
+				}
+			}
+			policy.Bindings = append(policy.Bindings, binding)
+			fallthrough
+		case add:
+			if containsString(binding.Members, member) {
+				return nil
+			}
+			binding.Members = append(binding.Members, member)
+		default:
+			if binding == nil || !containsString(binding.Members, member) {
+				return nil
+			}
+			members := []string{}
+			for _, m := range binding.Members {
+				if m != member {
+					members = append(members, m)
+				}
+			}
+			binding.Members = members
+			bindings := []*googleResourceManagerClient.Binding{}
+			for _, b := range policy.Bindings {
+				if len(b.Members) > 0 {
+					bindings = append(bindings, b)
+				}
+			}
+			policy.Bindings = bindings
+		}
+		// The conditional bindings are only kept by the version 3 policy.
+		policy.Version = iamPolicyVersion
+
+		_, err = resourceManagerClient.Projects.SetIamPolicy(project,
+			&googleResourceManagerClient.SetIamPolicyRequest{Policy: policy}).Context(ctx).Do()
+		if err != nil {
+			// The policy is modified by others, retry with the new etag.
+			if isConflictError(err) || isPreconditionFailedError(err) {
+				return err
+			}
+			return &backoff.PermanentError{Err: err}
+		}
+		return nil
+	}
+	return backoff.Retry(updateFunc, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), iamPolicyMaxRetryTimes))
+}
+
+// getProjectIamPolicy returns the version 3 IAM policy of the project.
+func getProjectIamPolicy(ctx context.Context, client *googleResourceManagerClient.Service,
+	project string) (*googleResourceManagerClient.Policy, error) {
+	return client.Projects.GetIamPolicy(project, &googleResourceManagerClient.GetIamPolicyRequest{
+		Options: &googleResourceManagerClient.GetPolicyOptions{
+			RequestedPolicyVersion: iamPolicyVersion,
+		},
+	}).Context(ctx).Do()
+}
+
+// findIamBinding returns the binding of the role and the condition in state,
+// nil if there is none.
+func findIamBinding(policy *googleResourceManagerClient.Policy,
+	s *iamMemberSafeState) *googleResourceManagerClient.Binding {
+	for _, binding := range policy.Bindings {
+		if binding.Role != s.Role.ValueString() {
+			continue
+		}
+		c := s.Condition
+		switch {
+		case c == nil && binding.Condition == nil:
+			return binding
+		case c != nil && binding.Condition != nil &&
+			binding.Condition.Title == c.Title.ValueString() &&
+			binding.Condition.Description == c.Description.ValueString() &&
+			binding.Condition.Expression == c.Expression.ValueString():
+			return binding
+		}
+	}
+	return nil
+}
@@ -0,0 +1,530 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+	googleRecommenderClient "google.golang.org/api/recommender/v1"
+)
+
+const (
+	defaultIdleCleanupMaxPerType = 10
+	computeResourcePrefix        = "//compute.googleapis.com/"
+	snapshotResourceType         = "compute.googleapis.com/Snapshot"
+	idleCleanupActionDelete      = "DELETE"
+	idleCleanupActionSnapshot    = "SNAPSHOT_AND_DELETE"
+)
+
+// idleResourceCleanupAction starts the cleanup of an idle compute resource
+// and returns its operation. The scope is the region or zone of the
+// resource, or global.
+type idleResourceCleanupAction func(ctx context.Context, c *googleComputeClient.Service,
+	project, scope, name string) (*googleComputeClient.Operation, error)
+
+type idleResourceCleanupEntry struct {
+	recommender  string
+	resourceType string
+	scope        computeNameLookupScope
+	// snapshot is true if the recommendation may add a snapshot to be
+	// created before the resource is deleted.
+	snapshot bool
+	cleanup  idleResourceCleanupAction
+}
+
+var idleResourceCleanups = map[string]idleResourceCleanupEntry{
+	"address": {
+		recommender:  "google.compute.address.IdleResourceRecommender",
+		resourceType: "compute.googleapis.com/Address",
+		scope:        scopeGlobalOrRegional,
+		cleanup: func(ctx context.Context, c *googleComputeClient.Service,
+			project, scope, name string) (*googleComputeClient.Operation, error) {
+			if scope == globalScope {
+				return c.GlobalAddresses.Delete(project, name).Context(ctx).Do()
+			}
+			return c.Addresses.Delete(project, scope, name).Context(ctx).Do()
+		},
+	},
+	"disk": {
+		recommender:  "google.compute.disk.IdleResourceRecommender",
+		resourceType: "compute.googleapis.com/Disk",
+		scope:        scopeZonal,
+		snapshot:     true,
+		cleanup: func(ctx context.Context, c *googleComputeClient.Service,
+			project, zone, name string) (*googleComputeClient.Operation, error) {
+			return c.Disks.Delete(project, zone, name).Context(ctx).Do()
+		},
+	},
+	"image": {
+		recommender:  "google.compute.image.IdleResourceRecommender",
+		resourceType: "compute.googleapis.com/Image",
+		scope:        scopeGlobal,
+		cleanup: func(ctx context.Context, c *googleComputeClient.Service,
+			project, _, name string) (*googleComputeClient.Operation, error) {
+			return c.Images.Delete(project, name).Context(ctx).Do()
+		},
+	},
+}
+
+// idleResourceCleanupResource Present st-gcp_idle_resource_cleanup resource
+type idleResourceCleanupResource struct {
+	client *gcpClients
+}
+
+type idleResourceCleanupState struct {
+	ClientConfig  *clientConfig                    `tfsdk:"client_config"`
+	ID            types.String                     `tfsdk:"id"`
+	ResourceTypes []types.String                   `tfsdk:"resource_types"`
+	Locations     []types.String                   `tfsdk:"locations"`
+	DryRun        types.Bool                       `tfsdk:"dry_run"`
+	MaxPerType    types.Map                        `tfsdk:"max_per_type"`
+	LastRunAt     types.String                     `tfsdk:"last_run_at"`
+	Actions       []*idleResourceCleanupActionItem `tfsdk:"actions"`
+}
+
+type idleResourceCleanupActionItem struct {
+	ResourceType   types.String `tfsdk:"resource_type"`
+	Resource       types.String `tfsdk:"resource"`
+	Action         types.String `tfsdk:"action"`
+	Executed       types.Bool   `tfsdk:"executed"`
+	Recommendation types.String `tfsdk:"recommendation"`
+}
+
+// NewIdleResourceCleanupResource
+func NewIdleResourceCleanupResource() resource.Resource {
+	return &idleResourceCleanupResource{}
+}
+
+// Metadata
+func (r *idleResourceCleanupResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idle_resource_cleanup"
+}
+
+// Schema
+func (r *idleResourceCleanupResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	supportedTypes := make([]string, 0, len(idleResourceCleanups))
+	for resourceType := range idleResourceCleanups {
+		supportedTypes = append(supportedTypes, resourceType)
+	}
+	sort.Strings(supportedTypes)
+
+	resp.Schema = schema.Schema{
+		Description: "Delete the idle compute resources reported by the " +
+			"Recommender idle resource recommenders on every apply. Nothing is " +
+			"changed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Project of the cleaned up resources.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_types": schema.ListAttribute{
+				Description: "Allow-list of resource types to be cleaned up, supported " +
+					"types are " + strings.Join(supportedTypes, ", ") + ". Idle " +
+					"resources are deleted, after the snapshot recommended is " +
+					"created for the idle disks.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"locations": schema.ListAttribute{
+				Description: "Locations of the recommendations to be queried, either " +
+					"global, regions or zones. Each resource type is only queried in " +
+					"the locations it supports, e.g. disks in zones.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"dry_run": schema.BoolAttribute{
+				Description: "Only report the idle resources in actions without " +
+					"cleaning them up. Default to true.",
+				Optional: true,
+			},
+			"max_per_type": schema.MapAttribute{
+				Description: "Maximum number of resources cleaned up per resource type " +
+					"in each apply, keyed by resource type. Default to " +
+					strconv.Itoa(defaultIdleCleanupMaxPerType) + ".",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"last_run_at": schema.StringAttribute{
+				Description: "The time of the last cleanup in RFC3339 format. It is " +
+					"always unknown in plan, so the cleanup runs on every apply.",
+				Computed: true,
+			},
+			"actions": schema.ListNestedAttribute{
+				Description: "Idle resources found by the last cleanup.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Description: "Type of the idle resource.",
+							Computed:    true,
+						},
+						"resource": schema.StringAttribute{
+							Description: "Full resource name of the idle resource.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Cleanup action of the idle resource, DELETE, or " +
+								"SNAPSHOT_AND_DELETE if a snapshot is created before deleting.",
+							Computed: true,
+						},
+						"executed": schema.BoolAttribute{
+							Description: "Whether the action is executed, false in dry run.",
+							Computed:    true,
+						},
+						"recommendation": schema.StringAttribute{
+							Description: "Name of the recommendation reporting the idle resource.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *idleResourceCleanupResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan
+func (r *idleResourceCleanupResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Mark the results unknown so that the cleanup runs on every apply.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_run_at"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("actions"),
+		types.ListUnknown(types.ObjectType{AttrTypes: map[string]attr.Type{
+			"resource_type":  types.StringType,
+			"resource":       types.StringType,
+			"action":         types.StringType,
+			"executed":       types.BoolType,
+			"recommendation": types.StringType,
+		}}))...)
+}
+
+// Create
+func (r *idleResourceCleanupResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state idleResourceCleanupState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.cleanupIdleResources(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("cleanupIdleResources error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *idleResourceCleanupResource) Read(_ context.Context,
+	_ resource.ReadRequest, _ *resource.ReadResponse) {
+	// The cleanup results are only recorded in state, there is nothing to read.
+}
+
+// Update
+func (r *idleResourceCleanupResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var state idleResourceCleanupState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.cleanupIdleResources(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("cleanupIdleResources error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete
+func (r *idleResourceCleanupResource) Delete(_ context.Context,
+	_ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+	// The cleaned up resources can not be restored, only remove from state.
+}
+
+// cleanupIdleResources Clean up the idle resources of the allowed types in
+// all locations, up to the maximum number per type, and record the actions in
+// state. The recommendations are marked succeeded once cleaned up.
+func (r *idleResourceCleanupResource) cleanupIdleResources(ctx context.Context,
+	s *idleResourceCleanupState) error {
+	maxPerType := map[string]int64{}
+	if !s.MaxPerType.IsNull() {
+		if diags := s.MaxPerType.ElementsAs(ctx, &maxPerType, false); diags.HasError() {
+			return fmt.Errorf("failed to read max_per_type")
+		}
+	}
+	dryRun := s.DryRun.IsNull() || s.DryRun.ValueBool()
+	for _, resourceType := range s.ResourceTypes {
+		if _, ok := idleResourceCleanups[resourceType.ValueString()]; !ok {
+			return fmt.Errorf("unsupported resource type %s", resourceType.ValueString())
+		}
+	}
+
+	recommenderClient, err := googleRecommenderClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to initialize Google Recommender client: %v", err)
+	}
+
+	actions := []*idleResourceCleanupActionItem{}
+	for _, resourceType := range s.ResourceTypes {
+		entry := idleResourceCleanups[resourceType.ValueString()]
+
+		limit := int64(defaultIdleCleanupMaxPerType)
+		if maxCount, ok := maxPerType[resourceType.ValueString()]; ok {
+			limit = maxCount
+		}
+
+		var count int64
+		for _, location := range s.Locations {
+			if count >= limit {
+				break
+			}
+			if !idleResourceCleanupInScope(entry.scope, location.ValueString()) {
+				continue
+			}
+
+			parent := fmt.Sprintf("projects/%s/locations/%s/recommenders/%s",
+				r.client.project, location.ValueString(), entry.recommender)
+			var recommendations []*googleRecommenderClient.GoogleCloudRecommenderV1Recommendation
+			err := recommenderClient.Projects.Locations.Recommenders.Recommendations.List(parent).
+				Filter("stateInfo.state = ACTIVE").Pages(ctx,
+				func(page *googleRecommenderClient.GoogleCloudRecommenderV1ListRecommendationsResponse) error {
+					recommendations = append(recommendations, page.Recommendations...)
+					return nil
+				})
+			if err != nil {
+				return fmt.Errorf("failed to list recommendations of %s: %v", parent, err)
+			}
+
+			for _, recommendation := range recommendations {
+				if count >= limit {
+					break
+				}
+				remove, snapshot, err := recommendationOperations(recommendation, &entry)
+				if err != nil {
+					return fmt.Errorf("unexpected recommendation %s: %v", recommendation.Name, err)
+				}
+				resourceName := remove.Resource
+				count++
+
+				actionName := idleCleanupActionDelete
+				if snapshot != nil {
+					actionName = idleCleanupActionSnapshot
+				}
+				action := &idleResourceCleanupActionItem{
+					ResourceType:   resourceType,
+					Resource:       types.StringValue(resourceName),
+					Action:         types.StringValue(actionName),
+					Executed:       types.BoolValue(!dryRun),
+					Recommendation: types.StringValue(recommendation.Name),
+				}
+				actions = append(actions, action)
+				if dryRun {
+					continue
+				}
+
+				project, scope, name := parseComputeResource(resourceName)
+				tflog.Info(ctx, "Cleaning up idle resource", map[string]interface{}{
+					"resource": resourceName,
+					"action":   actionName,
+				})
+				// The resource is only deleted after the snapshot recommended is
+				// created, so the data of the idle disk is kept.
+				if snapshot != nil {
+					if err := r.createRecommendedSnapshot(ctx, snapshot, resourceName); err != nil {
+						return fmt.Errorf("failed to create snapshot %s: %v", snapshot.Resource, err)
+					}
+				}
+				// The recommendation is only marked succeeded after the operation
+				// is done, so the failed cleanup is reported and retried.
+				op, err := entry.cleanup(ctx, r.client.computeClient, project, scope, name)
+				if err == nil {
+					err = waitComputeOperation(ctx, r.client.computeClient, project, op)
+				}
+				if err != nil && !isNotFoundError(err) {
+					return fmt.Errorf("failed to delete %s: %v", resourceName, err)
+				}
+
+				_, err = recommenderClient.Projects.Locations.Recommenders.Recommendations.MarkSucceeded(
+					recommendation.Name, &googleRecommenderClient.GoogleCloudRecommenderV1MarkRecommendationSucceededRequest{
+						Etag: recommendation.Etag,
+					}).Context(ctx).Do()
+				if err != nil {
+					tflog.Warn(ctx, "Failed to mark recommendation succeeded", map[string]interface{}{
+						"recommendation": recommendation.Name,
+						"error":          err.Error(),
+					})
+				}
+			}
+		}
+	}
+
+	s.ID = types.StringValue(r.client.project)
+	s.LastRunAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	s.Actions = actions
+	return nil
+}
+
+// idleResourceCleanupInScope returns true if the location is supported by
+// the resource type. Zones are told from regions by the zone suffix, e.g.
+// asia-southeast1-a.
+func idleResourceCleanupInScope(scope computeNameLookupScope, location string) bool {
+	isGlobal := location == globalScope
+	isZone := !isGlobal && strings.Count(location, "-") == 2
+	switch scope {
+	case scopeGlobal:
+		return isGlobal
+	case scopeZonal:
+		return isZone
+	case scopeGlobalOrRegional:
+		return !isZone
+	}
+	return false
+}
+
+// recommendationOperations returns the operation removing the idle resource
+// of the entry, e.g. //compute.googleapis.com/projects/p/zones/z/disks/d,
+// and the operation adding the snapshot to be created before the removal,
+// nil if no snapshot is recommended. An error is returned if the
+// recommendation has any other operation, since it cannot be executed as is.
+func recommendationOperations(recommendation *googleRecommenderClient.GoogleCloudRecommenderV1Recommendation,
+	entry *idleResourceCleanupEntry) (remove, snapshot *googleRecommenderClient.GoogleCloudRecommenderV1Operation,
+	err error) {
+	if recommendation.Content == nil {
+		return nil, nil, fmt.Errorf("no operation is recommended")
+	}
+	for _, group := range recommendation.Content.OperationGroups {
+		for _, operation := range group.Operations {
+			switch {
+			case operation.Action == "remove" && operation.ResourceType == entry.resourceType &&
+				strings.HasPrefix(operation.Resource, computeResourcePrefix) && remove == nil:
+				remove = operation
+			case operation.Action == "add" && operation.ResourceType == snapshotResourceType &&
+				entry.snapshot && snapshot == nil:
+				snapshot = operation
+			default:
+				return nil, nil, fmt.Errorf("unsupported %s operation of %s %s",
+					operation.Action, operation.ResourceType, operation.Resource)
+			}
+		}
+	}
+	if remove == nil {
+		return nil, nil, fmt.Errorf("no remove operation of %s", entry.resourceType)
+	}
+	return remove, snapshot, nil
+}
+
+// createRecommendedSnapshot creates the snapshot of the add operation of
+// recommendation, and waits until it is created. The snapshot is taken from
+// the disk removed if the source disk is not set by the recommendation.
+func (r *idleResourceCleanupResource) createRecommendedSnapshot(ctx context.Context,
+	operation *googleRecommenderClient.GoogleCloudRecommenderV1Operation, diskResourceName string) error {
+	snapshot := &googleComputeClient.Snapshot{}
+	if operation.Value != nil {
+		value, err := json.Marshal(operation.Value)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(value, snapshot); err != nil {
+			return err
+		}
+	}
+	project, _, name := parseComputeResource(operation.Resource)
+	if snapshot.Name == "" {
+		snapshot.Name = name
+	}
+	if snapshot.SourceDisk == "" {
+		snapshot.SourceDisk = strings.TrimPrefix(diskResourceName, computeResourcePrefix)
+	}
+
+	op, err := r.client.computeClient.Snapshots.Insert(project, snapshot).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, project, op)
+	}
+	// The snapshot already exists if the previous cleanup failed to delete
+	// the disk.
+	if isConflictError(err) {
+		return nil
+	}
+	return err
+}
+
+// parseComputeResource returns the project, the scope and the name of the
+// full compute resource name. The scope is the region or zone of the
+// resource, or global.
+func parseComputeResource(resourceName string) (project, scope, name string) {
+	parts := strings.Split(strings.TrimPrefix(resourceName, computeResourcePrefix), "/")
+	if len(parts) < 5 {
+		return "", "", ""
+	}
+	project = parts[1]
+	scope = globalScope
+	if parts[2] == "regions" || parts[2] == "zones" {
+		scope = parts[3]
+	}
+	return project, scope, parts[len(parts)-1]
+}
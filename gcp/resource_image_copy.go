@@ -0,0 +1,330 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// imageCopyResource Present st-gcp_image_copy resource
+type imageCopyResource struct {
+	client *gcpClients
+}
+
+type imageCopyState struct {
+	ClientConfig        *clientConfig `tfsdk:"client_config"`
+	ID                  types.String  `tfsdk:"id"`
+	Name                types.String  `tfsdk:"name"`
+	SourceImage         types.String  `tfsdk:"source_image"`
+	Family              types.String  `tfsdk:"family"`
+	Description         types.String  `tfsdk:"description"`
+	Labels              types.Map     `tfsdk:"labels"`
+	KmsKeyName          types.String  `tfsdk:"kms_key_name"`
+	SourceImageSelfLink types.String  `tfsdk:"source_image_self_link"`
+	SelfLink            types.String  `tfsdk:"self_link"`
+}
+
+// NewImageCopyResource
+func NewImageCopyResource() resource.Resource {
+	return &imageCopyResource{}
+}
+
+// Metadata
+func (r *imageCopyResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_copy"
+}
+
+// Schema
+func (r *imageCopyResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Copy a compute image, e.g. from a build project into the images " +
+			"project of production, keeping the family, the description and the labels of " +
+			"the source image and optionally encrypting the copy with a customer managed " +
+			"encryption key. Images are immutable, so changing any argument creates a new image.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of image.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the new image.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_image": schema.StringAttribute{
+				Description: "Self link of the image to be copied, e.g. " +
+					"projects/my-build-project/global/images/web-20240101, or of an image " +
+					"family, e.g. projects/my-build-project/global/images/family/web, to copy " +
+					"the latest image of family on create.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"family": schema.StringAttribute{
+				Description: "Family of the new image, the family of the source image is " +
+					"used if not set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the new image, the description of the source " +
+					"image is used if not set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of the new image merged into the labels of the source image.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"kms_key_name": schema.StringAttribute{
+				Description: "Resource name of the Cloud KMS key to encrypt the new image, " +
+					"e.g. projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key. " +
+					"The new image is encrypted with the Google managed key if not set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_image_self_link": schema.StringAttribute{
+				Description: "Self link of the image copied.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"self_link": schema.StringAttribute{
+				Description: "Self link of the new image.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *imageCopyResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *imageCopyResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state imageCopyState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	source, err := r.getSourceImage(ctx, state.SourceImage.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get source image", err.Error())
+		return
+	}
+
+	image, err := r.newImageCopy(ctx, &state, source)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to copy source image", err.Error())
+		return
+	}
+
+	op, err := r.client.computeClient.Images.Insert(r.client.project, image).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create image", err.Error())
+		return
+	}
+
+	created, err := r.client.computeClient.Images.Get(r.client.project, image.Name).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get image", err.Error())
+		return
+	}
+	state.ID = types.StringValue(created.Name)
+	state.SourceImageSelfLink = types.StringValue(source.SelfLink)
+	state.SelfLink = types.StringValue(created.SelfLink)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *imageCopyResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state imageCopyState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	image, err := r.client.computeClient.Images.Get(r.client.project, state.ID.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get image", err.Error())
+		return
+	}
+	state.SelfLink = types.StringValue(image.SelfLink)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *imageCopyResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the arguments require replacement since images are immutable, so
+	// there is nothing to update.
+	var state imageCopyState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete
+func (r *imageCopyResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state imageCopyState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	op, err := r.client.computeClient.Images.Delete(r.client.project, state.ID.ValueString()).Context(ctx).Do()
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete image", err.Error())
+	}
+}
+
+// getSourceImage gets the image of the self link, or the latest image of
+// family if the self link is of an image family.
+func (r *imageCopyResource) getSourceImage(ctx context.Context,
+	selfLink string) (*googleComputeClient.Image, error) {
+	parts := strings.Split(selfLink, "/")
+	for i := 0; i+4 < len(parts); i++ {
+		if parts[i] != "projects" || parts[i+2] != "global" || parts[i+3] != "images" {
+			continue
+		}
+		project := parts[i+1]
+		if parts[i+4] == "family" && i+5 < len(parts) {
+			return r.client.computeClient.Images.GetFromFamily(project, parts[i+5]).Context(ctx).Do()
+		}
+		return r.client.computeClient.Images.Get(project, parts[i+4]).Context(ctx).Do()
+	}
+	return nil, fmt.Errorf("%s is not in the format projects/<project>/global/images/<name> "+
+		"or projects/<project>/global/images/family/<family>", selfLink)
+}
+
+// newImageCopy copies the source image with the overrides in state.
+func (r *imageCopyResource) newImageCopy(ctx context.Context, s *imageCopyState,
+	source *googleComputeClient.Image) (*googleComputeClient.Image, error) {
+	image := &googleComputeClient.Image{
+		Name:        s.Name.ValueString(),
+		SourceImage: source.SelfLink,
+		Family:      source.Family,
+		Description: source.Description,
+		Labels:      source.Labels,
+	}
+	if !s.Family.IsNull() {
+		image.Family = s.Family.ValueString()
+	}
+	if !s.Description.IsNull() {
+		image.Description = s.Description.ValueString()
+	}
+	if !s.Labels.IsNull() {
+		labels := map[string]string{}
+		if diags := s.Labels.ElementsAs(ctx, &labels, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to read labels")
+		}
+		if image.Labels == nil {
+			image.Labels = map[string]string{}
+		}
+		for key, value := range labels {
+			image.Labels[key] = value
+		}
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		if image.Labels == nil {
+			image.Labels = map[string]string{}
+		}
+		image.Labels[changeReferenceKey] = label
+	}
+	if !s.KmsKeyName.IsNull() {
+		image.ImageEncryptionKey = &googleComputeClient.CustomerEncryptionKey{
+			KmsKeyName: s.KmsKeyName.ValueString(),
+		}
+	}
+	return image, nil
+}
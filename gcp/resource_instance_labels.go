@@ -0,0 +1,353 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// instanceLabelsResource Present st-gcp_instance_labels resource
+type instanceLabelsResource struct {
+	client *gcpClients
+}
+
+type instanceLabelsState struct {
+	ClientConfig *clientConfig `tfsdk:"client_config"`
+	ID           types.String  `tfsdk:"id"`
+	Instances    types.List    `tfsdk:"instances"`
+	Labels       types.Map     `tfsdk:"labels"`
+}
+
+// NewInstanceLabelsResource
+func NewInstanceLabelsResource() resource.Resource {
+	return &instanceLabelsResource{}
+}
+
+// Metadata
+func (r *instanceLabelsResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_labels"
+}
+
+// Schema
+func (r *instanceLabelsResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Set a subset of the labels of the existing compute instances, e.g. " +
+			"the instances created by the managed instance groups or other tooling. Only the " +
+			"declared labels are set and removed, the other labels of instances are kept and " +
+			"the changes are set with the label fingerprint of each instance. The instances " +
+			"deleted by others are skipped.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Project of instances.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instances": schema.ListAttribute{
+				Description: "Self links of the instances to set labels to, e.g. " +
+					"projects/my-project/zones/asia-east1-a/instances/my-instance.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of instances managed by resource.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *instanceLabelsResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *instanceLabelsResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state instanceLabelsState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	instances := []string{}
+	labels := map[string]string{}
+	resp.Diagnostics.Append(state.Instances.ElementsAs(ctx, &instances, false)...)
+	resp.Diagnostics.Append(state.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, instance := range instances {
+		if err := r.updateLabels(ctx, instance, labels, nil); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to set instance labels",
+				fmt.Sprintf("%s: %v", instance, err))
+			return
+		}
+	}
+
+	state.ID = types.StringValue(r.client.project)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *instanceLabelsResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state instanceLabelsState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	instances := []string{}
+	labels := map[string]string{}
+	resp.Diagnostics.Append(state.Instances.ElementsAs(ctx, &instances, false)...)
+	resp.Diagnostics.Append(state.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	computeClient := r.client.computeClient
+
+	// The label changed or removed by others on any instance is dropped, so
+	// it is set again on every instance.
+	for _, selfLink := range instances {
+		project, zone, name, err := parseInstanceSelfLink(selfLink)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid instance self link", err.Error())
+			return
+		}
+		instance, err := computeClient.Instances.Get(project, zone, name).Context(ctx).Do()
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			resp.Diagnostics.AddError("[API ERROR] Failed to get instance", err.Error())
+			return
+		}
+		for key, value := range labels {
+			if instanceValue, ok := instance.Labels[key]; !ok || instanceValue != value {
+				delete(labels, key)
+			}
+		}
+	}
+
+	elements := map[string]attr.Value{}
+	for key, value := range labels {
+		elements[key] = types.StringValue(value)
+	}
+	state.Labels = types.MapValueMust(types.StringType, elements)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *instanceLabelsResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state instanceLabelsState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	instances, stateInstances := []string{}, []string{}
+	labels := map[string]string{}
+	resp.Diagnostics.Append(plan.Instances.ElementsAs(ctx, &instances, false)...)
+	resp.Diagnostics.Append(state.Instances.ElementsAs(ctx, &stateInstances, false)...)
+	resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateKeys, removedKeys := []string{}, []string{}
+	for key := range state.Labels.Elements() {
+		stateKeys = append(stateKeys, key)
+		if _, ok := labels[key]; !ok {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+
+	// The labels are removed from the instances no longer managed.
+	for _, instance := range stateInstances {
+		if containsString(instances, instance) {
+			continue
+		}
+		if err := r.updateLabels(ctx, instance, nil, stateKeys); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to set instance labels",
+				fmt.Sprintf("%s: %v", instance, err))
+			return
+		}
+	}
+	for _, instance := range instances {
+		if err := r.updateLabels(ctx, instance, labels, removedKeys); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to set instance labels",
+				fmt.Sprintf("%s: %v", instance, err))
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *instanceLabelsResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state instanceLabelsState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	instances := []string{}
+	resp.Diagnostics.Append(state.Instances.ElementsAs(ctx, &instances, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	removedKeys := []string{}
+	for key := range state.Labels.Elements() {
+		removedKeys = append(removedKeys, key)
+	}
+
+	for _, instance := range instances {
+		if err := r.updateLabels(ctx, instance, nil, removedKeys); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to set instance labels",
+				fmt.Sprintf("%s: %v", instance, err))
+		}
+	}
+}
+
+// updateLabels Set the labels and remove the label keys of instance, retried
+// with the new label fingerprint when the labels are modified concurrently.
+// The instance not found is skipped.
+func (r *instanceLabelsResource) updateLabels(ctx context.Context, selfLink string,
+	labels map[string]string, removedKeys []string) error {
+	project, zone, name, err := parseInstanceSelfLink(selfLink)
+	if err != nil {
+		return err
+	}
+
+	computeClient := r.client.computeClient
+
+	updateFunc := func() error {
+		instance, err := computeClient.Instances.Get(project, zone, name).Context(ctx).Do()
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil
+			}
+			return &backoff.PermanentError{Err: err}
+		}
+
+		if instance.Labels == nil {
+			instance.Labels = map[string]string{}
+		}
+		for _, key := range removedKeys {
+			delete(instance.Labels, key)
+		}
+		for key, value := range labels {
+			instance.Labels[key] = value
+		}
+		if label := r.client.changeReferenceLabel(); label != "" {
+			instance.Labels[changeReferenceKey] = label
+		}
+
+		op, err := computeClient.Instances.SetLabels(project, zone, name, &googleComputeClient.InstancesSetLabelsRequest{
+			Labels:           instance.Labels,
+			LabelFingerprint: instance.LabelFingerprint,
+			// Removing the last label sends an empty map.
+			ForceSendFields: []string{"Labels"},
+		}).Context(ctx).Do()
+		if err != nil {
+			// The labels are modified by others, retry with the new fingerprint.
+			if isPreconditionFailedError(err) {
+				return err
+			}
+			if isNotFoundError(err) {
+				return nil
+			}
+			return &backoff.PermanentError{Err: err}
+		}
+		if err := waitComputeOperation(ctx, computeClient, project, op); err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		return nil
+	}
+	return backoff.Retry(updateFunc, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), maxRetryTimes))
+}
+
+// parseInstanceSelfLink returns the project, the zone and the name of the
+// instance self link, which is either the full URL or the relative one in
+// the format projects/<project>/zones/<zone>/instances/<name>.
+func parseInstanceSelfLink(selfLink string) (string, string, string, error) {
+	parts := strings.Split(selfLink, "/")
+	for i := 0; i+5 < len(parts); i++ {
+		if parts[i] == "projects" && parts[i+2] == "zones" && parts[i+4] == "instances" {
+			return parts[i+1], parts[i+3], parts[i+5], nil
+		}
+	}
+	return "", "", "", fmt.Errorf("%s is not in the format projects/<project>/zones/<zone>/instances/<name>",
+		selfLink)
+}
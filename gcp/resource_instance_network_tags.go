@@ -0,0 +1,299 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// instanceNetworkTagsResource Present st-gcp_instance_network_tags resource
+type instanceNetworkTagsResource struct {
+	client *gcpClients
+}
+
+type instanceNetworkTagsState struct {
+	ClientConfig *clientConfig `tfsdk:"client_config"`
+	ID           types.String  `tfsdk:"id"`
+	Instance     types.String  `tfsdk:"instance"`
+	Zone         types.String  `tfsdk:"zone"`
+	Tags         types.List    `tfsdk:"tags"`
+}
+
+// NewInstanceNetworkTagsResource
+func NewInstanceNetworkTagsResource() resource.Resource {
+	return &instanceNetworkTagsResource{}
+}
+
+// Metadata
+func (r *instanceNetworkTagsResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_network_tags"
+}
+
+// Schema
+func (r *instanceNetworkTagsResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Add network tags to an existing compute instance managed elsewhere. " +
+			"Only the declared tags are added and removed, the other tags of instance are " +
+			"kept and the changes are set with the fingerprint of tags, so the tags targeted " +
+			"by the firewall rules can be managed apart from the instance definition.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Self link of instance.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance": schema.StringAttribute{
+				Description: "Name of the instance to add tags to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of instance.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.ListAttribute{
+				Description: "Network tags of instance managed by resource.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *instanceNetworkTagsResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *instanceNetworkTagsResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state instanceNetworkTagsState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	tags := []string{}
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selfLink, err := r.updateTags(ctx, &state, tags, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to set instance network tags", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(selfLink)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *instanceNetworkTagsResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state instanceNetworkTagsState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	computeClient := r.client.computeClient
+
+	instance, err := computeClient.Instances.Get(r.client.project, state.Zone.ValueString(),
+		state.Instance.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get instance", err.Error())
+		return
+	}
+
+	// Only the managed tags are refreshed, the tags removed by others are
+	// added again.
+	instanceTags := []string{}
+	if instance.Tags != nil {
+		instanceTags = instance.Tags.Items
+	}
+	tags := []attr.Value{}
+	for _, tag := range state.Tags.Elements() {
+		if containsString(instanceTags, tag.(types.String).ValueString()) {
+			tags = append(tags, tag)
+		}
+	}
+	state.Tags = types.ListValueMust(types.StringType, tags)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *instanceNetworkTagsResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state instanceNetworkTagsState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	tags, stateTags := []string{}, []string{}
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &stateTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	removedTags := []string{}
+	for _, tag := range stateTags {
+		if !containsString(tags, tag) {
+			removedTags = append(removedTags, tag)
+		}
+	}
+
+	if _, err := r.updateTags(ctx, &plan, tags, removedTags); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to set instance network tags", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *instanceNetworkTagsResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state instanceNetworkTagsState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	removedTags := []string{}
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &removedTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.updateTags(ctx, &state, nil, removedTags); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to set instance network tags", err.Error())
+	}
+}
+
+// updateTags Add and remove the network tags of instance, retried with the
+// new fingerprint when the tags are modified concurrently. It returns the
+// self link of instance.
+func (r *instanceNetworkTagsResource) updateTags(ctx context.Context, s *instanceNetworkTagsState,
+	addedTags, removedTags []string) (string, error) {
+	computeClient := r.client.computeClient
+
+	project := r.client.project
+	zone := s.Zone.ValueString()
+	name := s.Instance.ValueString()
+	var selfLink string
+	updateFunc := func() error {
+		instance, err := computeClient.Instances.Get(project, zone, name).Context(ctx).Do()
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		selfLink = instance.SelfLink
+
+		tags := &googleComputeClient.Tags{}
+		if instance.Tags != nil {
+			tags.Fingerprint = instance.Tags.Fingerprint
+			for _, tag := range instance.Tags.Items {
+				if !containsString(removedTags, tag) {
+					tags.Items = append(tags.Items, tag)
+				}
+			}
+		}
+		for _, tag := range addedTags {
+			if !containsString(tags.Items, tag) {
+				tags.Items = append(tags.Items, tag)
+			}
+		}
+		// Removing the last tag sends an empty list.
+		tags.ForceSendFields = []string{"Items"}
+
+		op, err := computeClient.Instances.SetTags(project, zone, name, tags).Context(ctx).Do()
+		if err != nil {
+			// The tags are modified by others, retry with the new fingerprint.
+			if isPreconditionFailedError(err) {
+				return err
+			}
+			return &backoff.PermanentError{Err: err}
+		}
+		if err := waitComputeOperation(ctx, computeClient, project, op); err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		return nil
+	}
+	err := backoff.Retry(updateFunc, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), maxRetryTimes))
+	return selfLink, err
+}
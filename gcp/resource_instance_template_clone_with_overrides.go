@@ -0,0 +1,354 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// instanceTemplateCloneWithOverridesResource Present
+// st-gcp_instance_template_clone_with_overrides resource
+type instanceTemplateCloneWithOverridesResource struct {
+	client *gcpClients
+}
+
+type instanceTemplateCloneWithOverridesState struct {
+	ClientConfig           *clientConfig `tfsdk:"client_config"`
+	ID                     types.String  `tfsdk:"id"`
+	Name                   types.String  `tfsdk:"name"`
+	Region                 types.String  `tfsdk:"region"`
+	SourceTemplate         types.String  `tfsdk:"source_template"`
+	Description            types.String  `tfsdk:"description"`
+	Image                  types.String  `tfsdk:"image"`
+	MachineType            types.String  `tfsdk:"machine_type"`
+	Labels                 types.Map     `tfsdk:"labels"`
+	SourceTemplateSelfLink types.String  `tfsdk:"source_template_self_link"`
+	SelfLink               types.String  `tfsdk:"self_link"`
+}
+
+// NewInstanceTemplateCloneWithOverridesResource
+func NewInstanceTemplateCloneWithOverridesResource() resource.Resource {
+	return &instanceTemplateCloneWithOverridesResource{}
+}
+
+// Metadata
+func (r *instanceTemplateCloneWithOverridesResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_template_clone_with_overrides"
+}
+
+// Schema
+func (r *instanceTemplateCloneWithOverridesResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Clone an existing instance template into a new instance template with " +
+			"the image, the machine type and the labels overridden. Instance templates are " +
+			"immutable, so changing any argument creates a new instance template.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of instance template.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the new instance template.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of the source and the new instance templates, the instance " +
+					"templates are global if not set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_template": schema.StringAttribute{
+				Description: "Name or self link of the instance template to be cloned.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the new instance template, the description of the " +
+					"source instance template is used if not set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image": schema.StringAttribute{
+				Description: "Source image of the boot disk overriding the source instance " +
+					"template, e.g. projects/my-project/global/images/web-20240101.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"machine_type": schema.StringAttribute{
+				Description: "Machine type overriding the source instance template, e.g. e2-medium.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of the instances merged into the labels of the source " +
+					"instance template.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_template_self_link": schema.StringAttribute{
+				Description: "Self link of the instance template cloned.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"self_link": schema.StringAttribute{
+				Description: "Self link of the new instance template.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *instanceTemplateCloneWithOverridesResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *instanceTemplateCloneWithOverridesResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state instanceTemplateCloneWithOverridesState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	source, err := r.getInstanceTemplate(ctx, state.Region.ValueString(),
+		resourceNameFromSelfLink(state.SourceTemplate.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get source instance template", err.Error())
+		return
+	}
+
+	template, err := r.newInstanceTemplateClone(ctx, &state, source)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to override source instance template", err.Error())
+		return
+	}
+
+	var op *googleComputeClient.Operation
+	if region := state.Region.ValueString(); region != "" {
+		op, err = r.client.computeClient.RegionInstanceTemplates.Insert(r.client.project, region,
+			template).Context(ctx).Do()
+	} else {
+		op, err = r.client.computeClient.InstanceTemplates.Insert(r.client.project, template).Context(ctx).Do()
+	}
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create instance template", err.Error())
+		return
+	}
+
+	created, err := r.getInstanceTemplate(ctx, state.Region.ValueString(), template.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get instance template", err.Error())
+		return
+	}
+	state.ID = types.StringValue(created.Name)
+	state.SourceTemplateSelfLink = types.StringValue(source.SelfLink)
+	state.SelfLink = types.StringValue(created.SelfLink)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *instanceTemplateCloneWithOverridesResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state instanceTemplateCloneWithOverridesState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	template, err := r.getInstanceTemplate(ctx, state.Region.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get instance template", err.Error())
+		return
+	}
+	state.SelfLink = types.StringValue(template.SelfLink)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *instanceTemplateCloneWithOverridesResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the arguments require replacement since instance templates are
+	// immutable, so there is nothing to update.
+	var state instanceTemplateCloneWithOverridesState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete
+func (r *instanceTemplateCloneWithOverridesResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state instanceTemplateCloneWithOverridesState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	var op *googleComputeClient.Operation
+	var err error
+	if region := state.Region.ValueString(); region != "" {
+		op, err = r.client.computeClient.RegionInstanceTemplates.Delete(r.client.project, region,
+			state.ID.ValueString()).Context(ctx).Do()
+	} else {
+		op, err = r.client.computeClient.InstanceTemplates.Delete(r.client.project,
+			state.ID.ValueString()).Context(ctx).Do()
+	}
+	if err == nil {
+		err = waitComputeOperation(ctx, r.client.computeClient, r.client.project, op)
+	}
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete instance template", err.Error())
+	}
+}
+
+// getInstanceTemplate gets the global instance template, or the regional
+// instance template if region is set.
+func (r *instanceTemplateCloneWithOverridesResource) getInstanceTemplate(ctx context.Context,
+	region, name string) (*googleComputeClient.InstanceTemplate, error) {
+	if region != "" {
+		return r.client.computeClient.RegionInstanceTemplates.Get(r.client.project, region, name).
+			Context(ctx).Do()
+	}
+	return r.client.computeClient.InstanceTemplates.Get(r.client.project, name).Context(ctx).Do()
+}
+
+// newInstanceTemplateClone clones the source instance template with the
+// overrides in state.
+func (r *instanceTemplateCloneWithOverridesResource) newInstanceTemplateClone(ctx context.Context,
+	s *instanceTemplateCloneWithOverridesState,
+	source *googleComputeClient.InstanceTemplate) (*googleComputeClient.InstanceTemplate, error) {
+	properties := source.Properties
+	if properties == nil {
+		properties = &googleComputeClient.InstanceProperties{}
+	}
+	template := &googleComputeClient.InstanceTemplate{
+		Name:        s.Name.ValueString(),
+		Description: source.Description,
+		Properties:  properties,
+	}
+	if !s.Description.IsNull() {
+		template.Description = s.Description.ValueString()
+	}
+
+	if !s.MachineType.IsNull() {
+		properties.MachineType = s.MachineType.ValueString()
+	}
+	if !s.Image.IsNull() {
+		overridden := false
+		for _, disk := range properties.Disks {
+			if disk.Boot && disk.InitializeParams != nil {
+				disk.InitializeParams.SourceImage = s.Image.ValueString()
+				overridden = true
+			}
+		}
+		if !overridden {
+			return nil, fmt.Errorf("source instance template %s has no boot disk created from image",
+				source.Name)
+		}
+	}
+	if !s.Labels.IsNull() {
+		labels := map[string]string{}
+		if diags := s.Labels.ElementsAs(ctx, &labels, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to read labels")
+		}
+		if properties.Labels == nil {
+			properties.Labels = map[string]string{}
+		}
+		for key, value := range labels {
+			properties.Labels[key] = value
+		}
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		if properties.Labels == nil {
+			properties.Labels = map[string]string{}
+		}
+		properties.Labels[changeReferenceKey] = label
+	}
+	return template, nil
+}
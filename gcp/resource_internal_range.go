@@ -0,0 +1,397 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleNetworkConnectivityClient "google.golang.org/api/networkconnectivity/v1"
+)
+
+const (
+	defaultInternalRangeUsage   = "FOR_VPC"
+	defaultInternalRangePeering = "FOR_SELF"
+)
+
+// internalRangeResource Present st-gcp_internal_range resource
+type internalRangeResource struct {
+	client *gcpClients
+}
+
+type internalRangeState struct {
+	ClientConfig     *clientConfig `tfsdk:"client_config"`
+	ID               types.String  `tfsdk:"id"`
+	Name             types.String  `tfsdk:"name"`
+	Description      types.String  `tfsdk:"description"`
+	Network          types.String  `tfsdk:"network"`
+	IPCidrRange      types.String  `tfsdk:"ip_cidr_range"`
+	PrefixLength     types.Int64   `tfsdk:"prefix_length"`
+	TargetCidrRanges types.List    `tfsdk:"target_cidr_ranges"`
+	Usage            types.String  `tfsdk:"usage"`
+	Peering          types.String  `tfsdk:"peering"`
+	Overlaps         types.List    `tfsdk:"overlaps"`
+	Labels           types.Map     `tfsdk:"labels"`
+	Users            types.List    `tfsdk:"users"`
+}
+
+// NewInternalRangeResource
+func NewInternalRangeResource() resource.Resource {
+	return &internalRangeResource{}
+}
+
+// Metadata
+func (r *internalRangeResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_range"
+}
+
+// Schema
+func (r *internalRangeResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a Network Connectivity Center internal range reserving an IP " +
+			"address space of a network. Either ip_cidr_range or prefix_length must be set, " +
+			"a free range of prefix_length is allocated if ip_cidr_range is not set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource name of internal range.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of internal range.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of internal range.",
+				Optional:    true,
+			},
+			"network": schema.StringAttribute{
+				Description: "Self link of the network of internal range.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_cidr_range": schema.StringAttribute{
+				Description: "IP CIDR range of internal range.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prefix_length": schema.Int64Attribute{
+				Description: "Prefix length of the range to be allocated.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"target_cidr_ranges": schema.ListAttribute{
+				Description: "IP CIDR ranges to allocate the range of prefix_length from.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"usage": schema.StringAttribute{
+				Description: "Usage of internal range, FOR_VPC or EXTERNAL_TO_VPC. Default to " +
+					defaultInternalRangeUsage + ".",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peering": schema.StringAttribute{
+				Description: "Peering type of internal range, FOR_SELF, FOR_PEER or " +
+					"NOT_SHARED. Default to " + defaultInternalRangePeering + ".",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"overlaps": schema.ListAttribute{
+				Description: "Types of the resources allowed to overlap with internal range, " +
+					"e.g. OVERLAP_ROUTE_RANGE or OVERLAP_EXISTING_SUBNET_RANGE.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of internal range.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"users": schema.ListAttribute{
+				Description: "Resources using internal range.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *internalRangeResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *internalRangeResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state internalRangeState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+	if state.IPCidrRange.IsUnknown() && state.PrefixLength.IsUnknown() {
+		resp.Diagnostics.AddError("ip_cidr_range or prefix_length is required",
+			"Either ip_cidr_range or prefix_length must be set.")
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	internalRange, diags := r.newInternalRange(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parent := networkConnectivityParent(r.client.project, globalScope)
+	op, err := networkConnectivityClient.Projects.Locations.InternalRanges.Create(parent, internalRange).
+		InternalRangeId(state.Name.ValueString()).Context(ctx).Do()
+	if err == nil {
+		err = waitNetworkConnectivityOperation(ctx, networkConnectivityClient, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create internal range", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(parent + "/internalRanges/" + state.Name.ValueString())
+	if err := refreshInternalRange(ctx, networkConnectivityClient, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get internal range", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *internalRangeResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state internalRangeState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	if err := refreshInternalRange(ctx, networkConnectivityClient, &state); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get internal range", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *internalRangeResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state internalRangeState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	internalRange, diags := r.newInternalRange(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	op, err := networkConnectivityClient.Projects.Locations.InternalRanges.
+		Patch(state.ID.ValueString(), internalRange).
+		UpdateMask("description,overlaps,labels").Context(ctx).Do()
+	if err == nil {
+		err = waitNetworkConnectivityOperation(ctx, networkConnectivityClient, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update internal range", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	if err := refreshInternalRange(ctx, networkConnectivityClient, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get internal range", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *internalRangeResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state internalRangeState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	op, err := networkConnectivityClient.Projects.Locations.InternalRanges.Delete(state.ID.ValueString()).
+		Context(ctx).Do()
+	if err == nil {
+		err = waitNetworkConnectivityOperation(ctx, networkConnectivityClient, op)
+	}
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete internal range", err.Error())
+	}
+}
+
+// newInternalRange converts the state into the internal range.
+func (r *internalRangeResource) newInternalRange(ctx context.Context,
+	s *internalRangeState) (*googleNetworkConnectivityClient.InternalRange, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	targetCidrRanges := []string{}
+	diags.Append(s.TargetCidrRanges.ElementsAs(ctx, &targetCidrRanges, false)...)
+	overlaps := []string{}
+	diags.Append(s.Overlaps.ElementsAs(ctx, &overlaps, false)...)
+	labels := map[string]string{}
+	diags.Append(s.Labels.ElementsAs(ctx, &labels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		labels[changeReferenceKey] = label
+	}
+
+	usage := defaultInternalRangeUsage
+	if s.Usage.ValueString() != "" {
+		usage = s.Usage.ValueString()
+	}
+	peering := defaultInternalRangePeering
+	if s.Peering.ValueString() != "" {
+		peering = s.Peering.ValueString()
+	}
+
+	return &googleNetworkConnectivityClient.InternalRange{
+		Description:     s.Description.ValueString(),
+		Network:         s.Network.ValueString(),
+		IpCidrRange:     s.IPCidrRange.ValueString(),
+		PrefixLength:    s.PrefixLength.ValueInt64(),
+		TargetCidrRange: targetCidrRanges,
+		Usage:           usage,
+		Peering:         peering,
+		Overlaps:        overlaps,
+		Labels:          labels,
+	}, nil
+}
+
+// refreshInternalRange gets the internal range and updates the allocated
+// range and the users in state.
+func refreshInternalRange(ctx context.Context, client *googleNetworkConnectivityClient.Service,
+	s *internalRangeState) error {
+	internalRange, err := client.Projects.Locations.InternalRanges.Get(s.ID.ValueString()).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	users := []attr.Value{}
+	for _, user := range internalRange.Users {
+		users = append(users, types.StringValue(user))
+	}
+	s.IPCidrRange = types.StringValue(internalRange.IpCidrRange)
+	s.PrefixLength = types.Int64Value(internalRange.PrefixLength)
+	s.Users = types.ListValueMust(types.StringType, users)
+	if !s.Description.IsNull() || internalRange.Description != "" {
+		s.Description = types.StringValue(internalRange.Description)
+	}
+	return nil
+}
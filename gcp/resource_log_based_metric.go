@@ -0,0 +1,437 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleLoggingClient "google.golang.org/api/logging/v2"
+)
+
+const (
+	defaultLogMetricKind      = "DELTA"
+	defaultLogMetricValueType = "INT64"
+	logMetricTypePrefix       = "logging.googleapis.com/user/"
+)
+
+// logBasedMetricResource Present st-gcp_log_based_metric resource
+type logBasedMetricResource struct {
+	client *gcpClients
+}
+
+type logBasedMetricState struct {
+	ClientConfig       *clientConfig                `tfsdk:"client_config"`
+	ID                 types.String                 `tfsdk:"id"`
+	Name               types.String                 `tfsdk:"name"`
+	Description        types.String                 `tfsdk:"description"`
+	Filter             types.String                 `tfsdk:"filter"`
+	Disabled           types.Bool                   `tfsdk:"disabled"`
+	MetricKind         types.String                 `tfsdk:"metric_kind"`
+	ValueType          types.String                 `tfsdk:"value_type"`
+	Unit               types.String                 `tfsdk:"unit"`
+	ValueExtractor     types.String                 `tfsdk:"value_extractor"`
+	LabelExtractors    types.Map                    `tfsdk:"label_extractors"`
+	LinearBuckets      *logMetricLinearBuckets      `tfsdk:"linear_buckets"`
+	ExponentialBuckets *logMetricExponentialBuckets `tfsdk:"exponential_buckets"`
+	ExplicitBuckets    types.List                   `tfsdk:"explicit_buckets"`
+	MetricType         types.String                 `tfsdk:"metric_type"`
+}
+
+type logMetricLinearBuckets struct {
+	NumFiniteBuckets types.Int64   `tfsdk:"num_finite_buckets"`
+	Width            types.Float64 `tfsdk:"width"`
+	Offset           types.Float64 `tfsdk:"offset"`
+}
+
+type logMetricExponentialBuckets struct {
+	NumFiniteBuckets types.Int64   `tfsdk:"num_finite_buckets"`
+	GrowthFactor     types.Float64 `tfsdk:"growth_factor"`
+	Scale            types.Float64 `tfsdk:"scale"`
+}
+
+// NewLogBasedMetricResource
+func NewLogBasedMetricResource() resource.Resource {
+	return &logBasedMetricResource{}
+}
+
+// Metadata
+func (r *logBasedMetricResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_based_metric"
+}
+
+// Schema
+func (r *logBasedMetricResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a log-based metric of Cloud Logging, e.g. the 5xx rate of " +
+			"the load balancers consumed by alerting. Labels are extracted as STRING " +
+			"labels. Only one of linear_buckets, exponential_buckets and " +
+			"explicit_buckets can be set for DISTRIBUTION metric.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource name of log-based metric.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of log-based metric, e.g. lb_5xx_count.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of log-based metric.",
+				Optional:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "Logging filter of the log entries counted, e.g. " +
+					`resource.type="http_load_balancer" AND httpRequest.status>=500.`,
+				Required: true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Whether the log-based metric is disabled. Default to false.",
+				Optional:    true,
+			},
+			"metric_kind": schema.StringAttribute{
+				Description: "Metric kind of log-based metric. Only DELTA is supported. " +
+					"Default to " + defaultLogMetricKind + ".",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value_type": schema.StringAttribute{
+				Description: "Value type of log-based metric, INT64 for counter metric or " +
+					"DISTRIBUTION for distribution metric. Default to " + defaultLogMetricValueType + ".",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"unit": schema.StringAttribute{
+				Description: "Unit of the metric values, e.g. ms.",
+				Optional:    true,
+			},
+			"value_extractor": schema.StringAttribute{
+				Description: "Extractor of the values of DISTRIBUTION metric, e.g. " +
+					`EXTRACT(httpRequest.latency).`,
+				Optional: true,
+			},
+			"label_extractors": schema.MapAttribute{
+				Description: "Map of label keys to the extractors of the label values, e.g. " +
+					`{ backend_service = "EXTRACT(resource.labels.backend_service_name)" }.`,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"linear_buckets": schema.SingleNestedAttribute{
+				Description: "Linear buckets of DISTRIBUTION metric.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"num_finite_buckets": schema.Int64Attribute{
+						Description: "Number of finite buckets.",
+						Required:    true,
+					},
+					"width": schema.Float64Attribute{
+						Description: "Width of each bucket.",
+						Required:    true,
+					},
+					"offset": schema.Float64Attribute{
+						Description: "Lower bound of the first bucket.",
+						Required:    true,
+					},
+				},
+			},
+			"exponential_buckets": schema.SingleNestedAttribute{
+				Description: "Exponential buckets of DISTRIBUTION metric.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"num_finite_buckets": schema.Int64Attribute{
+						Description: "Number of finite buckets.",
+						Required:    true,
+					},
+					"growth_factor": schema.Float64Attribute{
+						Description: "Growth factor of the bucket bounds, must be greater than 1.",
+						Required:    true,
+					},
+					"scale": schema.Float64Attribute{
+						Description: "Upper bound of the first bucket.",
+						Required:    true,
+					},
+				},
+			},
+			"explicit_buckets": schema.ListAttribute{
+				Description: "Explicit bucket bounds of DISTRIBUTION metric in ascending order.",
+				ElementType: types.Float64Type,
+				Optional:    true,
+			},
+			"metric_type": schema.StringAttribute{
+				Description: "Type of the metric in Cloud Monitoring, e.g. " +
+					logMetricTypePrefix + "lb_5xx_count.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *logBasedMetricResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *logBasedMetricResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state logBasedMetricState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	loggingClient, err := googleLoggingClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Logging client", err.Error())
+		return
+	}
+
+	metric, d := newLogMetric(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, err = loggingClient.Projects.Metrics.Create("projects/"+r.client.project, metric).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create log-based metric", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(logMetricResourceName(r.client.project, state.Name.ValueString()))
+	state.MetricType = types.StringValue(logMetricTypePrefix + state.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *logBasedMetricResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state logBasedMetricState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	loggingClient, err := googleLoggingClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Logging client", err.Error())
+		return
+	}
+
+	metric, err := loggingClient.Projects.Metrics.Get(state.ID.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get log-based metric", err.Error())
+		return
+	}
+
+	state.Filter = types.StringValue(metric.Filter)
+	if !state.Description.IsNull() || metric.Description != "" {
+		state.Description = types.StringValue(metric.Description)
+	}
+	if !state.Disabled.IsNull() || metric.Disabled {
+		state.Disabled = types.BoolValue(metric.Disabled)
+	}
+	if !state.ValueExtractor.IsNull() || metric.ValueExtractor != "" {
+		state.ValueExtractor = types.StringValue(metric.ValueExtractor)
+	}
+	if !state.LabelExtractors.IsNull() || len(metric.LabelExtractors) > 0 {
+		state.LabelExtractors, d = types.MapValueFrom(ctx, types.StringType, metric.LabelExtractors)
+		resp.Diagnostics.Append(d...)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *logBasedMetricResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state logBasedMetricState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	loggingClient, err := googleLoggingClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Logging client", err.Error())
+		return
+	}
+
+	metric, d := newLogMetric(ctx, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := loggingClient.Projects.Metrics.Update(state.ID.ValueString(), metric).Context(ctx).Do(); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update log-based metric", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.MetricType = state.MetricType
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *logBasedMetricResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state logBasedMetricState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	loggingClient, err := googleLoggingClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Logging client", err.Error())
+		return
+	}
+
+	_, err = loggingClient.Projects.Metrics.Delete(state.ID.ValueString()).Context(ctx).Do()
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete log-based metric", err.Error())
+	}
+}
+
+// logMetricResourceName returns the resource name of the log-based metric.
+func logMetricResourceName(project, name string) string {
+	return fmt.Sprintf("projects/%s/metrics/%s", project, name)
+}
+
+// newLogMetric converts the state into the log-based metric.
+func newLogMetric(ctx context.Context, s *logBasedMetricState) (*googleLoggingClient.LogMetric, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	metricKind := defaultLogMetricKind
+	if s.MetricKind.ValueString() != "" {
+		metricKind = s.MetricKind.ValueString()
+	}
+	valueType := defaultLogMetricValueType
+	if s.ValueType.ValueString() != "" {
+		valueType = s.ValueType.ValueString()
+	}
+
+	labelExtractors := map[string]string{}
+	diags.Append(s.LabelExtractors.ElementsAs(ctx, &labelExtractors, false)...)
+	labels := []*googleLoggingClient.LabelDescriptor{}
+	for key := range labelExtractors {
+		labels = append(labels, &googleLoggingClient.LabelDescriptor{
+			Key:       key,
+			ValueType: "STRING",
+		})
+	}
+
+	metric := &googleLoggingClient.LogMetric{
+		Name:        s.Name.ValueString(),
+		Description: s.Description.ValueString(),
+		Filter:      s.Filter.ValueString(),
+		Disabled:    s.Disabled.ValueBool(),
+		MetricDescriptor: &googleLoggingClient.MetricDescriptor{
+			MetricKind: metricKind,
+			ValueType:  valueType,
+			Unit:       s.Unit.ValueString(),
+			Labels:     labels,
+		},
+		LabelExtractors: labelExtractors,
+		ValueExtractor:  s.ValueExtractor.ValueString(),
+	}
+
+	switch {
+	case s.LinearBuckets != nil:
+		metric.BucketOptions = &googleLoggingClient.BucketOptions{
+			LinearBuckets: &googleLoggingClient.Linear{
+				NumFiniteBuckets: s.LinearBuckets.NumFiniteBuckets.ValueInt64(),
+				Width:            s.LinearBuckets.Width.ValueFloat64(),
+				Offset:           s.LinearBuckets.Offset.ValueFloat64(),
+				ForceSendFields:  []string{"Offset"},
+			},
+		}
+	case s.ExponentialBuckets != nil:
+		metric.BucketOptions = &googleLoggingClient.BucketOptions{
+			ExponentialBuckets: &googleLoggingClient.Exponential{
+				NumFiniteBuckets: s.ExponentialBuckets.NumFiniteBuckets.ValueInt64(),
+				GrowthFactor:     s.ExponentialBuckets.GrowthFactor.ValueFloat64(),
+				Scale:            s.ExponentialBuckets.Scale.ValueFloat64(),
+			},
+		}
+	case !s.ExplicitBuckets.IsNull():
+		bounds := []float64{}
+		diags.Append(s.ExplicitBuckets.ElementsAs(ctx, &bounds, false)...)
+		metric.BucketOptions = &googleLoggingClient.BucketOptions{
+			ExplicitBuckets: &googleLoggingClient.Explicit{Bounds: bounds},
+		}
+	}
+	return metric, diags
+}
@@ -0,0 +1,386 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+const (
+	migRollingActionRestart            = "RESTART"
+	migRollingActionReplace            = "REPLACE"
+	migRollingActionResize             = "RESIZE"
+	defaultMigRollingActionTimeoutSecs = 1800
+)
+
+// migRollingActionResource Present st-gcp_mig_rolling_action resource
+type migRollingActionResource struct {
+	client *gcpClients
+}
+
+type migRollingActionState struct {
+	ClientConfig         *clientConfig `tfsdk:"client_config"`
+	ID                   types.String  `tfsdk:"id"`
+	InstanceGroupManager types.String  `tfsdk:"instance_group_manager"`
+	Zone                 types.String  `tfsdk:"zone"`
+	Region               types.String  `tfsdk:"region"`
+	Action               types.String  `tfsdk:"action"`
+	TargetSize           types.Int64   `tfsdk:"target_size"`
+	MaxSurge             types.Int64   `tfsdk:"max_surge"`
+	MaxUnavailable       types.Int64   `tfsdk:"max_unavailable"`
+	TimeoutSeconds       types.Int64   `tfsdk:"timeout_seconds"`
+	Triggers             types.Map     `tfsdk:"triggers"`
+	LastActionAt         types.String  `tfsdk:"last_action_at"`
+}
+
+// NewMigRollingActionResource
+func NewMigRollingActionResource() resource.Resource {
+	return &migRollingActionResource{}
+}
+
+// Metadata
+func (r *migRollingActionResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mig_rolling_action"
+}
+
+// Schema
+func (r *migRollingActionResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Run an action on an existing managed instance group on create and " +
+			"whenever triggers or the action change, i.e. a rolling restart or replace of " +
+			"all instances or a resize, and wait until the managed instance group is " +
+			"stable. Used e.g. to restart all instances after a configuration change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Self link of managed instance group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_group_manager": schema.StringAttribute{
+				Description: "Name of managed instance group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description: "Zone of zonal managed instance group. One of zone and region must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of regional managed instance group. One of zone and region must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "Action to run, " + migRollingActionRestart + " or " +
+					migRollingActionReplace + " all instances in a rolling update, or " +
+					migRollingActionResize + " the managed instance group to target_size.",
+				Required: true,
+			},
+			"target_size": schema.Int64Attribute{
+				Description: "Target size of managed instance group, required for the " +
+					migRollingActionResize + " action.",
+				Optional: true,
+			},
+			"max_surge": schema.Int64Attribute{
+				Description: "Maximum number of instances created above target size during " +
+					"the rolling update. Default to the value chosen by Google Cloud.",
+				Optional: true,
+			},
+			"max_unavailable": schema.Int64Attribute{
+				Description: "Maximum number of instances unavailable during the rolling " +
+					"update. Default to the value chosen by Google Cloud.",
+				Optional: true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Maximum seconds to wait for managed instance group to be stable. " +
+					"Default to " + strconv.Itoa(defaultMigRollingActionTimeoutSecs) + ".",
+				Optional: true,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will run the action again.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"last_action_at": schema.StringAttribute{
+				Description: "The time of the last action in RFC3339 format.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *migRollingActionResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *migRollingActionResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state migRollingActionState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	r.runAction(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *migRollingActionResource) Read(_ context.Context,
+	_ resource.ReadRequest, _ *resource.ReadResponse) {
+	// The action only runs on create and update, there is nothing to refresh.
+}
+
+// Update
+func (r *migRollingActionResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state migRollingActionState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	// Only timeout_seconds and client_config are updated without running the
+	// action.
+	if plan.Triggers.Equal(state.Triggers) && plan.Action.Equal(state.Action) &&
+		plan.TargetSize.Equal(state.TargetSize) && plan.MaxSurge.Equal(state.MaxSurge) &&
+		plan.MaxUnavailable.Equal(state.MaxUnavailable) {
+		plan.ID = state.ID
+		plan.LastActionAt = state.LastActionAt
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	r.runAction(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *migRollingActionResource) Delete(_ context.Context,
+	_ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+	// Nothing to delete, only remove from state.
+}
+
+// runAction Run the action on managed instance group and wait until it is
+// stable.
+func (r *migRollingActionResource) runAction(ctx context.Context, s *migRollingActionState,
+	diags *diag.Diagnostics) {
+	zone, region := s.Zone.ValueString(), s.Region.ValueString()
+	if (zone == "") == (region == "") {
+		diags.AddError("Invalid location", "Exactly one of zone and region must be set.")
+		return
+	}
+	action := s.Action.ValueString()
+	switch action {
+	case migRollingActionRestart, migRollingActionReplace:
+	case migRollingActionResize:
+		if s.TargetSize.IsNull() {
+			diags.AddError("target_size is required",
+				"target_size must be set for the "+migRollingActionResize+" action.")
+			return
+		}
+	default:
+		diags.AddError("Invalid action", fmt.Sprintf("action must be %s, %s or %s.",
+			migRollingActionRestart, migRollingActionReplace, migRollingActionResize))
+		return
+	}
+
+	computeClient := r.client.computeClient
+
+	project := r.client.project
+	name := s.InstanceGroupManager.ValueString()
+	getManager := func() (*googleComputeClient.InstanceGroupManager, error) {
+		if zone != "" {
+			return computeClient.InstanceGroupManagers.Get(project, zone, name).Context(ctx).Do()
+		}
+		return computeClient.RegionInstanceGroupManagers.Get(project, region, name).Context(ctx).Do()
+	}
+
+	if action == migRollingActionResize {
+		var op *googleComputeClient.Operation
+		var err error
+		if zone != "" {
+			op, err = computeClient.InstanceGroupManagers.Resize(project, zone, name,
+				s.TargetSize.ValueInt64()).Context(ctx).Do()
+		} else {
+			op, err = computeClient.RegionInstanceGroupManagers.Resize(project, region, name,
+				s.TargetSize.ValueInt64()).Context(ctx).Do()
+		}
+		if err == nil {
+			err = waitComputeOperation(ctx, computeClient, project, op)
+		}
+		if err != nil {
+			diags.AddError("[API ERROR] Failed to resize managed instance group", err.Error())
+			return
+		}
+	} else {
+		updateFunc := func() error {
+			manager, err := getManager()
+			if err != nil {
+				return &backoff.PermanentError{Err: err}
+			}
+			patch := newMigRollingActionPatch(manager, s)
+
+			var op *googleComputeClient.Operation
+			if zone != "" {
+				op, err = computeClient.InstanceGroupManagers.Patch(project, zone, name, patch).Context(ctx).Do()
+			} else {
+				op, err = computeClient.RegionInstanceGroupManagers.Patch(project, region, name, patch).Context(ctx).Do()
+			}
+			if err != nil {
+				// The managed instance group is modified by others, retry with the
+				// new fingerprint.
+				if isPreconditionFailedError(err) {
+					return err
+				}
+				return &backoff.PermanentError{Err: err}
+			}
+			if err := waitComputeOperation(ctx, computeClient, project, op); err != nil {
+				return &backoff.PermanentError{Err: err}
+			}
+			return nil
+		}
+		err := backoff.Retry(updateFunc, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), maxRetryTimes))
+		if err != nil {
+			diags.AddError("[API ERROR] Failed to start rolling update of managed instance group", err.Error())
+			return
+		}
+	}
+	s.LastActionAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	timeout := time.Duration(defaultMigRollingActionTimeoutSecs) * time.Second
+	if !s.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(s.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		manager, err := getManager()
+		if err != nil {
+			diags.AddError("[API ERROR] Failed to get managed instance group", err.Error())
+			return
+		}
+		s.ID = types.StringValue(manager.SelfLink)
+		if manager.Status != nil && manager.Status.IsStable {
+			return
+		}
+		if time.Now().After(deadline) {
+			diags.AddError("Managed instance group is not stable",
+				fmt.Sprintf("%s is not stable after %s.", name, timeout))
+			return
+		}
+		select {
+		case <-ctx.Done():
+			diags.AddError("Managed instance group is not stable", ctx.Err().Error())
+			return
+		case <-time.After(operationPollInterval):
+		}
+	}
+}
+
+// newMigRollingActionPatch returns the patch of a proactive rolling update
+// of all instances. The versions are renamed to start the update without
+// changing the instance templates, as gcloud rolling-action does.
+func newMigRollingActionPatch(manager *googleComputeClient.InstanceGroupManager,
+	s *migRollingActionState) *googleComputeClient.InstanceGroupManager {
+	action := s.Action.ValueString()
+	policy := &googleComputeClient.InstanceGroupManagerUpdatePolicy{
+		Type:                        "PROACTIVE",
+		MinimalAction:               action,
+		MostDisruptiveAllowedAction: action,
+	}
+	if !s.MaxSurge.IsNull() {
+		policy.MaxSurge = &googleComputeClient.FixedOrPercent{
+			Fixed:           s.MaxSurge.ValueInt64(),
+			ForceSendFields: []string{"Fixed"},
+		}
+	}
+	if !s.MaxUnavailable.IsNull() {
+		policy.MaxUnavailable = &googleComputeClient.FixedOrPercent{
+			Fixed:           s.MaxUnavailable.ValueInt64(),
+			ForceSendFields: []string{"Fixed"},
+		}
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	versions := []*googleComputeClient.InstanceGroupManagerVersion{}
+	for i, version := range manager.Versions {
+		versions = append(versions, &googleComputeClient.InstanceGroupManagerVersion{
+			Name:             fmt.Sprintf("%d/%s", i, now),
+			InstanceTemplate: version.InstanceTemplate,
+			TargetSize:       version.TargetSize,
+		})
+	}
+
+	return &googleComputeClient.InstanceGroupManager{
+		Fingerprint:  manager.Fingerprint,
+		UpdatePolicy: policy,
+		Versions:     versions,
+	}
+}
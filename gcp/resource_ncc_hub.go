@@ -0,0 +1,312 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleNetworkConnectivityClient "google.golang.org/api/networkconnectivity/v1"
+)
+
+// nccHubResource Present st-gcp_ncc_hub resource
+type nccHubResource struct {
+	client *gcpClients
+}
+
+type nccHubState struct {
+	ClientConfig *clientConfig `tfsdk:"client_config"`
+	ID           types.String  `tfsdk:"id"`
+	Name         types.String  `tfsdk:"name"`
+	Description  types.String  `tfsdk:"description"`
+	Labels       types.Map     `tfsdk:"labels"`
+	State        types.String  `tfsdk:"state"`
+	UniqueID     types.String  `tfsdk:"unique_id"`
+	RoutingVpcs  types.List    `tfsdk:"routing_vpcs"`
+}
+
+// NewNccHubResource
+func NewNccHubResource() resource.Resource {
+	return &nccHubResource{}
+}
+
+// Metadata
+func (r *nccHubResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ncc_hub"
+}
+
+// Schema
+func (r *nccHubResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a Network Connectivity Center hub, the center of the " +
+			"hub-and-spoke topology the st-gcp_ncc_spoke resources are attached to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource name of hub.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of hub.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of hub.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of hub.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of hub.",
+				Computed:    true,
+			},
+			"unique_id": schema.StringAttribute{
+				Description: "Unique ID of hub.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"routing_vpcs": schema.ListAttribute{
+				Description: "VPC networks connected to hub by the spokes.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *nccHubResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *nccHubResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state nccHubState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	hub, diags := r.newNccHub(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parent := networkConnectivityParent(r.client.project, globalScope)
+	op, err := networkConnectivityClient.Projects.Locations.Global.Hubs.Create(parent, hub).
+		HubId(state.Name.ValueString()).Context(ctx).Do()
+	if err == nil {
+		err = waitNetworkConnectivityOperation(ctx, networkConnectivityClient, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create hub", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(parent + "/hubs/" + state.Name.ValueString())
+	if err := refreshNccHub(ctx, networkConnectivityClient, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get hub", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *nccHubResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state nccHubState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	if err := refreshNccHub(ctx, networkConnectivityClient, &state); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get hub", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *nccHubResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state nccHubState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	hub, diags := r.newNccHub(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	op, err := networkConnectivityClient.Projects.Locations.Global.Hubs.Patch(state.ID.ValueString(), hub).
+		UpdateMask("description,labels").Context(ctx).Do()
+	if err == nil {
+		err = waitNetworkConnectivityOperation(ctx, networkConnectivityClient, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update hub", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	if err := refreshNccHub(ctx, networkConnectivityClient, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get hub", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *nccHubResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state nccHubState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	op, err := networkConnectivityClient.Projects.Locations.Global.Hubs.Delete(state.ID.ValueString()).
+		Context(ctx).Do()
+	if err == nil {
+		err = waitNetworkConnectivityOperation(ctx, networkConnectivityClient, op)
+	}
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete hub", err.Error())
+	}
+}
+
+// newNccHub converts the state into the hub.
+func (r *nccHubResource) newNccHub(ctx context.Context,
+	s *nccHubState) (*googleNetworkConnectivityClient.Hub, diag.Diagnostics) {
+	labels := map[string]string{}
+	diags := s.Labels.ElementsAs(ctx, &labels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		labels[changeReferenceKey] = label
+	}
+
+	return &googleNetworkConnectivityClient.Hub{
+		Description: s.Description.ValueString(),
+		Labels:      labels,
+	}, nil
+}
+
+// refreshNccHub gets the hub and updates the computed attributes in state.
+func refreshNccHub(ctx context.Context, client *googleNetworkConnectivityClient.Service,
+	s *nccHubState) error {
+	hub, err := client.Projects.Locations.Global.Hubs.Get(s.ID.ValueString()).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	routingVpcs := []attr.Value{}
+	for _, routingVpc := range hub.RoutingVpcs {
+		routingVpcs = append(routingVpcs, types.StringValue(routingVpc.Uri))
+	}
+	s.State = types.StringValue(hub.State)
+	s.UniqueID = types.StringValue(hub.UniqueId)
+	s.RoutingVpcs = types.ListValueMust(types.StringType, routingVpcs)
+	if !s.Description.IsNull() || hub.Description != "" {
+		s.Description = types.StringValue(hub.Description)
+	}
+	return nil
+}
@@ -0,0 +1,428 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleNetworkConnectivityClient "google.golang.org/api/networkconnectivity/v1"
+)
+
+// nccSpokeResource Present st-gcp_ncc_spoke resource
+type nccSpokeResource struct {
+	client *gcpClients
+}
+
+type nccSpokeState struct {
+	ClientConfig                  *clientConfig              `tfsdk:"client_config"`
+	ID                            types.String               `tfsdk:"id"`
+	Name                          types.String               `tfsdk:"name"`
+	Location                      types.String               `tfsdk:"location"`
+	Hub                           types.String               `tfsdk:"hub"`
+	Description                   types.String               `tfsdk:"description"`
+	Labels                        types.Map                  `tfsdk:"labels"`
+	LinkedVpnTunnels              *nccSpokeLinkedAttachments `tfsdk:"linked_vpn_tunnels"`
+	LinkedInterconnectAttachments *nccSpokeLinkedAttachments `tfsdk:"linked_interconnect_attachments"`
+	LinkedVpcNetwork              *nccSpokeLinkedVpcNetwork  `tfsdk:"linked_vpc_network"`
+	SpokeType                     types.String               `tfsdk:"spoke_type"`
+	State                         types.String               `tfsdk:"state"`
+	UniqueID                      types.String               `tfsdk:"unique_id"`
+}
+
+type nccSpokeLinkedAttachments struct {
+	Uris                   []types.String `tfsdk:"uris"`
+	SiteToSiteDataTransfer types.Bool     `tfsdk:"site_to_site_data_transfer"`
+}
+
+type nccSpokeLinkedVpcNetwork struct {
+	Uri                 types.String   `tfsdk:"uri"`
+	ExcludeExportRanges []types.String `tfsdk:"exclude_export_ranges"`
+}
+
+// NewNccSpokeResource
+func NewNccSpokeResource() resource.Resource {
+	return &nccSpokeResource{}
+}
+
+// Metadata
+func (r *nccSpokeResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ncc_spoke"
+}
+
+// Schema
+func (r *nccSpokeResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	linkedAttachmentsAttributes := func(resourceType string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"uris": schema.ListAttribute{
+				Description: fmt.Sprintf("Self links of the %s.", resourceType),
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"site_to_site_data_transfer": schema.BoolAttribute{
+				Description: "Whether site-to-site data transfer is enabled through the spoke.",
+				Optional:    true,
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage a Network Connectivity Center spoke attaching VPN tunnels, " +
+			"Interconnect attachments or a VPC network to a hub. Exactly one of " +
+			"linked_vpn_tunnels, linked_interconnect_attachments and linked_vpc_network " +
+			"must be set, VPC network spokes must be created in the global location.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource name of spoke.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of spoke.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Description: "Location of spoke, the region of the linked VPN tunnels or " +
+					"Interconnect attachments, or global for VPC network spoke.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hub": schema.StringAttribute{
+				Description: "Name or resource name of the hub the spoke is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of spoke.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of spoke.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"linked_vpn_tunnels": schema.SingleNestedAttribute{
+				Description: "VPN tunnels linked by spoke.",
+				Optional:    true,
+				Attributes:  linkedAttachmentsAttributes("VPN tunnels"),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"linked_interconnect_attachments": schema.SingleNestedAttribute{
+				Description: "Interconnect attachments linked by spoke.",
+				Optional:    true,
+				Attributes:  linkedAttachmentsAttributes("Interconnect attachments"),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"linked_vpc_network": schema.SingleNestedAttribute{
+				Description: "VPC network linked by spoke.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"uri": schema.StringAttribute{
+						Description: "Self link of the VPC network.",
+						Required:    true,
+					},
+					"exclude_export_ranges": schema.ListAttribute{
+						Description: "IP ranges of the VPC network not exported to hub.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"spoke_type": schema.StringAttribute{
+				Description: "Type of spoke, e.g. VPN_TUNNEL, INTERCONNECT_ATTACHMENT or VPC_NETWORK.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "State of spoke.",
+				Computed:    true,
+			},
+			"unique_id": schema.StringAttribute{
+				Description: "Unique ID of spoke.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *nccSpokeResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *nccSpokeResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state nccSpokeState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	spoke, err := r.newNccSpoke(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid spoke configuration", err.Error())
+		return
+	}
+	parent := networkConnectivityParent(r.client.project, state.Location.ValueString())
+	op, err := networkConnectivityClient.Projects.Locations.Spokes.Create(parent, spoke).
+		SpokeId(state.Name.ValueString()).Context(ctx).Do()
+	if err == nil {
+		err = waitNetworkConnectivityOperation(ctx, networkConnectivityClient, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create spoke", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(parent + "/spokes/" + state.Name.ValueString())
+	if err := refreshNccSpoke(ctx, networkConnectivityClient, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get spoke", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *nccSpokeResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state nccSpokeState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	if err := refreshNccSpoke(ctx, networkConnectivityClient, &state); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get spoke", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *nccSpokeResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state nccSpokeState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	spoke, err := r.newNccSpoke(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid spoke configuration", err.Error())
+		return
+	}
+	op, err := networkConnectivityClient.Projects.Locations.Spokes.Patch(state.ID.ValueString(), spoke).
+		UpdateMask("description,labels").Context(ctx).Do()
+	if err == nil {
+		err = waitNetworkConnectivityOperation(ctx, networkConnectivityClient, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update spoke", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	if err := refreshNccSpoke(ctx, networkConnectivityClient, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get spoke", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *nccSpokeResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state nccSpokeState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	networkConnectivityClient, err := googleNetworkConnectivityClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Network Connectivity client", err.Error())
+		return
+	}
+
+	op, err := networkConnectivityClient.Projects.Locations.Spokes.Delete(state.ID.ValueString()).
+		Context(ctx).Do()
+	if err == nil {
+		err = waitNetworkConnectivityOperation(ctx, networkConnectivityClient, op)
+	}
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete spoke", err.Error())
+	}
+}
+
+// newNccSpoke converts the state into the spoke.
+func (r *nccSpokeResource) newNccSpoke(ctx context.Context,
+	s *nccSpokeState) (*googleNetworkConnectivityClient.Spoke, error) {
+	labels := map[string]string{}
+	if diags := s.Labels.ElementsAs(ctx, &labels, false); diags.HasError() {
+		return nil, fmt.Errorf("failed to read labels")
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		labels[changeReferenceKey] = label
+	}
+
+	spoke := &googleNetworkConnectivityClient.Spoke{
+		Hub:         nccHubName(r.client.project, s.Hub.ValueString()),
+		Description: s.Description.ValueString(),
+		Labels:      labels,
+	}
+
+	linked := 0
+	if s.LinkedVpnTunnels != nil {
+		linked++
+		spoke.LinkedVpnTunnels = &googleNetworkConnectivityClient.LinkedVpnTunnels{
+			SiteToSiteDataTransfer: s.LinkedVpnTunnels.SiteToSiteDataTransfer.ValueBool(),
+		}
+		for _, uri := range s.LinkedVpnTunnels.Uris {
+			spoke.LinkedVpnTunnels.Uris = append(spoke.LinkedVpnTunnels.Uris, uri.ValueString())
+		}
+	}
+	if s.LinkedInterconnectAttachments != nil {
+		linked++
+		spoke.LinkedInterconnectAttachments = &googleNetworkConnectivityClient.LinkedInterconnectAttachments{
+			SiteToSiteDataTransfer: s.LinkedInterconnectAttachments.SiteToSiteDataTransfer.ValueBool(),
+		}
+		for _, uri := range s.LinkedInterconnectAttachments.Uris {
+			spoke.LinkedInterconnectAttachments.Uris = append(spoke.LinkedInterconnectAttachments.Uris, uri.ValueString())
+		}
+	}
+	if s.LinkedVpcNetwork != nil {
+		linked++
+		if s.Location.ValueString() != globalScope {
+			return nil, fmt.Errorf("location must be %s for linked_vpc_network", globalScope)
+		}
+		spoke.LinkedVpcNetwork = &googleNetworkConnectivityClient.LinkedVpcNetwork{
+			Uri: s.LinkedVpcNetwork.Uri.ValueString(),
+		}
+		for _, excludeExportRange := range s.LinkedVpcNetwork.ExcludeExportRanges {
+			spoke.LinkedVpcNetwork.ExcludeExportRanges = append(spoke.LinkedVpcNetwork.ExcludeExportRanges,
+				excludeExportRange.ValueString())
+		}
+	}
+	if linked != 1 {
+		return nil, fmt.Errorf("exactly one of linked_vpn_tunnels, linked_interconnect_attachments " +
+			"and linked_vpc_network must be set")
+	}
+	return spoke, nil
+}
+
+// refreshNccSpoke gets the spoke and updates the computed attributes in state.
+func refreshNccSpoke(ctx context.Context, client *googleNetworkConnectivityClient.Service,
+	s *nccSpokeState) error {
+	spoke, err := client.Projects.Locations.Spokes.Get(s.ID.ValueString()).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	s.SpokeType = types.StringValue(spoke.SpokeType)
+	s.State = types.StringValue(spoke.State)
+	s.UniqueID = types.StringValue(spoke.UniqueId)
+	if !s.Description.IsNull() || spoke.Description != "" {
+		s.Description = types.StringValue(spoke.Description)
+	}
+	return nil
+}
@@ -0,0 +1,301 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleResourceManagerV3Client "google.golang.org/api/cloudresourcemanager/v3"
+)
+
+// projectLabelsResource Present st-gcp_project_labels resource
+type projectLabelsResource struct {
+	client *gcpClients
+}
+
+type projectLabelsState struct {
+	ClientConfig *clientConfig `tfsdk:"client_config"`
+	ID           types.String  `tfsdk:"id"`
+	Labels       types.Map     `tfsdk:"labels"`
+}
+
+// NewProjectLabelsResource
+func NewProjectLabelsResource() resource.Resource {
+	return &projectLabelsResource{}
+}
+
+// Metadata
+func (r *projectLabelsResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_labels"
+}
+
+// Schema
+func (r *projectLabelsResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a subset of the labels of the project. Only the declared " +
+			"labels are set and removed, the labels owned by others are preserved, and the " +
+			"project is updated with its etag and retried when it is modified concurrently.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource name of the project, e.g. projects/123456789.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels of the project managed by resource.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *projectLabelsResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *projectLabelsResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state projectLabelsState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	labels := map[string]string{}
+	resp.Diagnostics.Append(state.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, err := r.updateLabels(ctx, labels, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update project labels", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *projectLabelsResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectLabelsState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	resourceManagerClient, err := googleResourceManagerV3Client.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Resource Manager client", err.Error())
+		return
+	}
+
+	project, err := resourceManagerClient.Projects.Get(state.ID.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get project", err.Error())
+		return
+	}
+
+	// Only the managed labels are refreshed, the labels removed by others are
+	// set again.
+	labels := map[string]attr.Value{}
+	for key := range state.Labels.Elements() {
+		if value, ok := project.Labels[key]; ok {
+			labels[key] = types.StringValue(value)
+		}
+	}
+	state.Labels = types.MapValueMust(types.StringType, labels)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *projectLabelsResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state projectLabelsState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	labels := map[string]string{}
+	resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+	removedKeys := []string{}
+	for key := range state.Labels.Elements() {
+		if _, ok := labels[key]; !ok {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.updateLabels(ctx, labels, removedKeys); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update project labels", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *projectLabelsResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state projectLabelsState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	removedKeys := []string{}
+	for key := range state.Labels.Elements() {
+		removedKeys = append(removedKeys, key)
+	}
+	if _, err := r.updateLabels(ctx, nil, removedKeys); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update project labels", err.Error())
+	}
+}
+
+// updateLabels Set the labels and remove the label keys of the project,
+// retried with the new etag when the project is modified concurrently. It
+// returns the resource name of the project.
+func (r *projectLabelsResource) updateLabels(ctx context.Context, labels map[string]string,
+	removedKeys []string) (string, error) {
+	resourceManagerClient, err := googleResourceManagerV3Client.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		return "", fmt.Errorf("failed to initialize Google Cloud Resource Manager client: %v", err)
+	}
+
+	var name string
+	updateFunc := func() error {
+		project, err := resourceManagerClient.Projects.Get("projects/" + r.client.project).Context(ctx).Do()
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		name = project.Name
+
+		if project.Labels == nil {
+			project.Labels = map[string]string{}
+		}
+		for _, key := range removedKeys {
+			delete(project.Labels, key)
+		}
+		for key, value := range labels {
+			project.Labels[key] = value
+		}
+
+		op, err := resourceManagerClient.Projects.Patch(project.Name, &googleResourceManagerV3Client.Project{
+			Labels: project.Labels,
+			Etag:   project.Etag,
+			// Removing the last label sends an empty map.
+			ForceSendFields: []string{"Labels"},
+		}).UpdateMask("labels").Context(ctx).Do()
+		if err != nil {
+			// The project is modified by others, retry with the new etag.
+			if isConflictError(err) || isPreconditionFailedError(err) {
+				return err
+			}
+			return &backoff.PermanentError{Err: err}
+		}
+		if err := waitResourceManagerOperation(ctx, resourceManagerClient, op); err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		return nil
+	}
+	err = backoff.Retry(updateFunc, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), maxRetryTimes))
+	return name, err
+}
+
+// waitResourceManagerOperation waits for the Resource Manager operation to
+// be done.
+func waitResourceManagerOperation(ctx context.Context, client *googleResourceManagerV3Client.Service,
+	op *googleResourceManagerV3Client.Operation) error {
+	var err error
+	for !op.Done {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+		op, err = client.Operations.Get(op.Name).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+
+	if op.Error != nil {
+		return fmt.Errorf("operation %s failed: %d: %s", op.Name, op.Error.Code, op.Error.Message)
+	}
+	return nil
+}
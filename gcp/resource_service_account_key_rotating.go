@@ -0,0 +1,409 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleIamClient "google.golang.org/api/iam/v1"
+)
+
+const (
+	defaultServiceAccountKeyAlgorithm = "KEY_ALG_RSA_2048"
+	serviceAccountKeyCredentialsFile  = "TYPE_GOOGLE_CREDENTIALS_FILE"
+)
+
+// serviceAccountKeyRotatingResource Present st-gcp_service_account_key_rotating resource
+type serviceAccountKeyRotatingResource struct {
+	client *gcpClients
+}
+
+type serviceAccountKeyRotatingState struct {
+	ClientConfig        *clientConfig `tfsdk:"client_config"`
+	ID                  types.String  `tfsdk:"id"`
+	ServiceAccount      types.String  `tfsdk:"service_account"`
+	KeyAlgorithm        types.String  `tfsdk:"key_algorithm"`
+	RotationDays        types.Int64   `tfsdk:"rotation_days"`
+	OverlapHours        types.Int64   `tfsdk:"overlap_hours"`
+	PrivateKey          types.String  `tfsdk:"private_key"`
+	ValidAfter          types.String  `tfsdk:"valid_after"`
+	RotateAt            types.String  `tfsdk:"rotate_at"`
+	PreviousKeyName     types.String  `tfsdk:"previous_key_name"`
+	PreviousKeyDeleteAt types.String  `tfsdk:"previous_key_delete_at"`
+}
+
+// NewServiceAccountKeyRotatingResource
+func NewServiceAccountKeyRotatingResource() resource.Resource {
+	return &serviceAccountKeyRotatingResource{}
+}
+
+// Metadata
+func (r *serviceAccountKeyRotatingResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account_key_rotating"
+}
+
+// Schema
+func (r *serviceAccountKeyRotatingResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	useStateForUnknown := []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage a service account key rotated every rotation_days. A new key " +
+			"is planned once the key is older than rotation_days, and the previous key is " +
+			"kept valid for overlap_hours before being deleted on the next apply, so the " +
+			"consumers of the key can switch to the new key without downtime.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "Resource name of the current key.",
+				Computed:      true,
+				PlanModifiers: useStateForUnknown,
+			},
+			"service_account": schema.StringAttribute{
+				Description: "Email of the service account of key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_algorithm": schema.StringAttribute{
+				Description: "Algorithm of key, KEY_ALG_RSA_1024 or KEY_ALG_RSA_2048. " +
+					"Default to " + defaultServiceAccountKeyAlgorithm + ".",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_days": schema.Int64Attribute{
+				Description: "Days after which key is rotated.",
+				Required:    true,
+			},
+			"overlap_hours": schema.Int64Attribute{
+				Description: "Hours to keep the previous key valid after rotation. The " +
+					"previous key is deleted immediately if not set.",
+				Optional: true,
+			},
+			"private_key": schema.StringAttribute{
+				Description:   "Base64 encoded credentials file of the current key.",
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: useStateForUnknown,
+			},
+			"valid_after": schema.StringAttribute{
+				Description:   "The time the current key was created in RFC3339 format.",
+				Computed:      true,
+				PlanModifiers: useStateForUnknown,
+			},
+			"rotate_at": schema.StringAttribute{
+				Description: "The time the current key will be rotated in RFC3339 format.",
+				Computed:    true,
+			},
+			"previous_key_name": schema.StringAttribute{
+				Description: "Resource name of the previous key kept valid in the overlap " +
+					"window, empty if there is none.",
+				Computed:      true,
+				PlanModifiers: useStateForUnknown,
+			},
+			"previous_key_delete_at": schema.StringAttribute{
+				Description: "The time the previous key will be deleted in RFC3339 format, " +
+					"empty if there is no previous key.",
+				Computed:      true,
+				PlanModifiers: useStateForUnknown,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *serviceAccountKeyRotatingResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan
+func (r *serviceAccountKeyRotatingResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan serviceAccountKeyRotatingState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.RotationDays.IsUnknown() {
+		return
+	}
+
+	now := time.Now()
+	plan.RotateAt = types.StringValue(serviceAccountKeyRotateAt(&state, plan.RotationDays.ValueInt64()))
+	if serviceAccountKeyRotationDue(plan.RotateAt.ValueString(), now) {
+		plan.ID = types.StringUnknown()
+		plan.PrivateKey = types.StringUnknown()
+		plan.ValidAfter = types.StringUnknown()
+		plan.RotateAt = types.StringUnknown()
+		plan.PreviousKeyName = types.StringUnknown()
+		plan.PreviousKeyDeleteAt = types.StringUnknown()
+	}
+	if state.PreviousKeyName.ValueString() != "" &&
+		serviceAccountKeyRotationDue(state.PreviousKeyDeleteAt.ValueString(), now) {
+		plan.PreviousKeyName = types.StringUnknown()
+		plan.PreviousKeyDeleteAt = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create
+func (r *serviceAccountKeyRotatingResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state serviceAccountKeyRotatingState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	iamClient, err := googleIamClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google IAM client", err.Error())
+		return
+	}
+
+	if err := r.createKey(ctx, iamClient, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create service account key", err.Error())
+		return
+	}
+	state.PreviousKeyName = types.StringValue("")
+	state.PreviousKeyDeleteAt = types.StringValue("")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *serviceAccountKeyRotatingResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceAccountKeyRotatingState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	iamClient, err := googleIamClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google IAM client", err.Error())
+		return
+	}
+
+	key, err := iamClient.Projects.ServiceAccounts.Keys.Get(state.ID.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get service account key", err.Error())
+		return
+	}
+	if serviceAccountKeyRotationDue(state.RotateAt.ValueString(), time.Now()) {
+		resp.Diagnostics.AddWarning(
+			"[Warning] Service account key rotation due",
+			fmt.Sprintf("The service account key %s was created at %s and will be rotated.",
+				key.Name, state.ValidAfter.ValueString()),
+		)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *serviceAccountKeyRotatingResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state serviceAccountKeyRotatingState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	iamClient, err := googleIamClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google IAM client", err.Error())
+		return
+	}
+
+	// The rotation and the deletion of the previous key are decided on plan,
+	// so the applied result is consistent with the plan.
+	rotate := plan.ID.IsUnknown()
+	deletePrevious := plan.PreviousKeyName.IsUnknown()
+	plan.ID = state.ID
+	plan.PrivateKey = state.PrivateKey
+	plan.ValidAfter = state.ValidAfter
+	plan.PreviousKeyName = state.PreviousKeyName
+	plan.PreviousKeyDeleteAt = state.PreviousKeyDeleteAt
+	plan.RotateAt = types.StringValue(serviceAccountKeyRotateAt(&plan, plan.RotationDays.ValueInt64()))
+	defer func() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}()
+
+	now := time.Now()
+	if rotate {
+		// The previous key of the last rotation is superseded by the current key.
+		if err := deleteServiceAccountKey(ctx, iamClient, plan.PreviousKeyName.ValueString()); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to delete previous service account key", err.Error())
+			return
+		}
+		previousKeyName := plan.ID.ValueString()
+		plan.PreviousKeyName = types.StringValue("")
+		plan.PreviousKeyDeleteAt = types.StringValue("")
+		if err := r.createKey(ctx, iamClient, &plan); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to create service account key", err.Error())
+			return
+		}
+		plan.PreviousKeyName = types.StringValue(previousKeyName)
+		plan.PreviousKeyDeleteAt = types.StringValue(now.Add(
+			time.Duration(plan.OverlapHours.ValueInt64()) * time.Hour).UTC().Format(time.RFC3339))
+	}
+
+	if deletePrevious && plan.PreviousKeyName.ValueString() != "" &&
+		serviceAccountKeyRotationDue(plan.PreviousKeyDeleteAt.ValueString(), now) {
+		if err := deleteServiceAccountKey(ctx, iamClient, plan.PreviousKeyName.ValueString()); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to delete previous service account key", err.Error())
+			return
+		}
+		plan.PreviousKeyName = types.StringValue("")
+		plan.PreviousKeyDeleteAt = types.StringValue("")
+	}
+}
+
+// Delete
+func (r *serviceAccountKeyRotatingResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state serviceAccountKeyRotatingState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	iamClient, err := googleIamClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google IAM client", err.Error())
+		return
+	}
+
+	for _, name := range []string{state.PreviousKeyName.ValueString(), state.ID.ValueString()} {
+		if err := deleteServiceAccountKey(ctx, iamClient, name); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to delete service account key", err.Error())
+			return
+		}
+	}
+}
+
+// createKey Create a new key of the service account and set it as the
+// current key in state.
+func (r *serviceAccountKeyRotatingResource) createKey(ctx context.Context,
+	client *googleIamClient.Service, s *serviceAccountKeyRotatingState) error {
+	keyAlgorithm := defaultServiceAccountKeyAlgorithm
+	if s.KeyAlgorithm.ValueString() != "" {
+		keyAlgorithm = s.KeyAlgorithm.ValueString()
+	}
+
+	// "-" infers the project from the service account.
+	key, err := client.Projects.ServiceAccounts.Keys.Create(
+		"projects/-/serviceAccounts/"+s.ServiceAccount.ValueString(),
+		&googleIamClient.CreateServiceAccountKeyRequest{
+			KeyAlgorithm:   keyAlgorithm,
+			PrivateKeyType: serviceAccountKeyCredentialsFile,
+		}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	s.ID = types.StringValue(key.Name)
+	s.PrivateKey = types.StringValue(key.PrivateKeyData)
+	s.ValidAfter = types.StringValue(key.ValidAfterTime)
+	s.RotateAt = types.StringValue(serviceAccountKeyRotateAt(s, s.RotationDays.ValueInt64()))
+	return nil
+}
+
+// deleteServiceAccountKey Delete the service account key, the key already
+// deleted is ignored.
+func deleteServiceAccountKey(ctx context.Context, client *googleIamClient.Service, name string) error {
+	if name == "" {
+		return nil
+	}
+	_, err := client.Projects.ServiceAccounts.Keys.Delete(name).Context(ctx).Do()
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+	return nil
+}
+
+// serviceAccountKeyRotateAt returns the time the current key in state is
+// rotated after rotationDays in RFC3339 format.
+func serviceAccountKeyRotateAt(s *serviceAccountKeyRotatingState, rotationDays int64) string {
+	validAfter, err := time.Parse(time.RFC3339, s.ValidAfter.ValueString())
+	if err != nil {
+		// The key of unknown age is rotated.
+		return s.ValidAfter.ValueString()
+	}
+	return validAfter.Add(time.Duration(rotationDays) * 24 * time.Hour).UTC().Format(time.RFC3339)
+}
+
+// serviceAccountKeyRotationDue returns true if the time in RFC3339 format has
+// passed, the invalid time is always due.
+func serviceAccountKeyRotationDue(at string, now time.Time) bool {
+	t, err := time.Parse(time.RFC3339, at)
+	return err != nil || !now.Before(t)
+}
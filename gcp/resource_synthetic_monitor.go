@@ -0,0 +1,654 @@
+package gcp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleFunctionsClient "google.golang.org/api/cloudfunctions/v2"
+	googleMonitoringClient "google.golang.org/api/monitoring/v3"
+	googleStorageClient "google.golang.org/api/storage/v1"
+)
+
+const (
+	defaultSyntheticMonitorRuntime     = "nodejs18"
+	defaultSyntheticMonitorMemory      = "256M"
+	defaultSyntheticMonitorPeriodSecs  = 300
+	defaultSyntheticMonitorTimeoutSecs = 60
+	syntheticMonitorTargetURLEnv       = "TARGET_URL"
+	operationPollInterval              = 5 * time.Second
+)
+
+// syntheticMonitorResource Present st-gcp_synthetic_monitor resource
+type syntheticMonitorResource struct {
+	client *gcpClients
+}
+
+type syntheticMonitorState struct {
+	ClientConfig         *clientConfig `tfsdk:"client_config"`
+	ID                   types.String  `tfsdk:"id"`
+	Name                 types.String  `tfsdk:"name"`
+	Region               types.String  `tfsdk:"region"`
+	SourceArchive        types.String  `tfsdk:"source_archive"`
+	SourceBucket         types.String  `tfsdk:"source_bucket"`
+	Runtime              types.String  `tfsdk:"runtime"`
+	EntryPoint           types.String  `tfsdk:"entry_point"`
+	AvailableMemory      types.String  `tfsdk:"available_memory"`
+	ServiceAccountEmail  types.String  `tfsdk:"service_account_email"`
+	EnvironmentVariables types.Map     `tfsdk:"environment_variables"`
+	TargetURL            types.String  `tfsdk:"target_url"`
+	PeriodSeconds        types.Int64   `tfsdk:"period_seconds"`
+	TimeoutSeconds       types.Int64   `tfsdk:"timeout_seconds"`
+	SourceArchiveSha256  types.String  `tfsdk:"source_archive_sha256"`
+	SourceObject         types.String  `tfsdk:"source_object"`
+	FunctionName         types.String  `tfsdk:"function_name"`
+	FunctionURI          types.String  `tfsdk:"function_uri"`
+}
+
+// NewSyntheticMonitorResource
+func NewSyntheticMonitorResource() resource.Resource {
+	return &syntheticMonitorResource{}
+}
+
+// Metadata
+func (r *syntheticMonitorResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synthetic_monitor"
+}
+
+// Schema
+func (r *syntheticMonitorResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a Cloud Monitoring synthetic monitor together with the 2nd gen " +
+			"Cloud Function running the scripted check. The source archive is uploaded to " +
+			"the staging bucket and the function is redeployed whenever its content changes. " +
+			"The target URL is passed to the function in the " + syntheticMonitorTargetURLEnv +
+			" environment variable.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource name of the uptime check config of synthetic monitor.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the checker function, also used as the display " +
+					"name of synthetic monitor.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Region to deploy the checker function.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_archive": schema.StringAttribute{
+				Description: "Local path of the zip archive of the checker function source.",
+				Required:    true,
+			},
+			"source_bucket": schema.StringAttribute{
+				Description: "Bucket to stage the source archive for deployment.",
+				Required:    true,
+			},
+			"runtime": schema.StringAttribute{
+				Description: "Runtime of the checker function. Default to " +
+					defaultSyntheticMonitorRuntime + ".",
+				Optional: true,
+			},
+			"entry_point": schema.StringAttribute{
+				Description: "Entry point of the checker function, e.g. the name of the " +
+					"function registered with @google-cloud/synthetics-sdk-api.",
+				Required: true,
+			},
+			"available_memory": schema.StringAttribute{
+				Description: "Memory available to the checker function. Default to " +
+					defaultSyntheticMonitorMemory + ".",
+				Optional: true,
+			},
+			"service_account_email": schema.StringAttribute{
+				Description: "Service account to run the checker function. Default to " +
+					"the default compute service account.",
+				Optional: true,
+			},
+			"environment_variables": schema.MapAttribute{
+				Description: "Environment variables of the checker function.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"target_url": schema.StringAttribute{
+				Description: "URL of the load balancer endpoint to be checked.",
+				Optional:    true,
+			},
+			"period_seconds": schema.Int64Attribute{
+				Description: "Seconds between the checks, valid values are 60, 300, 600 " +
+					"and 900. Default to " + strconv.Itoa(defaultSyntheticMonitorPeriodSecs) + ".",
+				Optional: true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Seconds to wait for a check to finish. Default to " +
+					strconv.Itoa(defaultSyntheticMonitorTimeoutSecs) + ".",
+				Optional: true,
+			},
+			"source_archive_sha256": schema.StringAttribute{
+				Description: "SHA-256 of the source archive deployed.",
+				Computed:    true,
+			},
+			"source_object": schema.StringAttribute{
+				Description: "Object of the source archive in the staging bucket.",
+				Computed:    true,
+			},
+			"function_name": schema.StringAttribute{
+				Description: "Resource name of the checker function.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"function_uri": schema.StringAttribute{
+				Description: "URI of the checker function.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *syntheticMonitorResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan
+func (r *syntheticMonitorResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var sourceArchive, name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("source_archive"), &sourceArchive)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || sourceArchive.IsUnknown() || name.IsUnknown() {
+		return
+	}
+
+	// The archive is hashed on plan so that a changed content redeploys the
+	// function even though the path is unchanged.
+	sha, err := fileSha256(sourceArchive.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_archive"),
+			"Failed to read source archive", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_archive_sha256"),
+		types.StringValue(sha))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_object"),
+		types.StringValue(syntheticMonitorSourceObject(name.ValueString(), sha)))...)
+}
+
+// Create
+func (r *syntheticMonitorResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state syntheticMonitorState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+	functionsClient, err := googleFunctionsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Functions client", err.Error())
+		return
+	}
+	monitoringClient, err := googleMonitoringClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Monitoring client", err.Error())
+		return
+	}
+
+	if err := uploadSyntheticMonitorSource(ctx, storageClient, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to upload source archive", err.Error())
+		return
+	}
+
+	// The function is deployed first, resources created are recorded in
+	// state on every failure so that they can be destroyed.
+	function, diags := r.newCheckerFunction(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parent := fmt.Sprintf("projects/%s/locations/%s", r.client.project, state.Region.ValueString())
+	op, err := functionsClient.Projects.Locations.Functions.Create(parent, function).
+		FunctionId(state.Name.ValueString()).Context(ctx).Do()
+	if err == nil {
+		err = waitFunctionOperation(ctx, functionsClient, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to deploy checker function", err.Error())
+		return
+	}
+	state.ID = types.StringValue("")
+	state.FunctionName = types.StringValue(parent + "/functions/" + state.Name.ValueString())
+	state.FunctionURI = types.StringValue("")
+	defer func() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}()
+
+	deployedFunction, err := functionsClient.Projects.Locations.Functions.Get(state.FunctionName.ValueString()).
+		Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get checker function", err.Error())
+		return
+	}
+	if deployedFunction.ServiceConfig != nil {
+		state.FunctionURI = types.StringValue(deployedFunction.ServiceConfig.Uri)
+	}
+
+	uptimeCheck, err := monitoringClient.Projects.UptimeCheckConfigs.Create("projects/"+r.client.project,
+		r.newSyntheticMonitorUptimeCheck(&state)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to create synthetic monitor", err.Error())
+		return
+	}
+	state.ID = types.StringValue(uptimeCheck.Name)
+}
+
+// Read
+func (r *syntheticMonitorResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state syntheticMonitorState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	functionsClient, err := googleFunctionsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Functions client", err.Error())
+		return
+	}
+	monitoringClient, err := googleMonitoringClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Monitoring client", err.Error())
+		return
+	}
+
+	function, err := functionsClient.Projects.Locations.Functions.Get(state.FunctionName.ValueString()).
+		Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get checker function", err.Error())
+		return
+	}
+	if function.ServiceConfig != nil {
+		state.FunctionURI = types.StringValue(function.ServiceConfig.Uri)
+	}
+
+	// The synthetic monitor is recreated on update if it was deleted.
+	if state.ID.ValueString() != "" {
+		_, err = monitoringClient.Projects.UptimeCheckConfigs.Get(state.ID.ValueString()).Context(ctx).Do()
+		if err != nil {
+			if !isNotFoundError(err) {
+				resp.Diagnostics.AddError("[API ERROR] Failed to get synthetic monitor", err.Error())
+				return
+			}
+			state.ID = types.StringValue("")
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *syntheticMonitorResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan, state syntheticMonitorState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+	functionsClient, err := googleFunctionsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Functions client", err.Error())
+		return
+	}
+	monitoringClient, err := googleMonitoringClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Monitoring client", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.FunctionName = state.FunctionName
+	plan.FunctionURI = state.FunctionURI
+	sourceChanged := plan.SourceBucket.ValueString() != state.SourceBucket.ValueString() ||
+		plan.SourceObject.ValueString() != state.SourceObject.ValueString()
+	if sourceChanged {
+		if err := uploadSyntheticMonitorSource(ctx, storageClient, &plan); err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to upload source archive", err.Error())
+			return
+		}
+	}
+
+	// The whole function is replaced without update mask.
+	function, diags := r.newCheckerFunction(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	op, err := functionsClient.Projects.Locations.Functions.Patch(plan.FunctionName.ValueString(), function).
+		Context(ctx).Do()
+	if err == nil {
+		err = waitFunctionOperation(ctx, functionsClient, op)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to deploy checker function", err.Error())
+		return
+	}
+	defer func() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}()
+
+	// The previous archive is no longer used once the function is deployed.
+	if sourceChanged {
+		err := storageClient.Objects.Delete(state.SourceBucket.ValueString(), state.SourceObject.ValueString()).
+			Context(ctx).Do()
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddWarning("[API ERROR] Failed to delete previous source archive", err.Error())
+		}
+	}
+
+	uptimeCheck := r.newSyntheticMonitorUptimeCheck(&plan)
+	if plan.ID.ValueString() == "" {
+		uptimeCheck, err = monitoringClient.Projects.UptimeCheckConfigs.Create("projects/"+r.client.project,
+			uptimeCheck).Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError("[API ERROR] Failed to create synthetic monitor", err.Error())
+			return
+		}
+		plan.ID = types.StringValue(uptimeCheck.Name)
+		return
+	}
+	_, err = monitoringClient.Projects.UptimeCheckConfigs.Patch(plan.ID.ValueString(), uptimeCheck).
+		UpdateMask("displayName,period,timeout,userLabels").Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update synthetic monitor", err.Error())
+	}
+}
+
+// Delete
+func (r *syntheticMonitorResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state syntheticMonitorState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+	functionsClient, err := googleFunctionsClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Functions client", err.Error())
+		return
+	}
+	monitoringClient, err := googleMonitoringClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Monitoring client", err.Error())
+		return
+	}
+
+	// The synthetic monitor is deleted before the function it runs.
+	if state.ID.ValueString() != "" {
+		_, err = monitoringClient.Projects.UptimeCheckConfigs.Delete(state.ID.ValueString()).Context(ctx).Do()
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError("[API ERROR] Failed to delete synthetic monitor", err.Error())
+			return
+		}
+	}
+
+	op, err := functionsClient.Projects.Locations.Functions.Delete(state.FunctionName.ValueString()).
+		Context(ctx).Do()
+	if err == nil {
+		err = waitFunctionOperation(ctx, functionsClient, op)
+	}
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete checker function", err.Error())
+		return
+	}
+
+	err = storageClient.Objects.Delete(state.SourceBucket.ValueString(), state.SourceObject.ValueString()).
+		Context(ctx).Do()
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete source archive", err.Error())
+	}
+}
+
+// newCheckerFunction converts the state into the checker function deployed
+// from the staged source archive.
+func (r *syntheticMonitorResource) newCheckerFunction(ctx context.Context,
+	s *syntheticMonitorState) (*googleFunctionsClient.Function, diag.Diagnostics) {
+	environmentVariables := map[string]string{}
+	diags := s.EnvironmentVariables.ElementsAs(ctx, &environmentVariables, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if s.TargetURL.ValueString() != "" {
+		environmentVariables[syntheticMonitorTargetURLEnv] = s.TargetURL.ValueString()
+	}
+
+	runtime := defaultSyntheticMonitorRuntime
+	if s.Runtime.ValueString() != "" {
+		runtime = s.Runtime.ValueString()
+	}
+	availableMemory := defaultSyntheticMonitorMemory
+	if s.AvailableMemory.ValueString() != "" {
+		availableMemory = s.AvailableMemory.ValueString()
+	}
+	timeoutSeconds := int64(defaultSyntheticMonitorTimeoutSecs)
+	if !s.TimeoutSeconds.IsNull() {
+		timeoutSeconds = s.TimeoutSeconds.ValueInt64()
+	}
+
+	function := &googleFunctionsClient.Function{
+		BuildConfig: &googleFunctionsClient.BuildConfig{
+			Runtime:    runtime,
+			EntryPoint: s.EntryPoint.ValueString(),
+			Source: &googleFunctionsClient.Source{
+				StorageSource: &googleFunctionsClient.StorageSource{
+					Bucket: s.SourceBucket.ValueString(),
+					Object: s.SourceObject.ValueString(),
+				},
+			},
+		},
+		ServiceConfig: &googleFunctionsClient.ServiceConfig{
+			AvailableMemory:      availableMemory,
+			TimeoutSeconds:       timeoutSeconds,
+			ServiceAccountEmail:  s.ServiceAccountEmail.ValueString(),
+			EnvironmentVariables: environmentVariables,
+		},
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		function.Labels = map[string]string{changeReferenceKey: label}
+	}
+	return function, nil
+}
+
+// newSyntheticMonitorUptimeCheck converts the state into the uptime check
+// config running the checker function.
+func (r *syntheticMonitorResource) newSyntheticMonitorUptimeCheck(
+	s *syntheticMonitorState) *googleMonitoringClient.UptimeCheckConfig {
+	periodSeconds := int64(defaultSyntheticMonitorPeriodSecs)
+	if !s.PeriodSeconds.IsNull() {
+		periodSeconds = s.PeriodSeconds.ValueInt64()
+	}
+	timeoutSeconds := int64(defaultSyntheticMonitorTimeoutSecs)
+	if !s.TimeoutSeconds.IsNull() {
+		timeoutSeconds = s.TimeoutSeconds.ValueInt64()
+	}
+
+	uptimeCheck := &googleMonitoringClient.UptimeCheckConfig{
+		DisplayName: s.Name.ValueString(),
+		Period:      fmt.Sprintf("%ds", periodSeconds),
+		Timeout:     fmt.Sprintf("%ds", timeoutSeconds),
+		SyntheticMonitor: &googleMonitoringClient.SyntheticMonitorTarget{
+			CloudFunctionV2: &googleMonitoringClient.CloudFunctionV2Target{
+				Name: s.FunctionName.ValueString(),
+			},
+		},
+	}
+	if label := r.client.changeReferenceLabel(); label != "" {
+		uptimeCheck.UserLabels = map[string]string{changeReferenceKey: label}
+	}
+	return uptimeCheck
+}
+
+// uploadSyntheticMonitorSource uploads the source archive to the staging
+// bucket.
+func uploadSyntheticMonitorSource(ctx context.Context, client *googleStorageClient.Service,
+	s *syntheticMonitorState) error {
+	file, err := os.Open(s.SourceArchive.ValueString())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = client.Objects.Insert(s.SourceBucket.ValueString(), &googleStorageClient.Object{
+		Name:        s.SourceObject.ValueString(),
+		ContentType: "application/zip",
+	}).Media(file).Context(ctx).Do()
+	return err
+}
+
+// waitFunctionOperation waits until the Cloud Functions operation is done,
+// and returns the error of the operation if it failed.
+func waitFunctionOperation(ctx context.Context, client *googleFunctionsClient.Service,
+	op *googleFunctionsClient.Operation) error {
+	var err error
+	for !op.Done {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+		op, err = client.Projects.Locations.Operations.Get(op.Name).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+
+	if op.Error != nil {
+		return fmt.Errorf("operation %s failed: %d: %s", op.Name, op.Error.Code, op.Error.Message)
+	}
+	return nil
+}
+
+// syntheticMonitorSourceObject returns the object name of the source
+// archive, named after its content so that every change is deployed.
+func syntheticMonitorSourceObject(name, sha string) string {
+	return fmt.Sprintf("synthetic-monitors/%s/%s.zip", name, sha)
+}
+
+// fileSha256 returns the hex encoded SHA-256 of the file content.
+func fileSha256(name string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,358 @@
+package gcp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleStorageClient "google.golang.org/api/storage/v1"
+)
+
+const (
+	defaultUniqueNameClaimObjectPrefix = "unique-name-claims"
+	defaultUniqueNameSuffixLength      = 6
+	maxUniqueNameSuffixLength          = 32
+)
+
+// uniqueNameClaimResource Present st-gcp_unique_name_claim resource
+type uniqueNameClaimResource struct {
+	client *gcpClients
+}
+
+type uniqueNameClaimState struct {
+	ClientConfig       *clientConfig `tfsdk:"client_config"`
+	ID                 types.String  `tfsdk:"id"`
+	Bucket             types.String  `tfsdk:"bucket"`
+	ObjectPrefix       types.String  `tfsdk:"object_prefix"`
+	Prefix             types.String  `tfsdk:"prefix"`
+	Name               types.String  `tfsdk:"name"`
+	RandomSuffixLength types.Int64   `tfsdk:"random_suffix_length"`
+	Owner              types.String  `tfsdk:"owner"`
+	Generation         types.Int64   `tfsdk:"generation"`
+}
+
+// NewUniqueNameClaimResource
+func NewUniqueNameClaimResource() resource.Resource {
+	return &uniqueNameClaimResource{}
+}
+
+// Metadata
+func (r *uniqueNameClaimResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unique_name_claim"
+}
+
+// Schema
+func (r *uniqueNameClaimResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Claim a unique name backed by a GCS object, so concurrent applies " +
+			"generating names from the same prefix do not collide.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GCS object path of the claim.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bucket": schema.StringAttribute{
+				Description: "GCS bucket to store the claims.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_prefix": schema.StringAttribute{
+				Description: "Object prefix of the claims in the bucket. Default to " +
+					"`" + defaultUniqueNameClaimObjectPrefix + "`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "Prefix of the generated name. A random suffix is appended " +
+					"to the prefix when name is not set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name to be claimed. Generated from prefix if not set. " +
+					"The claim fails if the name is already claimed.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"random_suffix_length": schema.Int64Attribute{
+				Description: "Length of the random suffix appended to the prefix, from 1 " +
+					"to " + strconv.Itoa(maxUniqueNameSuffixLength) + ". Default to " +
+					strconv.Itoa(defaultUniqueNameSuffixLength) + ".",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Owner of the claim recorded in the object metadata, e.g. the pipeline name.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"generation": schema.Int64Attribute{
+				Description: "Generation of the claim object.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *uniqueNameClaimResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *uniqueNameClaimResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state uniqueNameClaimState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if state.Name.IsUnknown() && state.Prefix.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Missing name or prefix",
+			"Either name or prefix must be set to claim a unique name.",
+		)
+		return
+	}
+	if !state.RandomSuffixLength.IsNull() && (state.RandomSuffixLength.ValueInt64() < 1 ||
+		state.RandomSuffixLength.ValueInt64() > maxUniqueNameSuffixLength) {
+		resp.Diagnostics.AddError(
+			"Invalid random_suffix_length",
+			fmt.Sprintf("random_suffix_length must be from 1 to %d.", maxUniqueNameSuffixLength),
+		)
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	if err := r.claimUniqueName(ctx, storageClient, &state); err != nil {
+		resp.Diagnostics.AddError("claimUniqueName error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *uniqueNameClaimResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state uniqueNameClaimState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	object, err := storageClient.Objects.Get(state.Bucket.ValueString(), claimObjectName(&state)).
+		Context(ctx).Do()
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Warn(ctx, "Claim object not found, removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get claim object", err.Error())
+		return
+	}
+
+	// The name is claimed by someone else once the claim object is replaced.
+	if object.Generation != state.Generation.ValueInt64() {
+		tflog.Warn(ctx, "Claim object generation changed, removing from state", map[string]interface{}{
+			"id":         state.ID.ValueString(),
+			"generation": object.Generation,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *uniqueNameClaimResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	// Every configurable attribute requires replacement, hence only the
+	// planned values are stored.
+	var state uniqueNameClaimState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete
+func (r *uniqueNameClaimResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state uniqueNameClaimState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	storageClient, err := googleStorageClient.NewService(ctx, r.client.clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to initialize Google Cloud Storage client", err.Error())
+		return
+	}
+
+	// Only release the claim owned by this resource.
+	err = storageClient.Objects.Delete(state.Bucket.ValueString(), claimObjectName(&state)).
+		IfGenerationMatch(state.Generation.ValueInt64()).Context(ctx).Do()
+	if err != nil && !isNotFoundError(err) && !isPreconditionFailedError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to delete claim object", err.Error())
+	}
+}
+
+// claimUniqueName Create the claim object of the name, the object is only
+// created if it does not exist. A new random suffix is generated and
+// retried if the generated name is already claimed.
+func (r *uniqueNameClaimResource) claimUniqueName(ctx context.Context,
+	client *googleStorageClient.Service, s *uniqueNameClaimState) error {
+	generateName := s.Name.IsUnknown() || s.Name.IsNull()
+	suffixLength := defaultUniqueNameSuffixLength
+	if !s.RandomSuffixLength.IsNull() {
+		suffixLength = int(s.RandomSuffixLength.ValueInt64())
+	}
+
+	for i := 0; i < maxRetryTimes; i++ {
+		if generateName {
+			suffix, err := randomHexSuffix(suffixLength)
+			if err != nil {
+				return err
+			}
+			s.Name = types.StringValue(s.Prefix.ValueString() + suffix)
+		}
+
+		objectName := claimObjectName(s)
+		object := &googleStorageClient.Object{
+			Name:        objectName,
+			ContentType: "text/plain",
+			Metadata: r.client.withChangeReferenceMetadata(map[string]string{
+				"name":       s.Name.ValueString(),
+				"owner":      s.Owner.ValueString(),
+				"claimed-at": time.Now().UTC().Format(time.RFC3339),
+			}),
+		}
+		created, err := client.Objects.Insert(s.Bucket.ValueString(), object).
+			Media(strings.NewReader(s.Name.ValueString())).
+			IfGenerationMatch(0).Context(ctx).Do()
+		if err == nil {
+			s.ID = types.StringValue(s.Bucket.ValueString() + "/" + objectName)
+			s.Generation = types.Int64Value(created.Generation)
+			return nil
+		}
+		if !isPreconditionFailedError(err) {
+			return fmt.Errorf("failed to create claim object: %v", err)
+		}
+		if !generateName {
+			return fmt.Errorf("name %q is already claimed", s.Name.ValueString())
+		}
+		tflog.Warn(ctx, "Generated name is already claimed, retrying", map[string]interface{}{
+			"name": s.Name.ValueString(),
+		})
+		time.Sleep(retrySleepMs * time.Millisecond)
+	}
+	return fmt.Errorf("failed to claim a unique name with prefix %q after %d attempts",
+		s.Prefix.ValueString(), maxRetryTimes)
+}
+
+func claimObjectName(s *uniqueNameClaimState) string {
+	objectPrefix := defaultUniqueNameClaimObjectPrefix
+	if s.ObjectPrefix.ValueString() != "" {
+		objectPrefix = strings.TrimSuffix(s.ObjectPrefix.ValueString(), "/")
+	}
+	return objectPrefix + "/" + s.Name.ValueString()
+}
+
+// randomHexSuffix generates a random hex string with the given length.
+func randomHexSuffix(length int) (string, error) {
+	buf := make([]byte, (length+1)/2)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random suffix: %v", err)
+	}
+	return hex.EncodeToString(buf)[:length], nil
+}
@@ -0,0 +1,479 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	googleComputeClient "google.golang.org/api/compute/v1"
+)
+
+// urlMapPathRuleResource Present st-gcp_url_map_path_rule resource
+type urlMapPathRuleResource struct {
+	client *gcpClients
+}
+
+type urlMapPathRuleState struct {
+	ClientConfig   *clientConfig          `tfsdk:"client_config"`
+	ID             types.String           `tfsdk:"id"`
+	URLMap         types.String           `tfsdk:"url_map"`
+	Region         types.String           `tfsdk:"region"`
+	PathMatcher    types.String           `tfsdk:"path_matcher"`
+	Hosts          []types.String         `tfsdk:"hosts"`
+	DefaultService types.String           `tfsdk:"default_service"`
+	Description    types.String           `tfsdk:"description"`
+	PathRules      []*urlMapPathRuleModel `tfsdk:"path_rules"`
+}
+
+type urlMapPathRuleModel struct {
+	Paths   []types.String `tfsdk:"paths"`
+	Service types.String   `tfsdk:"service"`
+}
+
+// NewURLMapPathRuleResource
+func NewURLMapPathRuleResource() resource.Resource {
+	return &urlMapPathRuleResource{}
+}
+
+// Metadata
+func (r *urlMapPathRuleResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_url_map_path_rule"
+}
+
+// Schema
+func (r *urlMapPathRuleResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Register a host rule and its path matcher in an existing URL map " +
+			"managed elsewhere. Only the host rule and the path matcher of resource are " +
+			"changed, the other routes of the URL map are kept and the changes are patched " +
+			"with the fingerprint of URL map, so every team can register its own routes to " +
+			"a centrally owned load balancer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Self link of URL map and name of path matcher.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url_map": schema.StringAttribute{
+				Description: "Name of the URL map to register routes to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Region of the regional URL map, the global URL map is used if not set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path_matcher": schema.StringAttribute{
+				Description: "Name of path matcher, unique in URL map.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				Description: "Hosts of the host rule routed to path matcher, e.g. " +
+					"api.example.com or *.example.com. The hosts must not be routed by the " +
+					"other host rules of URL map.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"default_service": schema.StringAttribute{
+				Description: "Self link of the backend service or the backend bucket of the " +
+					"requests not matching any path rule.",
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the host rule and path matcher.",
+				Optional:    true,
+			},
+			"path_rules": schema.ListNestedAttribute{
+				Description: "Path rules of path matcher, the first matching path rule wins.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"paths": schema.ListAttribute{
+							Description: "Paths of path rule, e.g. /v1/*.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						"service": schema.StringAttribute{
+							Description: "Self link of the backend service or the backend bucket " +
+								"of the requests matching paths.",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": clientConfigResourceBlock(),
+		},
+	}
+}
+
+// Configure
+func (r *urlMapPathRuleResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// this data available on apply stage
+		return
+	}
+	client, ok := req.ProviderData.(*gcpClients)
+	if !ok {
+		resp.Diagnostics.AddError("req.ProviderData not a gcpClients error", "")
+		return
+	}
+	r.client = client
+}
+
+// Create
+func (r *urlMapPathRuleResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	var state urlMapPathRuleState
+	d := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Create req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	name := state.PathMatcher.ValueString()
+	err := r.updateURLMap(ctx, &state, func(urlMap *googleComputeClient.UrlMap) (bool, error) {
+		if findPathMatcher(urlMap, name) >= 0 {
+			return false, fmt.Errorf("path matcher %s already exists in URL map %s",
+				name, state.URLMap.ValueString())
+		}
+		if err := checkHostRuleHosts(urlMap, &state); err != nil {
+			return false, err
+		}
+		urlMap.HostRules = append(urlMap.HostRules, newHostRule(&state))
+		urlMap.PathMatchers = append(urlMap.PathMatchers, newPathMatcher(&state))
+		return true, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to add path matcher to URL map", err.Error())
+		return
+	}
+
+	if err := r.refreshPathMatcher(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get URL map", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read
+func (r *urlMapPathRuleResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state urlMapPathRuleState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Read req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.refreshPathMatcher(ctx, &state); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("[API ERROR] Failed to get URL map", err.Error())
+		return
+	}
+	// The path matcher is removed by others.
+	if state.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update
+func (r *urlMapPathRuleResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	var plan urlMapPathRuleState
+	d := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Update req.Plan.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, plan.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	name := plan.PathMatcher.ValueString()
+	err := r.updateURLMap(ctx, &plan, func(urlMap *googleComputeClient.UrlMap) (bool, error) {
+		i := findPathMatcher(urlMap, name)
+		if i < 0 {
+			return false, fmt.Errorf("path matcher %s does not exist in URL map %s",
+				name, plan.URLMap.ValueString())
+		}
+		if err := checkHostRuleHosts(urlMap, &plan); err != nil {
+			return false, err
+		}
+		urlMap.PathMatchers[i] = newPathMatcher(&plan)
+		hostRules := []*googleComputeClient.HostRule{}
+		for _, hostRule := range urlMap.HostRules {
+			if hostRule.PathMatcher != name {
+				hostRules = append(hostRules, hostRule)
+			}
+		}
+		urlMap.HostRules = append(hostRules, newHostRule(&plan))
+		return true, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to update path matcher of URL map", err.Error())
+		return
+	}
+
+	if err := r.refreshPathMatcher(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("[API ERROR] Failed to get URL map", err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete
+func (r *urlMapPathRuleResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.checkReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	var state urlMapPathRuleState
+	d := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Delete req.State.Get error")
+		return
+	}
+
+	if applyClientConfig(ctx, &r.client, state.ClientConfig, &resp.Diagnostics) {
+		return
+	}
+
+	name := state.PathMatcher.ValueString()
+	err := r.updateURLMap(ctx, &state, func(urlMap *googleComputeClient.UrlMap) (bool, error) {
+		i := findPathMatcher(urlMap, name)
+		if i < 0 {
+			return false, nil
+		}
+		urlMap.PathMatchers = append(urlMap.PathMatchers[:i], urlMap.PathMatchers[i+1:]...)
+		hostRules := []*googleComputeClient.HostRule{}
+		for _, hostRule := range urlMap.HostRules {
+			if hostRule.PathMatcher != name {
+				hostRules = append(hostRules, hostRule)
+			}
+		}
+		urlMap.HostRules = hostRules
+		return true, nil
+	})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("[API ERROR] Failed to remove path matcher from URL map", err.Error())
+	}
+}
+
+// getURLMap gets the global or the regional URL map.
+func (r *urlMapPathRuleResource) getURLMap(ctx context.Context,
+	s *urlMapPathRuleState) (*googleComputeClient.UrlMap, error) {
+	name := s.URLMap.ValueString()
+	if region := s.Region.ValueString(); region != "" {
+		return r.client.computeClient.RegionUrlMaps.Get(r.client.project, region, name).Context(ctx).Do()
+	}
+	return r.client.computeClient.UrlMaps.Get(r.client.project, name).Context(ctx).Do()
+}
+
+// updateURLMap Patch the host rules and the path matchers of URL map changed
+// by updateURLMapFunc, URL map is unchanged if it returns false.
+func (r *urlMapPathRuleResource) updateURLMap(ctx context.Context, s *urlMapPathRuleState,
+	updateURLMapFunc func(*googleComputeClient.UrlMap) (bool, error)) error {
+	project := r.client.project
+	name := s.URLMap.ValueString()
+	region := s.Region.ValueString()
+
+	updateFunc := func() error {
+		urlMap, err := r.getURLMap(ctx, s)
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+
+		changed, err := updateURLMapFunc(urlMap)
+		if err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		if !changed {
+			return nil
+		}
+		patch := &googleComputeClient.UrlMap{
+			HostRules:    urlMap.HostRules,
+			PathMatchers: urlMap.PathMatchers,
+			Fingerprint:  urlMap.Fingerprint,
+			// Removing the last path matcher sends empty lists.
+			ForceSendFields: []string{"HostRules", "PathMatchers"},
+		}
+
+		var op *googleComputeClient.Operation
+		if region != "" {
+			op, err = r.client.computeClient.RegionUrlMaps.Patch(project, region, name, patch).Context(ctx).Do()
+		} else {
+			op, err = r.client.computeClient.UrlMaps.Patch(project, name, patch).Context(ctx).Do()
+		}
+		if err != nil {
+			// The URL map is updated by others, retry with the new fingerprint.
+			if isPreconditionFailedError(err) {
+				return err
+			}
+			return &backoff.PermanentError{Err: err}
+		}
+		if err := waitComputeOperation(ctx, r.client.computeClient, project, op); err != nil {
+			return &backoff.PermanentError{Err: err}
+		}
+		return nil
+	}
+	return backoff.Retry(updateFunc, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), maxRetryTimes))
+}
+
+// refreshPathMatcher gets the path matcher and its host rule and updates the
+// settings in state, the ID is set to null if path matcher does not exist.
+func (r *urlMapPathRuleResource) refreshPathMatcher(ctx context.Context, s *urlMapPathRuleState) error {
+	urlMap, err := r.getURLMap(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	name := s.PathMatcher.ValueString()
+	i := findPathMatcher(urlMap, name)
+	if i < 0 {
+		s.ID = types.StringNull()
+		return nil
+	}
+	pathMatcher := urlMap.PathMatchers[i]
+
+	s.ID = types.StringValue(urlMap.SelfLink + "|" + name)
+	hosts := []types.String{}
+	for _, hostRule := range urlMap.HostRules {
+		if hostRule.PathMatcher == name {
+			for _, host := range hostRule.Hosts {
+				hosts = append(hosts, types.StringValue(host))
+			}
+		}
+	}
+	s.Hosts = hosts
+	if !matchResourceReference(pathMatcher.DefaultService, s.DefaultService.ValueString()) {
+		s.DefaultService = types.StringValue(pathMatcher.DefaultService)
+	}
+	if !s.Description.IsNull() || pathMatcher.Description != "" {
+		s.Description = types.StringValue(pathMatcher.Description)
+	}
+
+	if s.PathRules == nil && len(pathMatcher.PathRules) == 0 {
+		return nil
+	}
+	pathRules := []*urlMapPathRuleModel{}
+	for j, pathRule := range pathMatcher.PathRules {
+		item := &urlMapPathRuleModel{
+			Paths:   []types.String{},
+			Service: types.StringValue(pathRule.Service),
+		}
+		for _, path := range pathRule.Paths {
+			item.Paths = append(item.Paths, types.StringValue(path))
+		}
+		// The service is kept as configured if it refers to the same resource.
+		if j < len(s.PathRules) && matchResourceReference(pathRule.Service, s.PathRules[j].Service.ValueString()) {
+			item.Service = s.PathRules[j].Service
+		}
+		pathRules = append(pathRules, item)
+	}
+	s.PathRules = pathRules
+	return nil
+}
+
+// checkHostRuleHosts returns an error if any host in state is routed by the
+// host rules of the other path matchers.
+func checkHostRuleHosts(urlMap *googleComputeClient.UrlMap, s *urlMapPathRuleState) error {
+	for _, hostRule := range urlMap.HostRules {
+		if hostRule.PathMatcher == s.PathMatcher.ValueString() {
+			continue
+		}
+		for _, host := range s.Hosts {
+			if containsString(hostRule.Hosts, host.ValueString()) {
+				return fmt.Errorf("host %s is already routed to path matcher %s",
+					host.ValueString(), hostRule.PathMatcher)
+			}
+		}
+	}
+	return nil
+}
+
+// newHostRule converts the state into the host rule of URL map.
+func newHostRule(s *urlMapPathRuleState) *googleComputeClient.HostRule {
+	hostRule := &googleComputeClient.HostRule{
+		Description: s.Description.ValueString(),
+		PathMatcher: s.PathMatcher.ValueString(),
+	}
+	for _, host := range s.Hosts {
+		hostRule.Hosts = append(hostRule.Hosts, host.ValueString())
+	}
+	return hostRule
+}
+
+// newPathMatcher converts the state into the path matcher of URL map.
+func newPathMatcher(s *urlMapPathRuleState) *googleComputeClient.PathMatcher {
+	pathMatcher := &googleComputeClient.PathMatcher{
+		Name:           s.PathMatcher.ValueString(),
+		Description:    s.Description.ValueString(),
+		DefaultService: s.DefaultService.ValueString(),
+	}
+	for _, pathRule := range s.PathRules {
+		rule := &googleComputeClient.PathRule{Service: pathRule.Service.ValueString()}
+		for _, path := range pathRule.Paths {
+			rule.Paths = append(rule.Paths, path.ValueString())
+		}
+		pathMatcher.PathRules = append(pathMatcher.PathRules, rule)
+	}
+	return pathMatcher
+}
+
+// findPathMatcher returns the index of the path matcher of name in URL map,
+// -1 if it does not exist.
+func findPathMatcher(urlMap *googleComputeClient.UrlMap, name string) int {
+	for i, pathMatcher := range urlMap.PathMatchers {
+		if pathMatcher.Name == name {
+			return i
+		}
+	}
+	return -1
+}
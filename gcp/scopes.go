@@ -0,0 +1,18 @@
+package gcp
+
+import (
+	"sort"
+)
+
+const globalScope = "global"
+
+// sortedKeys returns the sorted keys of the map, e.g. the scopes of the
+// aggregated list items, to keep the order of items stable.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
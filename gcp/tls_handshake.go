@@ -0,0 +1,74 @@
+package gcp
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"net"
+	"time"
+)
+
+const (
+	defaultTLSPort = 443
+)
+
+// tlsHandshakeRequest describes a TLS handshake made from the provider host.
+type tlsHandshakeRequest struct {
+	address    string
+	serverName string
+	timeout    time.Duration
+}
+
+// tlsHandshake connects to the address and returns the connection state of
+// the handshake. The presented chain is not verified during the handshake, so
+// that the chain of endpoints serving invalid certificates can be inspected,
+// use verifyCertificateChain to verify it.
+func tlsHandshake(ctx context.Context, handshake *tlsHandshakeRequest) (*tls.ConnectionState, error) {
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: handshake.timeout},
+		Config: &tls.Config{
+			ServerName:         handshake.serverName,
+			InsecureSkipVerify: true, // nolint:gosec
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, handshake.timeout)
+	defer cancel()
+
+	conn, err := dialer.DialContext(ctx, "tcp", handshake.address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	state := conn.(*tls.Conn).ConnectionState()
+	return &state, nil
+}
+
+// verifyCertificateChain verifies the presented chain against the system
+// roots for the server name.
+func verifyCertificateChain(serverName string, certificates []*x509.Certificate) error {
+	if len(certificates) == 0 {
+		return errors.New("no certificate is presented")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+	_, err := certificates[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Intermediates: intermediates,
+	})
+	return err
+}
+
+// certificateFingerprint returns the hex encoded SHA-256 fingerprint of the
+// certificate.
+func certificateFingerprint(certificate *x509.Certificate) string {
+	sum := sha256.Sum256(certificate.Raw)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,257 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/myklst/terraform-provider-st-gcp"
+
+var (
+	// tracingEnabled is set by InitTracing if the traces are exported.
+	tracingEnabled bool
+	// tracingParent is the span context of the TRACEPARENT environment
+	// variable, so the spans of the provider can be linked to the trace of
+	// the pipeline running Terraform.
+	tracingParent trace.SpanContext
+)
+
+// InitTracing configures the OpenTelemetry tracer provider exporting the
+// spans by OTLP if OTEL_TRACES_EXPORTER is otlp, or it is not set and the OTLP
+// endpoint is set. The exporter is configured by the standard OTEL_*
+// environment variables. The returned function flushes the spans and must be
+// called before the provider process exits.
+func InitTracing(ctx context.Context) (func(context.Context) error, error) {
+	shutdown := func(context.Context) error { return nil }
+
+	switch os.Getenv("OTEL_TRACES_EXPORTER") {
+	case "otlp":
+	case "":
+		if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+			return shutdown, nil
+		}
+	case "none":
+		return shutdown, nil
+	default:
+		return shutdown, fmt.Errorf("unsupported OTEL_TRACES_EXPORTER %q, only otlp and none are supported",
+			os.Getenv("OTEL_TRACES_EXPORTER"))
+	}
+
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+	var exporter *otlptrace.Exporter
+	var err error
+	switch protocol {
+	case "", "http/protobuf":
+		exporter, err = otlptracehttp.New(ctx)
+	case "grpc":
+		exporter, err = otlptracegrpc.New(ctx)
+	default:
+		return shutdown, fmt.Errorf("unsupported OTLP protocol %q, only http/protobuf and grpc are supported", protocol)
+	}
+	if err != nil {
+		return shutdown, err
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence over
+	// the default service name.
+	res, err := sdkresource.New(ctx,
+		sdkresource.WithAttributes(semconv.ServiceName("terraform-provider-st-gcp")),
+		sdkresource.WithFromEnv(),
+		sdkresource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return shutdown, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	tracingParent = trace.SpanContextFromContext(propagation.TraceContext{}.Extract(ctx,
+		propagation.MapCarrier{"traceparent": os.Getenv("TRACEPARENT")}))
+	tracingEnabled = true
+	return tracerProvider.Shutdown, nil
+}
+
+// startSpan starts a span, the span is a child of the TRACEPARENT environment
+// variable if there is no span in the context.
+func startSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() && tracingParent.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, tracingParent)
+	}
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// endSpan ends the span with the error status if the diagnostics have
+// errors.
+func endSpan(span trace.Span, diags diag.Diagnostics) {
+	for _, d := range diags.Errors() {
+		span.SetStatus(codes.Error, d.Summary())
+		break
+	}
+	span.End()
+}
+
+// apiTracingTransport creates a span for every request sent through the base
+// transport.
+type apiTracingTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *apiTracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := startSpan(req.Context(), req.Method+" "+req.URL.Host,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPMethod(req.Method),
+			semconv.HTTPURL(req.URL.Scheme+"://"+req.URL.Host+req.URL.Path),
+			semconv.NetPeerName(req.URL.Hostname()),
+		))
+	defer span.End()
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+	span.SetAttributes(semconv.HTTPStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, err
+}
+
+// tracedDataSources wraps the data sources to create a span for every Read if
+// tracing is enabled.
+func tracedDataSources(dataSources []func() datasource.DataSource) []func() datasource.DataSource {
+	if !tracingEnabled {
+		return dataSources
+	}
+
+	traced := make([]func() datasource.DataSource, 0, len(dataSources))
+	for _, newDataSource := range dataSources {
+		newDataSource := newDataSource
+		traced = append(traced, func() datasource.DataSource {
+			dataSource := newDataSource()
+			resp := &datasource.MetadataResponse{}
+			dataSource.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: providerTypeName}, resp)
+			return &tracedDataSource{DataSource: dataSource, typeName: resp.TypeName}
+		})
+	}
+	return traced
+}
+
+// tracedResources wraps the resources to create a span for every CRUD
+// operation if tracing is enabled.
+func tracedResources(resources []func() resource.Resource) []func() resource.Resource {
+	if !tracingEnabled {
+		return resources
+	}
+
+	traced := make([]func() resource.Resource, 0, len(resources))
+	for _, newResource := range resources {
+		newResource := newResource
+		traced = append(traced, func() resource.Resource {
+			res := newResource()
+			resp := &resource.MetadataResponse{}
+			res.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: providerTypeName}, resp)
+			return &tracedResource{Resource: res, typeName: resp.TypeName}
+		})
+	}
+	return traced
+}
+
+// tracedDataSource is created per request by the framework, the type name is
+// resolved when it is created since Metadata is only called on start.
+type tracedDataSource struct {
+	datasource.DataSource
+	typeName string
+}
+
+// Configure
+func (d *tracedDataSource) Configure(ctx context.Context,
+	req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if dataSource, ok := d.DataSource.(datasource.DataSourceWithConfigure); ok {
+		dataSource.Configure(ctx, req, resp)
+	}
+}
+
+// Read
+func (d *tracedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startSpan(ctx, "data."+d.typeName+".Read")
+	defer func() { endSpan(span, resp.Diagnostics) }()
+	d.DataSource.Read(ctx, req, resp)
+}
+
+type tracedResource struct {
+	resource.Resource
+	typeName string
+}
+
+// Configure
+func (r *tracedResource) Configure(ctx context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if res, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		res.Configure(ctx, req, resp)
+	}
+}
+
+// ModifyPlan
+func (r *tracedResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if res, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		ctx, span := startSpan(ctx, r.typeName+".ModifyPlan")
+		defer func() { endSpan(span, resp.Diagnostics) }()
+		res.ModifyPlan(ctx, req, resp)
+	}
+}
+
+// Create
+func (r *tracedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startSpan(ctx, r.typeName+".Create")
+	defer func() { endSpan(span, resp.Diagnostics) }()
+	r.Resource.Create(ctx, req, resp)
+}
+
+// Read
+func (r *tracedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startSpan(ctx, r.typeName+".Read")
+	defer func() { endSpan(span, resp.Diagnostics) }()
+	r.Resource.Read(ctx, req, resp)
+}
+
+// Update
+func (r *tracedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startSpan(ctx, r.typeName+".Update")
+	defer func() { endSpan(span, resp.Diagnostics) }()
+	r.Resource.Update(ctx, req, resp)
+}
+
+// Delete
+func (r *tracedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startSpan(ctx, r.typeName+".Delete")
+	defer func() { endSpan(span, resp.Diagnostics) }()
+	r.Resource.Delete(ctx, req, resp)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"log"
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -17,7 +18,17 @@ func main() {
 	if providerAddress == "" {
 		providerAddress = "registry.terraform.io/myklst/st-gcp"
 	}
+	shutdownTracing, err := gcp.InitTracing(context.Background())
+	if err != nil {
+		log.Printf("[WARN] Failed to initialize tracing: %v", err)
+	}
 	_ = providerserver.Serve(context.Background(), gcp.New, providerserver.ServeOpts{
 		Address: providerAddress,
 	})
+	// Flush the spans buffered and write the API metrics before the provider
+	// process exits.
+	_ = shutdownTracing(context.Background())
+	if err := gcp.WriteMetrics(); err != nil {
+		log.Printf("[WARN] %v", err)
+	}
 }